@@ -44,6 +44,28 @@ func deepCopy_api_Application(in api.Application, out *api.Application, c *conve
 	return nil
 }
 
+func deepCopy_api_ApplicationCondition(in api.ApplicationCondition, out *api.ApplicationCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_api_ApplicationItemStatus(in api.ApplicationItemStatus, out *api.ApplicationItemStatus, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Health = in.Health
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_ApplicationList(in api.ApplicationList, out *api.ApplicationList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -68,6 +90,106 @@ func deepCopy_api_ApplicationList(in api.ApplicationList, out *api.ApplicationLi
 	return nil
 }
 
+func deepCopy_api_ApplicationPromotion(in api.ApplicationPromotion, out *api.ApplicationPromotion, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_ApplicationPromotionSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ApplicationPromotionStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_ApplicationPromotionItemStatus(in api.ApplicationPromotionItemStatus, out *api.ApplicationPromotionItemStatus, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_api_ApplicationPromotionList(in api.ApplicationPromotionList, out *api.ApplicationPromotionList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationPromotion, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ApplicationPromotion(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ApplicationPromotionSpec(in api.ApplicationPromotionSpec, out *api.ApplicationPromotionSpec, c *conversion.Cloner) error {
+	out.Application = in.Application
+	out.TargetNamespace = in.TargetNamespace
+	if in.Plans != nil {
+		out.Plans = make(map[string]string)
+		for key, val := range in.Plans {
+			out.Plans[key] = val
+		}
+	} else {
+		out.Plans = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ApplicationPromotionStatus(in api.ApplicationPromotionStatus, out *api.ApplicationPromotionStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationPromotionItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ApplicationPromotionItemStatus(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ApplicationSpec(in api.ApplicationSpec, out *api.ApplicationSpec, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if in.Items != nil {
@@ -94,6 +216,26 @@ func deepCopy_api_ApplicationSpec(in api.ApplicationSpec, out *api.ApplicationSp
 
 func deepCopy_api_ApplicationStatus(in api.ApplicationStatus, out *api.ApplicationStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ApplicationItemStatus(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]api.ApplicationCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_ApplicationCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -855,6 +997,10 @@ func deepCopy_api_ServicePlan(in backingserviceapi.ServicePlan, out *backingserv
 		return err
 	}
 	out.Free = in.Free
+	out.Deprecated = in.Deprecated
+	if err := deepCopy_api_ServicePlanSchemas(in.Schemas, &out.Schemas, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -894,6 +1040,154 @@ func deepCopy_api_ServicePlanMetadata(in backingserviceapi.ServicePlanMetadata,
 	return nil
 }
 
+func deepCopy_api_ServicePlanSchemas(in backingserviceapi.ServicePlanSchemas, out *backingserviceapi.ServicePlanSchemas, c *conversion.Cloner) error {
+	out.InstanceCreate = in.InstanceCreate
+	out.InstanceUpdate = in.InstanceUpdate
+	out.BindingCreate = in.BindingCreate
+	return nil
+}
+
+func deepCopy_api_BackingServiceBinding(in backingserviceinstanceapi.BackingServiceBinding, out *backingserviceinstanceapi.BackingServiceBinding, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_BackingServiceBindingSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_BackingServiceBindingStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceBindingCondition(in backingserviceinstanceapi.BackingServiceBindingCondition, out *backingserviceinstanceapi.BackingServiceBindingCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_api_BackingServiceBindingList(in backingserviceinstanceapi.BackingServiceBindingList, out *backingserviceinstanceapi.BackingServiceBindingList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapi.BackingServiceBinding, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_BackingServiceBinding(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceBindingRotation(in backingserviceinstanceapi.BackingServiceBindingRotation, out *backingserviceinstanceapi.BackingServiceBindingRotation, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.StartTime); err != nil {
+		return err
+	} else {
+		out.StartTime = newVal.(unversioned.Time)
+	}
+	out.BindUuid = in.BindUuid
+	out.RetiredBindUuid = in.RetiredBindUuid
+	out.RetiredCredentialsSecret = in.RetiredCredentialsSecret
+	out.LatestVersion = in.LatestVersion
+	return nil
+}
+
+func deepCopy_api_BackingServiceBindingSpec(in backingserviceinstanceapi.BackingServiceBindingSpec, out *backingserviceinstanceapi.BackingServiceBindingSpec, c *conversion.Cloner) error {
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BindKind = in.BindKind
+	out.ResourceName = in.ResourceName
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]string)
+		for key, val := range in.Parameters {
+			out.Parameters[key] = val
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.RotationPeriod != nil {
+		if newVal, err := c.DeepCopy(in.RotationPeriod); err != nil {
+			return err
+		} else {
+			out.RotationPeriod = newVal.(*unversioned.Duration)
+		}
+	} else {
+		out.RotationPeriod = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceBindingStatus(in backingserviceinstanceapi.BackingServiceBindingStatus, out *backingserviceinstanceapi.BackingServiceBindingStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.BindUuid = in.BindUuid
+	if in.BoundTime != nil {
+		if newVal, err := c.DeepCopy(in.BoundTime); err != nil {
+			return err
+		} else {
+			out.BoundTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.BoundTime = nil
+	}
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Conditions != nil {
+		out.Conditions = make([]backingserviceinstanceapi.BackingServiceBindingCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_BackingServiceBindingCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.RouteServiceURL = in.RouteServiceURL
+	if in.RotatedTime != nil {
+		if newVal, err := c.DeepCopy(in.RotatedTime); err != nil {
+			return err
+		} else {
+			out.RotatedTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.RotatedTime = nil
+	}
+	out.RotationRequest = in.RotationRequest
+	if in.Rotation != nil {
+		out.Rotation = new(backingserviceinstanceapi.BackingServiceBindingRotation)
+		if err := deepCopy_api_BackingServiceBindingRotation(*in.Rotation, out.Rotation, c); err != nil {
+			return err
+		}
+	} else {
+		out.Rotation = nil
+	}
+	return nil
+}
+
 func deepCopy_api_BackingServiceInstance(in backingserviceinstanceapi.BackingServiceInstance, out *backingserviceinstanceapi.BackingServiceInstance, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -976,6 +1270,151 @@ func deepCopy_api_BackingServiceInstanceStatus(in backingserviceinstanceapi.Back
 	} else {
 		out.LastOperation = nil
 	}
+	out.AppliedPlanGuid = in.AppliedPlanGuid
+	if in.AppliedParameters != nil {
+		out.AppliedParameters = make(map[string]string)
+		for key, val := range in.AppliedParameters {
+			out.AppliedParameters[key] = val
+		}
+	} else {
+		out.AppliedParameters = nil
+	}
+	if in.Deletion != nil {
+		out.Deletion = new(backingserviceinstanceapi.InstanceDeletionStatus)
+		if err := deepCopy_api_InstanceDeletionStatus(*in.Deletion, out.Deletion, c); err != nil {
+			return err
+		}
+	} else {
+		out.Deletion = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceUsage(in backingserviceinstanceapi.BackingServiceUsage, out *backingserviceinstanceapi.BackingServiceUsage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BackingServiceInstanceUID = in.BackingServiceInstanceUID
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	if in.Costs != nil {
+		out.Costs = make([]backingserviceinstanceapi.UsageCost, len(in.Costs))
+		for i := range in.Costs {
+			if err := deepCopy_api_UsageCost(in.Costs[i], &out.Costs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Costs = nil
+	}
+	if newVal, err := c.DeepCopy(in.Start); err != nil {
+		return err
+	} else {
+		out.Start = newVal.(unversioned.Time)
+	}
+	if in.End != nil {
+		if newVal, err := c.DeepCopy(in.End); err != nil {
+			return err
+		} else {
+			out.End = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceUsageList(in backingserviceinstanceapi.BackingServiceUsageList, out *backingserviceinstanceapi.BackingServiceUsageList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapi.BackingServiceUsage, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_BackingServiceUsage(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceUsageReport(in backingserviceinstanceapi.BackingServiceUsageReport, out *backingserviceinstanceapi.BackingServiceUsageReport, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_BackingServiceUsageReportSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_BackingServiceUsageReportStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceUsageReportSpec(in backingserviceinstanceapi.BackingServiceUsageReportSpec, out *backingserviceinstanceapi.BackingServiceUsageReportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Start); err != nil {
+		return err
+	} else {
+		out.Start = newVal.(unversioned.Time)
+	}
+	if in.End != nil {
+		if newVal, err := c.DeepCopy(in.End); err != nil {
+			return err
+		} else {
+			out.End = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BackingServiceUsageReportStatus(in backingserviceinstanceapi.BackingServiceUsageReportStatus, out *backingserviceinstanceapi.BackingServiceUsageReportStatus, c *conversion.Cloner) error {
+	if in.Plans != nil {
+		out.Plans = make([]backingserviceinstanceapi.PlanUsage, len(in.Plans))
+		for i := range in.Plans {
+			if err := deepCopy_api_PlanUsage(in.Plans[i], &out.Plans[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Total != nil {
+		out.Total = make(map[string]float64)
+		for key, val := range in.Total {
+			out.Total[key] = val
+		}
+	} else {
+		out.Total = nil
+	}
 	return nil
 }
 
@@ -993,6 +1432,8 @@ func deepCopy_api_BindingRequestOptions(in backingserviceinstanceapi.BindingRequ
 	out.BindKind = in.BindKind
 	out.BindResourceVersion = in.BindResourceVersion
 	out.ResourceName = in.ResourceName
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -1007,6 +1448,7 @@ func deepCopy_api_InstanceBinding(in backingserviceinstanceapi.InstanceBinding,
 	} else {
 		out.BoundTime = nil
 	}
+	out.BindKind = in.BindKind
 	out.BindDeploymentConfig = in.BindDeploymentConfig
 	if in.Credentials != nil {
 		out.Credentials = make(map[string]string)
@@ -1016,12 +1458,30 @@ func deepCopy_api_InstanceBinding(in backingserviceinstanceapi.InstanceBinding,
 	} else {
 		out.Credentials = nil
 	}
+	out.CredentialsSecret = in.CredentialsSecret
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
+	return nil
+}
+
+func deepCopy_api_InstanceDeletionStatus(in backingserviceinstanceapi.InstanceDeletionStatus, out *backingserviceinstanceapi.InstanceDeletionStatus, c *conversion.Cloner) error {
+	out.RemainingBindings = in.RemainingBindings
+	if in.FailedBindings != nil {
+		out.FailedBindings = make([]string, len(in.FailedBindings))
+		for i := range in.FailedBindings {
+			out.FailedBindings[i] = in.FailedBindings[i]
+		}
+	} else {
+		out.FailedBindings = nil
+	}
+	out.Message = in.Message
 	return nil
 }
 
 func deepCopy_api_InstanceProvisioning(in backingserviceinstanceapi.InstanceProvisioning, out *backingserviceinstanceapi.InstanceProvisioning, c *conversion.Cloner) error {
 	out.DashboardUrl = in.DashboardUrl
 	out.BackingServiceName = in.BackingServiceName
+	out.BackingServiceNamespace = in.BackingServiceNamespace
 	out.BackingServiceSpecID = in.BackingServiceSpecID
 	out.BackingServicePlanGuid = in.BackingServicePlanGuid
 	out.BackingServicePlanName = in.BackingServicePlanName
@@ -1040,6 +1500,47 @@ func deepCopy_api_LastOperation(in backingserviceinstanceapi.LastOperation, out
 	out.State = in.State
 	out.Description = in.Description
 	out.AsyncPollIntervalSeconds = in.AsyncPollIntervalSeconds
+	out.Operation = in.Operation
+	out.Mitigation = in.Mitigation
+	return nil
+}
+
+func deepCopy_api_PlanUsage(in backingserviceinstanceapi.PlanUsage, out *backingserviceinstanceapi.PlanUsage, c *conversion.Cloner) error {
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	out.Instances = in.Instances
+	out.Hours = in.Hours
+	if in.Cost != nil {
+		out.Cost = make(map[string]float64)
+		for key, val := range in.Cost {
+			out.Cost[key] = val
+		}
+	} else {
+		out.Cost = nil
+	}
+	if in.UnmeteredUnits != nil {
+		out.UnmeteredUnits = make([]string, len(in.UnmeteredUnits))
+		for i := range in.UnmeteredUnits {
+			out.UnmeteredUnits[i] = in.UnmeteredUnits[i]
+		}
+	} else {
+		out.UnmeteredUnits = nil
+	}
+	return nil
+}
+
+func deepCopy_api_UsageCost(in backingserviceinstanceapi.UsageCost, out *backingserviceinstanceapi.UsageCost, c *conversion.Cloner) error {
+	if in.Amount != nil {
+		out.Amount = make(map[string]float64)
+		for key, val := range in.Amount {
+			out.Amount[key] = val
+		}
+	} else {
+		out.Amount = nil
+	}
+	out.Unit = in.Unit
 	return nil
 }
 
@@ -3031,6 +3532,56 @@ func deepCopy_api_NetNamespaceList(in sdnapi.NetNamespaceList, out *sdnapi.NetNa
 	return nil
 }
 
+func deepCopy_api_ProjectServiceBroker(in servicebrokerapi.ProjectServiceBroker, out *servicebrokerapi.ProjectServiceBroker, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_ServiceBrokerSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ServiceBrokerStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_ProjectServiceBrokerList(in servicebrokerapi.ProjectServiceBrokerList, out *servicebrokerapi.ProjectServiceBrokerList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]servicebrokerapi.ProjectServiceBroker, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ProjectServiceBroker(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_SecretReference(in servicebrokerapi.SecretReference, out *servicebrokerapi.SecretReference, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_api_ServiceBroker(in servicebrokerapi.ServiceBroker, out *servicebrokerapi.ServiceBroker, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -3051,6 +3602,31 @@ func deepCopy_api_ServiceBroker(in servicebrokerapi.ServiceBroker, out *serviceb
 	return nil
 }
 
+func deepCopy_api_ServiceBrokerCondition(in servicebrokerapi.ServiceBrokerCondition, out *servicebrokerapi.ServiceBrokerCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastProbeTime); err != nil {
+		return err
+	} else {
+		out.LastProbeTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_api_ServiceBrokerHealthCheck(in servicebrokerapi.ServiceBrokerHealthCheck, out *servicebrokerapi.ServiceBrokerHealthCheck, c *conversion.Cloner) error {
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.FailureThreshold = in.FailureThreshold
+	return nil
+}
+
 func deepCopy_api_ServiceBrokerList(in servicebrokerapi.ServiceBrokerList, out *servicebrokerapi.ServiceBrokerList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -3080,6 +3656,57 @@ func deepCopy_api_ServiceBrokerSpec(in servicebrokerapi.ServiceBrokerSpec, out *
 	out.Name = in.Name
 	out.UserName = in.UserName
 	out.Password = in.Password
+	if in.AuthSecretRef != nil {
+		out.AuthSecretRef = new(servicebrokerapi.SecretReference)
+		if err := deepCopy_api_SecretReference(*in.AuthSecretRef, out.AuthSecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.AuthSecretRef = nil
+	}
+	if in.CABundle != nil {
+		out.CABundle = make([]uint8, len(in.CABundle))
+		for i := range in.CABundle {
+			out.CABundle[i] = in.CABundle[i]
+		}
+	} else {
+		out.CABundle = nil
+	}
+	if in.CASecretRef != nil {
+		out.CASecretRef = new(servicebrokerapi.SecretReference)
+		if err := deepCopy_api_SecretReference(*in.CASecretRef, out.CASecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.CASecretRef = nil
+	}
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	if in.ClientCertSecretRef != nil {
+		out.ClientCertSecretRef = new(servicebrokerapi.SecretReference)
+		if err := deepCopy_api_SecretReference(*in.ClientCertSecretRef, out.ClientCertSecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.Visibility != nil {
+		out.Visibility = make([]servicebrokerapi.ServiceVisibility, len(in.Visibility))
+		for i := range in.Visibility {
+			if err := deepCopy_api_ServiceVisibility(in.Visibility[i], &out.Visibility[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Visibility = nil
+	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(servicebrokerapi.ServiceBrokerHealthCheck)
+		if err := deepCopy_api_ServiceBrokerHealthCheck(*in.HealthCheck, out.HealthCheck, c); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapi.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -3093,6 +3720,55 @@ func deepCopy_api_ServiceBrokerSpec(in servicebrokerapi.ServiceBrokerSpec, out *
 
 func deepCopy_api_ServiceBrokerStatus(in servicebrokerapi.ServiceBrokerStatus, out *servicebrokerapi.ServiceBrokerStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.Conditions != nil {
+		out.Conditions = make([]servicebrokerapi.ServiceBrokerCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_ServiceBrokerCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.CatalogHash = in.CatalogHash
+	out.ServiceCount = in.ServiceCount
+	return nil
+}
+
+func deepCopy_api_ServiceVisibility(in servicebrokerapi.ServiceVisibility, out *servicebrokerapi.ServiceVisibility, c *conversion.Cloner) error {
+	if in.Services != nil {
+		out.Services = make([]string, len(in.Services))
+		for i := range in.Services {
+			out.Services[i] = in.Services[i]
+		}
+	} else {
+		out.Services = nil
+	}
+	if in.Plans != nil {
+		out.Plans = make([]string, len(in.Plans))
+		for i := range in.Plans {
+			out.Plans[i] = in.Plans[i]
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	if in.NamespaceSelector != nil {
+		out.NamespaceSelector = make(map[string]string)
+		for key, val := range in.NamespaceSelector {
+			out.NamespaceSelector[key] = val
+		}
+	} else {
+		out.NamespaceSelector = nil
+	}
 	return nil
 }
 
@@ -3358,7 +4034,14 @@ func deepCopy_api_UserList(in userapi.UserList, out *userapi.UserList, c *conver
 func init() {
 	err := pkgapi.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_api_Application,
+		deepCopy_api_ApplicationCondition,
+		deepCopy_api_ApplicationItemStatus,
 		deepCopy_api_ApplicationList,
+		deepCopy_api_ApplicationPromotion,
+		deepCopy_api_ApplicationPromotionItemStatus,
+		deepCopy_api_ApplicationPromotionList,
+		deepCopy_api_ApplicationPromotionSpec,
+		deepCopy_api_ApplicationPromotionStatus,
 		deepCopy_api_ApplicationSpec,
 		deepCopy_api_ApplicationStatus,
 		deepCopy_api_Item,
@@ -3394,14 +4077,29 @@ func init() {
 		deepCopy_api_ServicePlan,
 		deepCopy_api_ServicePlanCost,
 		deepCopy_api_ServicePlanMetadata,
+		deepCopy_api_ServicePlanSchemas,
+		deepCopy_api_BackingServiceBinding,
+		deepCopy_api_BackingServiceBindingCondition,
+		deepCopy_api_BackingServiceBindingList,
+		deepCopy_api_BackingServiceBindingRotation,
+		deepCopy_api_BackingServiceBindingSpec,
+		deepCopy_api_BackingServiceBindingStatus,
 		deepCopy_api_BackingServiceInstance,
 		deepCopy_api_BackingServiceInstanceList,
 		deepCopy_api_BackingServiceInstanceSpec,
 		deepCopy_api_BackingServiceInstanceStatus,
+		deepCopy_api_BackingServiceUsage,
+		deepCopy_api_BackingServiceUsageList,
+		deepCopy_api_BackingServiceUsageReport,
+		deepCopy_api_BackingServiceUsageReportSpec,
+		deepCopy_api_BackingServiceUsageReportStatus,
 		deepCopy_api_BindingRequestOptions,
 		deepCopy_api_InstanceBinding,
+		deepCopy_api_InstanceDeletionStatus,
 		deepCopy_api_InstanceProvisioning,
 		deepCopy_api_LastOperation,
+		deepCopy_api_PlanUsage,
+		deepCopy_api_UsageCost,
 		deepCopy_api_BinaryBuildRequestOptions,
 		deepCopy_api_BinaryBuildSource,
 		deepCopy_api_Build,
@@ -3490,10 +4188,16 @@ func init() {
 		deepCopy_api_HostSubnetList,
 		deepCopy_api_NetNamespace,
 		deepCopy_api_NetNamespaceList,
+		deepCopy_api_ProjectServiceBroker,
+		deepCopy_api_ProjectServiceBrokerList,
+		deepCopy_api_SecretReference,
 		deepCopy_api_ServiceBroker,
+		deepCopy_api_ServiceBrokerCondition,
+		deepCopy_api_ServiceBrokerHealthCheck,
 		deepCopy_api_ServiceBrokerList,
 		deepCopy_api_ServiceBrokerSpec,
 		deepCopy_api_ServiceBrokerStatus,
+		deepCopy_api_ServiceVisibility,
 		deepCopy_api_Parameter,
 		deepCopy_api_Template,
 		deepCopy_api_TemplateList,
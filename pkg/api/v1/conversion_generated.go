@@ -60,6 +60,40 @@ func convert_api_Application_To_v1_Application(in *api.Application, out *v1.Appl
 	return autoconvert_api_Application_To_v1_Application(in, out, s)
 }
 
+func autoconvert_api_ApplicationCondition_To_v1_ApplicationCondition(in *api.ApplicationCondition, out *v1.ApplicationCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationCondition))(in)
+	}
+	out.Type = v1.ApplicationConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_ApplicationCondition_To_v1_ApplicationCondition(in *api.ApplicationCondition, out *v1.ApplicationCondition, s conversion.Scope) error {
+	return autoconvert_api_ApplicationCondition_To_v1_ApplicationCondition(in, out, s)
+}
+
+func autoconvert_api_ApplicationItemStatus_To_v1_ApplicationItemStatus(in *api.ApplicationItemStatus, out *v1.ApplicationItemStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationItemStatus))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Health = v1.ApplicationItemHealth(in.Health)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_ApplicationItemStatus_To_v1_ApplicationItemStatus(in *api.ApplicationItemStatus, out *v1.ApplicationItemStatus, s conversion.Scope) error {
+	return autoconvert_api_ApplicationItemStatus_To_v1_ApplicationItemStatus(in, out, s)
+}
+
 func autoconvert_api_ApplicationList_To_v1_ApplicationList(in *api.ApplicationList, out *v1.ApplicationList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.ApplicationList))(in)
@@ -87,6 +121,129 @@ func convert_api_ApplicationList_To_v1_ApplicationList(in *api.ApplicationList,
 	return autoconvert_api_ApplicationList_To_v1_ApplicationList(in, out, s)
 }
 
+func autoconvert_api_ApplicationPromotion_To_v1_ApplicationPromotion(in *api.ApplicationPromotion, out *v1.ApplicationPromotion, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationPromotion))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ApplicationPromotionSpec_To_v1_ApplicationPromotionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ApplicationPromotionStatus_To_v1_ApplicationPromotionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ApplicationPromotion_To_v1_ApplicationPromotion(in *api.ApplicationPromotion, out *v1.ApplicationPromotion, s conversion.Scope) error {
+	return autoconvert_api_ApplicationPromotion_To_v1_ApplicationPromotion(in, out, s)
+}
+
+func autoconvert_api_ApplicationPromotionItemStatus_To_v1_ApplicationPromotionItemStatus(in *api.ApplicationPromotionItemStatus, out *v1.ApplicationPromotionItemStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationPromotionItemStatus))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = v1.ApplicationPromotionItemPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_ApplicationPromotionItemStatus_To_v1_ApplicationPromotionItemStatus(in *api.ApplicationPromotionItemStatus, out *v1.ApplicationPromotionItemStatus, s conversion.Scope) error {
+	return autoconvert_api_ApplicationPromotionItemStatus_To_v1_ApplicationPromotionItemStatus(in, out, s)
+}
+
+func autoconvert_api_ApplicationPromotionList_To_v1_ApplicationPromotionList(in *api.ApplicationPromotionList, out *v1.ApplicationPromotionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationPromotionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationPromotion, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ApplicationPromotion_To_v1_ApplicationPromotion(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ApplicationPromotionList_To_v1_ApplicationPromotionList(in *api.ApplicationPromotionList, out *v1.ApplicationPromotionList, s conversion.Scope) error {
+	return autoconvert_api_ApplicationPromotionList_To_v1_ApplicationPromotionList(in, out, s)
+}
+
+func autoconvert_api_ApplicationPromotionSpec_To_v1_ApplicationPromotionSpec(in *api.ApplicationPromotionSpec, out *v1.ApplicationPromotionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationPromotionSpec))(in)
+	}
+	out.Application = in.Application
+	out.TargetNamespace = in.TargetNamespace
+	if in.Plans != nil {
+		out.Plans = make(map[string]string)
+		for key, val := range in.Plans {
+			out.Plans[key] = val
+		}
+	} else {
+		out.Plans = nil
+	}
+	return nil
+}
+
+func convert_api_ApplicationPromotionSpec_To_v1_ApplicationPromotionSpec(in *api.ApplicationPromotionSpec, out *v1.ApplicationPromotionSpec, s conversion.Scope) error {
+	return autoconvert_api_ApplicationPromotionSpec_To_v1_ApplicationPromotionSpec(in, out, s)
+}
+
+func autoconvert_api_ApplicationPromotionStatus_To_v1_ApplicationPromotionStatus(in *api.ApplicationPromotionStatus, out *v1.ApplicationPromotionStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ApplicationPromotionStatus))(in)
+	}
+	out.Phase = v1.ApplicationPromotionPhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationPromotionItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ApplicationPromotionItemStatus_To_v1_ApplicationPromotionItemStatus(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ApplicationPromotionStatus_To_v1_ApplicationPromotionStatus(in *api.ApplicationPromotionStatus, out *v1.ApplicationPromotionStatus, s conversion.Scope) error {
+	return autoconvert_api_ApplicationPromotionStatus_To_v1_ApplicationPromotionStatus(in, out, s)
+}
+
 func autoconvert_api_ApplicationSpec_To_v1_ApplicationSpec(in *api.ApplicationSpec, out *v1.ApplicationSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.ApplicationSpec))(in)
@@ -123,6 +280,26 @@ func autoconvert_api_ApplicationStatus_To_v1_ApplicationStatus(in *api.Applicati
 		defaulting.(func(*api.ApplicationStatus))(in)
 	}
 	out.Phase = v1.ApplicationPhase(in.Phase)
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ApplicationItemStatus_To_v1_ApplicationItemStatus(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]v1.ApplicationCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_ApplicationCondition_To_v1_ApplicationCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -167,6 +344,40 @@ func convert_v1_Application_To_api_Application(in *v1.Application, out *api.Appl
 	return autoconvert_v1_Application_To_api_Application(in, out, s)
 }
 
+func autoconvert_v1_ApplicationCondition_To_api_ApplicationCondition(in *v1.ApplicationCondition, out *api.ApplicationCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationCondition))(in)
+	}
+	out.Type = api.ApplicationConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_ApplicationCondition_To_api_ApplicationCondition(in *v1.ApplicationCondition, out *api.ApplicationCondition, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationCondition_To_api_ApplicationCondition(in, out, s)
+}
+
+func autoconvert_v1_ApplicationItemStatus_To_api_ApplicationItemStatus(in *v1.ApplicationItemStatus, out *api.ApplicationItemStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationItemStatus))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Health = api.ApplicationItemHealth(in.Health)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_ApplicationItemStatus_To_api_ApplicationItemStatus(in *v1.ApplicationItemStatus, out *api.ApplicationItemStatus, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationItemStatus_To_api_ApplicationItemStatus(in, out, s)
+}
+
 func autoconvert_v1_ApplicationList_To_api_ApplicationList(in *v1.ApplicationList, out *api.ApplicationList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.ApplicationList))(in)
@@ -194,6 +405,129 @@ func convert_v1_ApplicationList_To_api_ApplicationList(in *v1.ApplicationList, o
 	return autoconvert_v1_ApplicationList_To_api_ApplicationList(in, out, s)
 }
 
+func autoconvert_v1_ApplicationPromotion_To_api_ApplicationPromotion(in *v1.ApplicationPromotion, out *api.ApplicationPromotion, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationPromotion))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ApplicationPromotionSpec_To_api_ApplicationPromotionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ApplicationPromotionStatus_To_api_ApplicationPromotionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ApplicationPromotion_To_api_ApplicationPromotion(in *v1.ApplicationPromotion, out *api.ApplicationPromotion, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationPromotion_To_api_ApplicationPromotion(in, out, s)
+}
+
+func autoconvert_v1_ApplicationPromotionItemStatus_To_api_ApplicationPromotionItemStatus(in *v1.ApplicationPromotionItemStatus, out *api.ApplicationPromotionItemStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationPromotionItemStatus))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = api.ApplicationPromotionItemPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_ApplicationPromotionItemStatus_To_api_ApplicationPromotionItemStatus(in *v1.ApplicationPromotionItemStatus, out *api.ApplicationPromotionItemStatus, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationPromotionItemStatus_To_api_ApplicationPromotionItemStatus(in, out, s)
+}
+
+func autoconvert_v1_ApplicationPromotionList_To_api_ApplicationPromotionList(in *v1.ApplicationPromotionList, out *api.ApplicationPromotionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationPromotionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationPromotion, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ApplicationPromotion_To_api_ApplicationPromotion(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ApplicationPromotionList_To_api_ApplicationPromotionList(in *v1.ApplicationPromotionList, out *api.ApplicationPromotionList, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationPromotionList_To_api_ApplicationPromotionList(in, out, s)
+}
+
+func autoconvert_v1_ApplicationPromotionSpec_To_api_ApplicationPromotionSpec(in *v1.ApplicationPromotionSpec, out *api.ApplicationPromotionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationPromotionSpec))(in)
+	}
+	out.Application = in.Application
+	out.TargetNamespace = in.TargetNamespace
+	if in.Plans != nil {
+		out.Plans = make(map[string]string)
+		for key, val := range in.Plans {
+			out.Plans[key] = val
+		}
+	} else {
+		out.Plans = nil
+	}
+	return nil
+}
+
+func convert_v1_ApplicationPromotionSpec_To_api_ApplicationPromotionSpec(in *v1.ApplicationPromotionSpec, out *api.ApplicationPromotionSpec, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationPromotionSpec_To_api_ApplicationPromotionSpec(in, out, s)
+}
+
+func autoconvert_v1_ApplicationPromotionStatus_To_api_ApplicationPromotionStatus(in *v1.ApplicationPromotionStatus, out *api.ApplicationPromotionStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationPromotionStatus))(in)
+	}
+	out.Phase = api.ApplicationPromotionPhase(in.Phase)
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationPromotionItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ApplicationPromotionItemStatus_To_api_ApplicationPromotionItemStatus(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ApplicationPromotionStatus_To_api_ApplicationPromotionStatus(in *v1.ApplicationPromotionStatus, out *api.ApplicationPromotionStatus, s conversion.Scope) error {
+	return autoconvert_v1_ApplicationPromotionStatus_To_api_ApplicationPromotionStatus(in, out, s)
+}
+
 func autoconvert_v1_ApplicationSpec_To_api_ApplicationSpec(in *v1.ApplicationSpec, out *api.ApplicationSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.ApplicationSpec))(in)
@@ -230,6 +564,26 @@ func autoconvert_v1_ApplicationStatus_To_api_ApplicationStatus(in *v1.Applicatio
 		defaulting.(func(*v1.ApplicationStatus))(in)
 	}
 	out.Phase = api.ApplicationPhase(in.Phase)
+	if in.Items != nil {
+		out.Items = make([]api.ApplicationItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ApplicationItemStatus_To_api_ApplicationItemStatus(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]api.ApplicationCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_ApplicationCondition_To_api_ApplicationCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1422,6 +1776,10 @@ func autoconvert_api_ServicePlan_To_v1_ServicePlan(in *backingserviceapi.Service
 		return err
 	}
 	out.Free = in.Free
+	out.Deprecated = in.Deprecated
+	if err := convert_api_ServicePlanSchemas_To_v1_ServicePlanSchemas(&in.Schemas, &out.Schemas, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1479,12 +1837,26 @@ func convert_api_ServicePlanMetadata_To_v1_ServicePlanMetadata(in *backingservic
 	return autoconvert_api_ServicePlanMetadata_To_v1_ServicePlanMetadata(in, out, s)
 }
 
-func autoconvert_v1_BackingService_To_api_BackingService(in *backingserviceapiv1.BackingService, out *backingserviceapi.BackingService, s conversion.Scope) error {
+func autoconvert_api_ServicePlanSchemas_To_v1_ServicePlanSchemas(in *backingserviceapi.ServicePlanSchemas, out *backingserviceapiv1.ServicePlanSchemas, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*backingserviceapiv1.BackingService))(in)
+		defaulting.(func(*backingserviceapi.ServicePlanSchemas))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+	out.InstanceCreate = in.InstanceCreate
+	out.InstanceUpdate = in.InstanceUpdate
+	out.BindingCreate = in.BindingCreate
+	return nil
+}
+
+func convert_api_ServicePlanSchemas_To_v1_ServicePlanSchemas(in *backingserviceapi.ServicePlanSchemas, out *backingserviceapiv1.ServicePlanSchemas, s conversion.Scope) error {
+	return autoconvert_api_ServicePlanSchemas_To_v1_ServicePlanSchemas(in, out, s)
+}
+
+func autoconvert_v1_BackingService_To_api_BackingService(in *backingserviceapiv1.BackingService, out *backingserviceapi.BackingService, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceapiv1.BackingService))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
 	}
 	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -1610,6 +1982,10 @@ func autoconvert_v1_ServicePlan_To_api_ServicePlan(in *backingserviceapiv1.Servi
 		return err
 	}
 	out.Free = in.Free
+	out.Deprecated = in.Deprecated
+	if err := convert_v1_ServicePlanSchemas_To_api_ServicePlanSchemas(&in.Schemas, &out.Schemas, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1667,6 +2043,185 @@ func convert_v1_ServicePlanMetadata_To_api_ServicePlanMetadata(in *backingservic
 	return autoconvert_v1_ServicePlanMetadata_To_api_ServicePlanMetadata(in, out, s)
 }
 
+func autoconvert_v1_ServicePlanSchemas_To_api_ServicePlanSchemas(in *backingserviceapiv1.ServicePlanSchemas, out *backingserviceapi.ServicePlanSchemas, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceapiv1.ServicePlanSchemas))(in)
+	}
+	out.InstanceCreate = in.InstanceCreate
+	out.InstanceUpdate = in.InstanceUpdate
+	out.BindingCreate = in.BindingCreate
+	return nil
+}
+
+func convert_v1_ServicePlanSchemas_To_api_ServicePlanSchemas(in *backingserviceapiv1.ServicePlanSchemas, out *backingserviceapi.ServicePlanSchemas, s conversion.Scope) error {
+	return autoconvert_v1_ServicePlanSchemas_To_api_ServicePlanSchemas(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBinding_To_v1_BackingServiceBinding(in *backingserviceinstanceapi.BackingServiceBinding, out *backingserviceinstanceapiv1.BackingServiceBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_BackingServiceBindingSpec_To_v1_BackingServiceBindingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_BackingServiceBindingStatus_To_v1_BackingServiceBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_BackingServiceBinding_To_v1_BackingServiceBinding(in *backingserviceinstanceapi.BackingServiceBinding, out *backingserviceinstanceapiv1.BackingServiceBinding, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBinding_To_v1_BackingServiceBinding(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBindingCondition_To_v1_BackingServiceBindingCondition(in *backingserviceinstanceapi.BackingServiceBindingCondition, out *backingserviceinstanceapiv1.BackingServiceBindingCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBindingCondition))(in)
+	}
+	out.Type = backingserviceinstanceapiv1.BackingServiceBindingConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_BackingServiceBindingCondition_To_v1_BackingServiceBindingCondition(in *backingserviceinstanceapi.BackingServiceBindingCondition, out *backingserviceinstanceapiv1.BackingServiceBindingCondition, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBindingCondition_To_v1_BackingServiceBindingCondition(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBindingList_To_v1_BackingServiceBindingList(in *backingserviceinstanceapi.BackingServiceBindingList, out *backingserviceinstanceapiv1.BackingServiceBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapiv1.BackingServiceBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_BackingServiceBinding_To_v1_BackingServiceBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceBindingList_To_v1_BackingServiceBindingList(in *backingserviceinstanceapi.BackingServiceBindingList, out *backingserviceinstanceapiv1.BackingServiceBindingList, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBindingList_To_v1_BackingServiceBindingList(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBindingRotation_To_v1_BackingServiceBindingRotation(in *backingserviceinstanceapi.BackingServiceBindingRotation, out *backingserviceinstanceapiv1.BackingServiceBindingRotation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBindingRotation))(in)
+	}
+	if err := s.Convert(&in.StartTime, &out.StartTime, 0); err != nil {
+		return err
+	}
+	out.BindUuid = in.BindUuid
+	out.RetiredBindUuid = in.RetiredBindUuid
+	out.RetiredCredentialsSecret = in.RetiredCredentialsSecret
+	out.LatestVersion = in.LatestVersion
+	return nil
+}
+
+func convert_api_BackingServiceBindingRotation_To_v1_BackingServiceBindingRotation(in *backingserviceinstanceapi.BackingServiceBindingRotation, out *backingserviceinstanceapiv1.BackingServiceBindingRotation, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBindingRotation_To_v1_BackingServiceBindingRotation(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBindingSpec_To_v1_BackingServiceBindingSpec(in *backingserviceinstanceapi.BackingServiceBindingSpec, out *backingserviceinstanceapiv1.BackingServiceBindingSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBindingSpec))(in)
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BindKind = in.BindKind
+	out.ResourceName = in.ResourceName
+	out.Injection = backingserviceinstanceapiv1.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]string)
+		for key, val := range in.Parameters {
+			out.Parameters[key] = val
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.RotationPeriod != nil {
+		if err := s.Convert(&in.RotationPeriod, &out.RotationPeriod, 0); err != nil {
+			return err
+		}
+	} else {
+		out.RotationPeriod = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceBindingSpec_To_v1_BackingServiceBindingSpec(in *backingserviceinstanceapi.BackingServiceBindingSpec, out *backingserviceinstanceapiv1.BackingServiceBindingSpec, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBindingSpec_To_v1_BackingServiceBindingSpec(in, out, s)
+}
+
+func autoconvert_api_BackingServiceBindingStatus_To_v1_BackingServiceBindingStatus(in *backingserviceinstanceapi.BackingServiceBindingStatus, out *backingserviceinstanceapiv1.BackingServiceBindingStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceBindingStatus))(in)
+	}
+	out.Phase = backingserviceinstanceapiv1.BackingServiceBindingPhase(in.Phase)
+	out.BindUuid = in.BindUuid
+	if in.BoundTime != nil {
+		if err := s.Convert(&in.BoundTime, &out.BoundTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.BoundTime = nil
+	}
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Conditions != nil {
+		out.Conditions = make([]backingserviceinstanceapiv1.BackingServiceBindingCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_BackingServiceBindingCondition_To_v1_BackingServiceBindingCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.RouteServiceURL = in.RouteServiceURL
+	if in.RotatedTime != nil {
+		if err := s.Convert(&in.RotatedTime, &out.RotatedTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.RotatedTime = nil
+	}
+	out.RotationRequest = in.RotationRequest
+	if in.Rotation != nil {
+		out.Rotation = new(backingserviceinstanceapiv1.BackingServiceBindingRotation)
+		if err := convert_api_BackingServiceBindingRotation_To_v1_BackingServiceBindingRotation(in.Rotation, out.Rotation, s); err != nil {
+			return err
+		}
+	} else {
+		out.Rotation = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceBindingStatus_To_v1_BackingServiceBindingStatus(in *backingserviceinstanceapi.BackingServiceBindingStatus, out *backingserviceinstanceapiv1.BackingServiceBindingStatus, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceBindingStatus_To_v1_BackingServiceBindingStatus(in, out, s)
+}
+
 func autoconvert_api_BackingServiceInstance_To_v1_BackingServiceInstance(in *backingserviceinstanceapi.BackingServiceInstance, out *backingserviceinstanceapiv1.BackingServiceInstance, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*backingserviceinstanceapi.BackingServiceInstance))(in)
@@ -1765,6 +2320,23 @@ func autoconvert_api_BackingServiceInstanceStatus_To_v1_BackingServiceInstanceSt
 	} else {
 		out.LastOperation = nil
 	}
+	out.AppliedPlanGuid = in.AppliedPlanGuid
+	if in.AppliedParameters != nil {
+		out.AppliedParameters = make(map[string]string)
+		for key, val := range in.AppliedParameters {
+			out.AppliedParameters[key] = val
+		}
+	} else {
+		out.AppliedParameters = nil
+	}
+	if in.Deletion != nil {
+		out.Deletion = new(backingserviceinstanceapiv1.InstanceDeletionStatus)
+		if err := convert_api_InstanceDeletionStatus_To_v1_InstanceDeletionStatus(in.Deletion, out.Deletion, s); err != nil {
+			return err
+		}
+	} else {
+		out.Deletion = nil
+	}
 	return nil
 }
 
@@ -1772,6 +2344,149 @@ func convert_api_BackingServiceInstanceStatus_To_v1_BackingServiceInstanceStatus
 	return autoconvert_api_BackingServiceInstanceStatus_To_v1_BackingServiceInstanceStatus(in, out, s)
 }
 
+func autoconvert_api_BackingServiceUsage_To_v1_BackingServiceUsage(in *backingserviceinstanceapi.BackingServiceUsage, out *backingserviceinstanceapiv1.BackingServiceUsage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceUsage))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BackingServiceInstanceUID = in.BackingServiceInstanceUID
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	if in.Costs != nil {
+		out.Costs = make([]backingserviceinstanceapiv1.UsageCost, len(in.Costs))
+		for i := range in.Costs {
+			if err := convert_api_UsageCost_To_v1_UsageCost(&in.Costs[i], &out.Costs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Costs = nil
+	}
+	if err := s.Convert(&in.Start, &out.Start, 0); err != nil {
+		return err
+	}
+	if in.End != nil {
+		if err := s.Convert(&in.End, &out.End, 0); err != nil {
+			return err
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceUsage_To_v1_BackingServiceUsage(in *backingserviceinstanceapi.BackingServiceUsage, out *backingserviceinstanceapiv1.BackingServiceUsage, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceUsage_To_v1_BackingServiceUsage(in, out, s)
+}
+
+func autoconvert_api_BackingServiceUsageList_To_v1_BackingServiceUsageList(in *backingserviceinstanceapi.BackingServiceUsageList, out *backingserviceinstanceapiv1.BackingServiceUsageList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceUsageList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapiv1.BackingServiceUsage, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_BackingServiceUsage_To_v1_BackingServiceUsage(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceUsageList_To_v1_BackingServiceUsageList(in *backingserviceinstanceapi.BackingServiceUsageList, out *backingserviceinstanceapiv1.BackingServiceUsageList, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceUsageList_To_v1_BackingServiceUsageList(in, out, s)
+}
+
+func autoconvert_api_BackingServiceUsageReport_To_v1_BackingServiceUsageReport(in *backingserviceinstanceapi.BackingServiceUsageReport, out *backingserviceinstanceapiv1.BackingServiceUsageReport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceUsageReport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_BackingServiceUsageReportSpec_To_v1_BackingServiceUsageReportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_BackingServiceUsageReportStatus_To_v1_BackingServiceUsageReportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_BackingServiceUsageReport_To_v1_BackingServiceUsageReport(in *backingserviceinstanceapi.BackingServiceUsageReport, out *backingserviceinstanceapiv1.BackingServiceUsageReport, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceUsageReport_To_v1_BackingServiceUsageReport(in, out, s)
+}
+
+func autoconvert_api_BackingServiceUsageReportSpec_To_v1_BackingServiceUsageReportSpec(in *backingserviceinstanceapi.BackingServiceUsageReportSpec, out *backingserviceinstanceapiv1.BackingServiceUsageReportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceUsageReportSpec))(in)
+	}
+	if err := s.Convert(&in.Start, &out.Start, 0); err != nil {
+		return err
+	}
+	if in.End != nil {
+		if err := s.Convert(&in.End, &out.End, 0); err != nil {
+			return err
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceUsageReportSpec_To_v1_BackingServiceUsageReportSpec(in *backingserviceinstanceapi.BackingServiceUsageReportSpec, out *backingserviceinstanceapiv1.BackingServiceUsageReportSpec, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceUsageReportSpec_To_v1_BackingServiceUsageReportSpec(in, out, s)
+}
+
+func autoconvert_api_BackingServiceUsageReportStatus_To_v1_BackingServiceUsageReportStatus(in *backingserviceinstanceapi.BackingServiceUsageReportStatus, out *backingserviceinstanceapiv1.BackingServiceUsageReportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.BackingServiceUsageReportStatus))(in)
+	}
+	if in.Plans != nil {
+		out.Plans = make([]backingserviceinstanceapiv1.PlanUsage, len(in.Plans))
+		for i := range in.Plans {
+			if err := convert_api_PlanUsage_To_v1_PlanUsage(&in.Plans[i], &out.Plans[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Total != nil {
+		out.Total = make(map[string]float64)
+		for key, val := range in.Total {
+			out.Total[key] = val
+		}
+	} else {
+		out.Total = nil
+	}
+	return nil
+}
+
+func convert_api_BackingServiceUsageReportStatus_To_v1_BackingServiceUsageReportStatus(in *backingserviceinstanceapi.BackingServiceUsageReportStatus, out *backingserviceinstanceapiv1.BackingServiceUsageReportStatus, s conversion.Scope) error {
+	return autoconvert_api_BackingServiceUsageReportStatus_To_v1_BackingServiceUsageReportStatus(in, out, s)
+}
+
 func autoconvert_api_BindingRequestOptions_To_v1_BindingRequestOptions(in *backingserviceinstanceapi.BindingRequestOptions, out *backingserviceinstanceapiv1.BindingRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*backingserviceinstanceapi.BindingRequestOptions))(in)
@@ -1785,6 +2500,8 @@ func autoconvert_api_BindingRequestOptions_To_v1_BindingRequestOptions(in *backi
 	out.BindKind = in.BindKind
 	out.BindResourceVersion = in.BindResourceVersion
 	out.ResourceName = in.ResourceName
+	out.Injection = backingserviceinstanceapiv1.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -1804,6 +2521,7 @@ func autoconvert_api_InstanceBinding_To_v1_InstanceBinding(in *backingserviceins
 	} else {
 		out.BoundTime = nil
 	}
+	out.BindKind = in.BindKind
 	out.BindDeploymentConfig = in.BindDeploymentConfig
 	if in.Credentials != nil {
 		out.Credentials = make(map[string]string)
@@ -1813,6 +2531,9 @@ func autoconvert_api_InstanceBinding_To_v1_InstanceBinding(in *backingserviceins
 	} else {
 		out.Credentials = nil
 	}
+	out.CredentialsSecret = in.CredentialsSecret
+	out.Injection = backingserviceinstanceapiv1.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -1820,12 +2541,34 @@ func convert_api_InstanceBinding_To_v1_InstanceBinding(in *backingserviceinstanc
 	return autoconvert_api_InstanceBinding_To_v1_InstanceBinding(in, out, s)
 }
 
+func autoconvert_api_InstanceDeletionStatus_To_v1_InstanceDeletionStatus(in *backingserviceinstanceapi.InstanceDeletionStatus, out *backingserviceinstanceapiv1.InstanceDeletionStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.InstanceDeletionStatus))(in)
+	}
+	out.RemainingBindings = in.RemainingBindings
+	if in.FailedBindings != nil {
+		out.FailedBindings = make([]string, len(in.FailedBindings))
+		for i := range in.FailedBindings {
+			out.FailedBindings[i] = in.FailedBindings[i]
+		}
+	} else {
+		out.FailedBindings = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_InstanceDeletionStatus_To_v1_InstanceDeletionStatus(in *backingserviceinstanceapi.InstanceDeletionStatus, out *backingserviceinstanceapiv1.InstanceDeletionStatus, s conversion.Scope) error {
+	return autoconvert_api_InstanceDeletionStatus_To_v1_InstanceDeletionStatus(in, out, s)
+}
+
 func autoconvert_api_InstanceProvisioning_To_v1_InstanceProvisioning(in *backingserviceinstanceapi.InstanceProvisioning, out *backingserviceinstanceapiv1.InstanceProvisioning, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*backingserviceinstanceapi.InstanceProvisioning))(in)
 	}
 	out.DashboardUrl = in.DashboardUrl
 	out.BackingServiceName = in.BackingServiceName
+	out.BackingServiceNamespace = in.BackingServiceNamespace
 	out.BackingServiceSpecID = in.BackingServiceSpecID
 	out.BackingServicePlanGuid = in.BackingServicePlanGuid
 	out.BackingServicePlanName = in.BackingServicePlanName
@@ -1851,6 +2594,8 @@ func autoconvert_api_LastOperation_To_v1_LastOperation(in *backingserviceinstanc
 	out.State = in.State
 	out.Description = in.Description
 	out.AsyncPollIntervalSeconds = in.AsyncPollIntervalSeconds
+	out.Operation = in.Operation
+	out.Mitigation = in.Mitigation
 	return nil
 }
 
@@ -1858,12 +2603,230 @@ func convert_api_LastOperation_To_v1_LastOperation(in *backingserviceinstanceapi
 	return autoconvert_api_LastOperation_To_v1_LastOperation(in, out, s)
 }
 
-func autoconvert_v1_BackingServiceInstance_To_api_BackingServiceInstance(in *backingserviceinstanceapiv1.BackingServiceInstance, out *backingserviceinstanceapi.BackingServiceInstance, s conversion.Scope) error {
+func autoconvert_api_PlanUsage_To_v1_PlanUsage(in *backingserviceinstanceapi.PlanUsage, out *backingserviceinstanceapiv1.PlanUsage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceInstance))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*backingserviceinstanceapi.PlanUsage))(in)
+	}
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	out.Instances = in.Instances
+	out.Hours = in.Hours
+	if in.Cost != nil {
+		out.Cost = make(map[string]float64)
+		for key, val := range in.Cost {
+			out.Cost[key] = val
+		}
+	} else {
+		out.Cost = nil
+	}
+	if in.UnmeteredUnits != nil {
+		out.UnmeteredUnits = make([]string, len(in.UnmeteredUnits))
+		for i := range in.UnmeteredUnits {
+			out.UnmeteredUnits[i] = in.UnmeteredUnits[i]
+		}
+	} else {
+		out.UnmeteredUnits = nil
+	}
+	return nil
+}
+
+func convert_api_PlanUsage_To_v1_PlanUsage(in *backingserviceinstanceapi.PlanUsage, out *backingserviceinstanceapiv1.PlanUsage, s conversion.Scope) error {
+	return autoconvert_api_PlanUsage_To_v1_PlanUsage(in, out, s)
+}
+
+func autoconvert_api_UsageCost_To_v1_UsageCost(in *backingserviceinstanceapi.UsageCost, out *backingserviceinstanceapiv1.UsageCost, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapi.UsageCost))(in)
+	}
+	if in.Amount != nil {
+		out.Amount = make(map[string]float64)
+		for key, val := range in.Amount {
+			out.Amount[key] = val
+		}
+	} else {
+		out.Amount = nil
+	}
+	out.Unit = in.Unit
+	return nil
+}
+
+func convert_api_UsageCost_To_v1_UsageCost(in *backingserviceinstanceapi.UsageCost, out *backingserviceinstanceapiv1.UsageCost, s conversion.Scope) error {
+	return autoconvert_api_UsageCost_To_v1_UsageCost(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBinding_To_api_BackingServiceBinding(in *backingserviceinstanceapiv1.BackingServiceBinding, out *backingserviceinstanceapi.BackingServiceBinding, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBinding))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BackingServiceBindingSpec_To_api_BackingServiceBindingSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BackingServiceBindingStatus_To_api_BackingServiceBindingStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceBinding_To_api_BackingServiceBinding(in *backingserviceinstanceapiv1.BackingServiceBinding, out *backingserviceinstanceapi.BackingServiceBinding, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBinding_To_api_BackingServiceBinding(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBindingCondition_To_api_BackingServiceBindingCondition(in *backingserviceinstanceapiv1.BackingServiceBindingCondition, out *backingserviceinstanceapi.BackingServiceBindingCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBindingCondition))(in)
+	}
+	out.Type = backingserviceinstanceapi.BackingServiceBindingConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_BackingServiceBindingCondition_To_api_BackingServiceBindingCondition(in *backingserviceinstanceapiv1.BackingServiceBindingCondition, out *backingserviceinstanceapi.BackingServiceBindingCondition, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBindingCondition_To_api_BackingServiceBindingCondition(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBindingList_To_api_BackingServiceBindingList(in *backingserviceinstanceapiv1.BackingServiceBindingList, out *backingserviceinstanceapi.BackingServiceBindingList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBindingList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapi.BackingServiceBinding, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_BackingServiceBinding_To_api_BackingServiceBinding(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceBindingList_To_api_BackingServiceBindingList(in *backingserviceinstanceapiv1.BackingServiceBindingList, out *backingserviceinstanceapi.BackingServiceBindingList, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBindingList_To_api_BackingServiceBindingList(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBindingRotation_To_api_BackingServiceBindingRotation(in *backingserviceinstanceapiv1.BackingServiceBindingRotation, out *backingserviceinstanceapi.BackingServiceBindingRotation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBindingRotation))(in)
+	}
+	if err := s.Convert(&in.StartTime, &out.StartTime, 0); err != nil {
+		return err
+	}
+	out.BindUuid = in.BindUuid
+	out.RetiredBindUuid = in.RetiredBindUuid
+	out.RetiredCredentialsSecret = in.RetiredCredentialsSecret
+	out.LatestVersion = in.LatestVersion
+	return nil
+}
+
+func convert_v1_BackingServiceBindingRotation_To_api_BackingServiceBindingRotation(in *backingserviceinstanceapiv1.BackingServiceBindingRotation, out *backingserviceinstanceapi.BackingServiceBindingRotation, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBindingRotation_To_api_BackingServiceBindingRotation(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBindingSpec_To_api_BackingServiceBindingSpec(in *backingserviceinstanceapiv1.BackingServiceBindingSpec, out *backingserviceinstanceapi.BackingServiceBindingSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBindingSpec))(in)
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BindKind = in.BindKind
+	out.ResourceName = in.ResourceName
+	out.Injection = backingserviceinstanceapi.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]string)
+		for key, val := range in.Parameters {
+			out.Parameters[key] = val
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.RotationPeriod != nil {
+		if err := s.Convert(&in.RotationPeriod, &out.RotationPeriod, 0); err != nil {
+			return err
+		}
+	} else {
+		out.RotationPeriod = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceBindingSpec_To_api_BackingServiceBindingSpec(in *backingserviceinstanceapiv1.BackingServiceBindingSpec, out *backingserviceinstanceapi.BackingServiceBindingSpec, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBindingSpec_To_api_BackingServiceBindingSpec(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceBindingStatus_To_api_BackingServiceBindingStatus(in *backingserviceinstanceapiv1.BackingServiceBindingStatus, out *backingserviceinstanceapi.BackingServiceBindingStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceBindingStatus))(in)
+	}
+	out.Phase = backingserviceinstanceapi.BackingServiceBindingPhase(in.Phase)
+	out.BindUuid = in.BindUuid
+	if in.BoundTime != nil {
+		if err := s.Convert(&in.BoundTime, &out.BoundTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.BoundTime = nil
+	}
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Conditions != nil {
+		out.Conditions = make([]backingserviceinstanceapi.BackingServiceBindingCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_BackingServiceBindingCondition_To_api_BackingServiceBindingCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.RouteServiceURL = in.RouteServiceURL
+	if in.RotatedTime != nil {
+		if err := s.Convert(&in.RotatedTime, &out.RotatedTime, 0); err != nil {
+			return err
+		}
+	} else {
+		out.RotatedTime = nil
+	}
+	out.RotationRequest = in.RotationRequest
+	if in.Rotation != nil {
+		out.Rotation = new(backingserviceinstanceapi.BackingServiceBindingRotation)
+		if err := convert_v1_BackingServiceBindingRotation_To_api_BackingServiceBindingRotation(in.Rotation, out.Rotation, s); err != nil {
+			return err
+		}
+	} else {
+		out.Rotation = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceBindingStatus_To_api_BackingServiceBindingStatus(in *backingserviceinstanceapiv1.BackingServiceBindingStatus, out *backingserviceinstanceapi.BackingServiceBindingStatus, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceBindingStatus_To_api_BackingServiceBindingStatus(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceInstance_To_api_BackingServiceInstance(in *backingserviceinstanceapiv1.BackingServiceInstance, out *backingserviceinstanceapi.BackingServiceInstance, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceInstance))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
 	}
 	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -1956,6 +2919,23 @@ func autoconvert_v1_BackingServiceInstanceStatus_To_api_BackingServiceInstanceSt
 	} else {
 		out.LastOperation = nil
 	}
+	out.AppliedPlanGuid = in.AppliedPlanGuid
+	if in.AppliedParameters != nil {
+		out.AppliedParameters = make(map[string]string)
+		for key, val := range in.AppliedParameters {
+			out.AppliedParameters[key] = val
+		}
+	} else {
+		out.AppliedParameters = nil
+	}
+	if in.Deletion != nil {
+		out.Deletion = new(backingserviceinstanceapi.InstanceDeletionStatus)
+		if err := convert_v1_InstanceDeletionStatus_To_api_InstanceDeletionStatus(in.Deletion, out.Deletion, s); err != nil {
+			return err
+		}
+	} else {
+		out.Deletion = nil
+	}
 	return nil
 }
 
@@ -1963,6 +2943,149 @@ func convert_v1_BackingServiceInstanceStatus_To_api_BackingServiceInstanceStatus
 	return autoconvert_v1_BackingServiceInstanceStatus_To_api_BackingServiceInstanceStatus(in, out, s)
 }
 
+func autoconvert_v1_BackingServiceUsage_To_api_BackingServiceUsage(in *backingserviceinstanceapiv1.BackingServiceUsage, out *backingserviceinstanceapi.BackingServiceUsage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceUsage))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BackingServiceInstanceUID = in.BackingServiceInstanceUID
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	if in.Costs != nil {
+		out.Costs = make([]backingserviceinstanceapi.UsageCost, len(in.Costs))
+		for i := range in.Costs {
+			if err := convert_v1_UsageCost_To_api_UsageCost(&in.Costs[i], &out.Costs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Costs = nil
+	}
+	if err := s.Convert(&in.Start, &out.Start, 0); err != nil {
+		return err
+	}
+	if in.End != nil {
+		if err := s.Convert(&in.End, &out.End, 0); err != nil {
+			return err
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceUsage_To_api_BackingServiceUsage(in *backingserviceinstanceapiv1.BackingServiceUsage, out *backingserviceinstanceapi.BackingServiceUsage, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceUsage_To_api_BackingServiceUsage(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceUsageList_To_api_BackingServiceUsageList(in *backingserviceinstanceapiv1.BackingServiceUsageList, out *backingserviceinstanceapi.BackingServiceUsageList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceUsageList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapi.BackingServiceUsage, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_BackingServiceUsage_To_api_BackingServiceUsage(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceUsageList_To_api_BackingServiceUsageList(in *backingserviceinstanceapiv1.BackingServiceUsageList, out *backingserviceinstanceapi.BackingServiceUsageList, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceUsageList_To_api_BackingServiceUsageList(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceUsageReport_To_api_BackingServiceUsageReport(in *backingserviceinstanceapiv1.BackingServiceUsageReport, out *backingserviceinstanceapi.BackingServiceUsageReport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceUsageReport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BackingServiceUsageReportSpec_To_api_BackingServiceUsageReportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_BackingServiceUsageReportStatus_To_api_BackingServiceUsageReportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceUsageReport_To_api_BackingServiceUsageReport(in *backingserviceinstanceapiv1.BackingServiceUsageReport, out *backingserviceinstanceapi.BackingServiceUsageReport, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceUsageReport_To_api_BackingServiceUsageReport(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceUsageReportSpec_To_api_BackingServiceUsageReportSpec(in *backingserviceinstanceapiv1.BackingServiceUsageReportSpec, out *backingserviceinstanceapi.BackingServiceUsageReportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceUsageReportSpec))(in)
+	}
+	if err := s.Convert(&in.Start, &out.Start, 0); err != nil {
+		return err
+	}
+	if in.End != nil {
+		if err := s.Convert(&in.End, &out.End, 0); err != nil {
+			return err
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceUsageReportSpec_To_api_BackingServiceUsageReportSpec(in *backingserviceinstanceapiv1.BackingServiceUsageReportSpec, out *backingserviceinstanceapi.BackingServiceUsageReportSpec, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceUsageReportSpec_To_api_BackingServiceUsageReportSpec(in, out, s)
+}
+
+func autoconvert_v1_BackingServiceUsageReportStatus_To_api_BackingServiceUsageReportStatus(in *backingserviceinstanceapiv1.BackingServiceUsageReportStatus, out *backingserviceinstanceapi.BackingServiceUsageReportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.BackingServiceUsageReportStatus))(in)
+	}
+	if in.Plans != nil {
+		out.Plans = make([]backingserviceinstanceapi.PlanUsage, len(in.Plans))
+		for i := range in.Plans {
+			if err := convert_v1_PlanUsage_To_api_PlanUsage(&in.Plans[i], &out.Plans[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Total != nil {
+		out.Total = make(map[string]float64)
+		for key, val := range in.Total {
+			out.Total[key] = val
+		}
+	} else {
+		out.Total = nil
+	}
+	return nil
+}
+
+func convert_v1_BackingServiceUsageReportStatus_To_api_BackingServiceUsageReportStatus(in *backingserviceinstanceapiv1.BackingServiceUsageReportStatus, out *backingserviceinstanceapi.BackingServiceUsageReportStatus, s conversion.Scope) error {
+	return autoconvert_v1_BackingServiceUsageReportStatus_To_api_BackingServiceUsageReportStatus(in, out, s)
+}
+
 func autoconvert_v1_BindingRequestOptions_To_api_BindingRequestOptions(in *backingserviceinstanceapiv1.BindingRequestOptions, out *backingserviceinstanceapi.BindingRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*backingserviceinstanceapiv1.BindingRequestOptions))(in)
@@ -1976,6 +3099,8 @@ func autoconvert_v1_BindingRequestOptions_To_api_BindingRequestOptions(in *backi
 	out.BindKind = in.BindKind
 	out.BindResourceVersion = in.BindResourceVersion
 	out.ResourceName = in.ResourceName
+	out.Injection = backingserviceinstanceapi.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -1995,6 +3120,7 @@ func autoconvert_v1_InstanceBinding_To_api_InstanceBinding(in *backingserviceins
 		out.BoundTime = nil
 	}
 	out.BindUuid = in.BindUuid
+	out.BindKind = in.BindKind
 	out.BindDeploymentConfig = in.BindDeploymentConfig
 	if in.Credentials != nil {
 		out.Credentials = make(map[string]string)
@@ -2004,6 +3130,9 @@ func autoconvert_v1_InstanceBinding_To_api_InstanceBinding(in *backingserviceins
 	} else {
 		out.Credentials = nil
 	}
+	out.CredentialsSecret = in.CredentialsSecret
+	out.Injection = backingserviceinstanceapi.BindingInjection(in.Injection)
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -2011,12 +3140,34 @@ func convert_v1_InstanceBinding_To_api_InstanceBinding(in *backingserviceinstanc
 	return autoconvert_v1_InstanceBinding_To_api_InstanceBinding(in, out, s)
 }
 
+func autoconvert_v1_InstanceDeletionStatus_To_api_InstanceDeletionStatus(in *backingserviceinstanceapiv1.InstanceDeletionStatus, out *backingserviceinstanceapi.InstanceDeletionStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.InstanceDeletionStatus))(in)
+	}
+	out.RemainingBindings = in.RemainingBindings
+	if in.FailedBindings != nil {
+		out.FailedBindings = make([]string, len(in.FailedBindings))
+		for i := range in.FailedBindings {
+			out.FailedBindings[i] = in.FailedBindings[i]
+		}
+	} else {
+		out.FailedBindings = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_InstanceDeletionStatus_To_api_InstanceDeletionStatus(in *backingserviceinstanceapiv1.InstanceDeletionStatus, out *backingserviceinstanceapi.InstanceDeletionStatus, s conversion.Scope) error {
+	return autoconvert_v1_InstanceDeletionStatus_To_api_InstanceDeletionStatus(in, out, s)
+}
+
 func autoconvert_v1_InstanceProvisioning_To_api_InstanceProvisioning(in *backingserviceinstanceapiv1.InstanceProvisioning, out *backingserviceinstanceapi.InstanceProvisioning, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*backingserviceinstanceapiv1.InstanceProvisioning))(in)
 	}
 	out.DashboardUrl = in.DashboardUrl
 	out.BackingServiceName = in.BackingServiceName
+	out.BackingServiceNamespace = in.BackingServiceNamespace
 	out.BackingServiceSpecID = in.BackingServiceSpecID
 	out.BackingServicePlanGuid = in.BackingServicePlanGuid
 	out.BackingServicePlanName = in.BackingServicePlanName
@@ -2035,18 +3186,73 @@ func convert_v1_InstanceProvisioning_To_api_InstanceProvisioning(in *backingserv
 	return autoconvert_v1_InstanceProvisioning_To_api_InstanceProvisioning(in, out, s)
 }
 
-func autoconvert_v1_LastOperation_To_api_LastOperation(in *backingserviceinstanceapiv1.LastOperation, out *backingserviceinstanceapi.LastOperation, s conversion.Scope) error {
+func autoconvert_v1_LastOperation_To_api_LastOperation(in *backingserviceinstanceapiv1.LastOperation, out *backingserviceinstanceapi.LastOperation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.LastOperation))(in)
+	}
+	out.State = in.State
+	out.Description = in.Description
+	out.AsyncPollIntervalSeconds = in.AsyncPollIntervalSeconds
+	out.Operation = in.Operation
+	out.Mitigation = in.Mitigation
+	return nil
+}
+
+func convert_v1_LastOperation_To_api_LastOperation(in *backingserviceinstanceapiv1.LastOperation, out *backingserviceinstanceapi.LastOperation, s conversion.Scope) error {
+	return autoconvert_v1_LastOperation_To_api_LastOperation(in, out, s)
+}
+
+func autoconvert_v1_PlanUsage_To_api_PlanUsage(in *backingserviceinstanceapiv1.PlanUsage, out *backingserviceinstanceapi.PlanUsage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*backingserviceinstanceapiv1.PlanUsage))(in)
+	}
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	out.Instances = in.Instances
+	out.Hours = in.Hours
+	if in.Cost != nil {
+		out.Cost = make(map[string]float64)
+		for key, val := range in.Cost {
+			out.Cost[key] = val
+		}
+	} else {
+		out.Cost = nil
+	}
+	if in.UnmeteredUnits != nil {
+		out.UnmeteredUnits = make([]string, len(in.UnmeteredUnits))
+		for i := range in.UnmeteredUnits {
+			out.UnmeteredUnits[i] = in.UnmeteredUnits[i]
+		}
+	} else {
+		out.UnmeteredUnits = nil
+	}
+	return nil
+}
+
+func convert_v1_PlanUsage_To_api_PlanUsage(in *backingserviceinstanceapiv1.PlanUsage, out *backingserviceinstanceapi.PlanUsage, s conversion.Scope) error {
+	return autoconvert_v1_PlanUsage_To_api_PlanUsage(in, out, s)
+}
+
+func autoconvert_v1_UsageCost_To_api_UsageCost(in *backingserviceinstanceapiv1.UsageCost, out *backingserviceinstanceapi.UsageCost, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*backingserviceinstanceapiv1.LastOperation))(in)
+		defaulting.(func(*backingserviceinstanceapiv1.UsageCost))(in)
 	}
-	out.State = in.State
-	out.Description = in.Description
-	out.AsyncPollIntervalSeconds = in.AsyncPollIntervalSeconds
+	if in.Amount != nil {
+		out.Amount = make(map[string]float64)
+		for key, val := range in.Amount {
+			out.Amount[key] = val
+		}
+	} else {
+		out.Amount = nil
+	}
+	out.Unit = in.Unit
 	return nil
 }
 
-func convert_v1_LastOperation_To_api_LastOperation(in *backingserviceinstanceapiv1.LastOperation, out *backingserviceinstanceapi.LastOperation, s conversion.Scope) error {
-	return autoconvert_v1_LastOperation_To_api_LastOperation(in, out, s)
+func convert_v1_UsageCost_To_api_UsageCost(in *backingserviceinstanceapiv1.UsageCost, out *backingserviceinstanceapi.UsageCost, s conversion.Scope) error {
+	return autoconvert_v1_UsageCost_To_api_UsageCost(in, out, s)
 }
 
 func autoconvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions(in *buildapi.BinaryBuildRequestOptions, out *buildapiv1.BinaryBuildRequestOptions, s conversion.Scope) error {
@@ -6219,6 +7425,69 @@ func convert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespa
 	return autoconvert_v1_NetNamespaceList_To_api_NetNamespaceList(in, out, s)
 }
 
+func autoconvert_api_ProjectServiceBroker_To_v1_ProjectServiceBroker(in *servicebrokerapi.ProjectServiceBroker, out *servicebrokerapiv1.ProjectServiceBroker, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.ProjectServiceBroker))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ServiceBrokerSpec_To_v1_ServiceBrokerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ServiceBrokerStatus_To_v1_ServiceBrokerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ProjectServiceBroker_To_v1_ProjectServiceBroker(in *servicebrokerapi.ProjectServiceBroker, out *servicebrokerapiv1.ProjectServiceBroker, s conversion.Scope) error {
+	return autoconvert_api_ProjectServiceBroker_To_v1_ProjectServiceBroker(in, out, s)
+}
+
+func autoconvert_api_ProjectServiceBrokerList_To_v1_ProjectServiceBrokerList(in *servicebrokerapi.ProjectServiceBrokerList, out *servicebrokerapiv1.ProjectServiceBrokerList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.ProjectServiceBrokerList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]servicebrokerapiv1.ProjectServiceBroker, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ProjectServiceBroker_To_v1_ProjectServiceBroker(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ProjectServiceBrokerList_To_v1_ProjectServiceBrokerList(in *servicebrokerapi.ProjectServiceBrokerList, out *servicebrokerapiv1.ProjectServiceBrokerList, s conversion.Scope) error {
+	return autoconvert_api_ProjectServiceBrokerList_To_v1_ProjectServiceBrokerList(in, out, s)
+}
+
+func autoconvert_api_SecretReference_To_v1_SecretReference(in *servicebrokerapi.SecretReference, out *servicebrokerapiv1.SecretReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.SecretReference))(in)
+	}
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+func convert_api_SecretReference_To_v1_SecretReference(in *servicebrokerapi.SecretReference, out *servicebrokerapiv1.SecretReference, s conversion.Scope) error {
+	return autoconvert_api_SecretReference_To_v1_SecretReference(in, out, s)
+}
+
 func autoconvert_api_ServiceBroker_To_v1_ServiceBroker(in *servicebrokerapi.ServiceBroker, out *servicebrokerapiv1.ServiceBroker, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*servicebrokerapi.ServiceBroker))(in)
@@ -6242,6 +7511,41 @@ func convert_api_ServiceBroker_To_v1_ServiceBroker(in *servicebrokerapi.ServiceB
 	return autoconvert_api_ServiceBroker_To_v1_ServiceBroker(in, out, s)
 }
 
+func autoconvert_api_ServiceBrokerCondition_To_v1_ServiceBrokerCondition(in *servicebrokerapi.ServiceBrokerCondition, out *servicebrokerapiv1.ServiceBrokerCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.ServiceBrokerCondition))(in)
+	}
+	out.Type = servicebrokerapiv1.ServiceBrokerConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastProbeTime, &out.LastProbeTime, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_ServiceBrokerCondition_To_v1_ServiceBrokerCondition(in *servicebrokerapi.ServiceBrokerCondition, out *servicebrokerapiv1.ServiceBrokerCondition, s conversion.Scope) error {
+	return autoconvert_api_ServiceBrokerCondition_To_v1_ServiceBrokerCondition(in, out, s)
+}
+
+func autoconvert_api_ServiceBrokerHealthCheck_To_v1_ServiceBrokerHealthCheck(in *servicebrokerapi.ServiceBrokerHealthCheck, out *servicebrokerapiv1.ServiceBrokerHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.ServiceBrokerHealthCheck))(in)
+	}
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.FailureThreshold = in.FailureThreshold
+	return nil
+}
+
+func convert_api_ServiceBrokerHealthCheck_To_v1_ServiceBrokerHealthCheck(in *servicebrokerapi.ServiceBrokerHealthCheck, out *servicebrokerapiv1.ServiceBrokerHealthCheck, s conversion.Scope) error {
+	return autoconvert_api_ServiceBrokerHealthCheck_To_v1_ServiceBrokerHealthCheck(in, out, s)
+}
+
 func autoconvert_api_ServiceBrokerList_To_v1_ServiceBrokerList(in *servicebrokerapi.ServiceBrokerList, out *servicebrokerapiv1.ServiceBrokerList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*servicebrokerapi.ServiceBrokerList))(in)
@@ -6277,6 +7581,52 @@ func autoconvert_api_ServiceBrokerSpec_To_v1_ServiceBrokerSpec(in *servicebroker
 	out.Name = in.Name
 	out.UserName = in.UserName
 	out.Password = in.Password
+	if in.AuthSecretRef != nil {
+		out.AuthSecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := convert_api_SecretReference_To_v1_SecretReference(in.AuthSecretRef, out.AuthSecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuthSecretRef = nil
+	}
+	if err := s.Convert(&in.CABundle, &out.CABundle, 0); err != nil {
+		return err
+	}
+	if in.CASecretRef != nil {
+		out.CASecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := convert_api_SecretReference_To_v1_SecretReference(in.CASecretRef, out.CASecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.CASecretRef = nil
+	}
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	if in.ClientCertSecretRef != nil {
+		out.ClientCertSecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := convert_api_SecretReference_To_v1_SecretReference(in.ClientCertSecretRef, out.ClientCertSecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.Visibility != nil {
+		out.Visibility = make([]servicebrokerapiv1.ServiceVisibility, len(in.Visibility))
+		for i := range in.Visibility {
+			if err := convert_api_ServiceVisibility_To_v1_ServiceVisibility(&in.Visibility[i], &out.Visibility[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Visibility = nil
+	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(servicebrokerapiv1.ServiceBrokerHealthCheck)
+		if err := convert_api_ServiceBrokerHealthCheck_To_v1_ServiceBrokerHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -6297,6 +7647,19 @@ func autoconvert_api_ServiceBrokerStatus_To_v1_ServiceBrokerStatus(in *servicebr
 		defaulting.(func(*servicebrokerapi.ServiceBrokerStatus))(in)
 	}
 	out.Phase = servicebrokerapiv1.ServiceBrokerPhase(in.Phase)
+	if in.Conditions != nil {
+		out.Conditions = make([]servicebrokerapiv1.ServiceBrokerCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_ServiceBrokerCondition_To_v1_ServiceBrokerCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.CatalogHash = in.CatalogHash
+	out.ServiceCount = in.ServiceCount
 	return nil
 }
 
@@ -6304,6 +7667,112 @@ func convert_api_ServiceBrokerStatus_To_v1_ServiceBrokerStatus(in *servicebroker
 	return autoconvert_api_ServiceBrokerStatus_To_v1_ServiceBrokerStatus(in, out, s)
 }
 
+func autoconvert_api_ServiceVisibility_To_v1_ServiceVisibility(in *servicebrokerapi.ServiceVisibility, out *servicebrokerapiv1.ServiceVisibility, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapi.ServiceVisibility))(in)
+	}
+	if in.Services != nil {
+		out.Services = make([]string, len(in.Services))
+		for i := range in.Services {
+			out.Services[i] = in.Services[i]
+		}
+	} else {
+		out.Services = nil
+	}
+	if in.Plans != nil {
+		out.Plans = make([]string, len(in.Plans))
+		for i := range in.Plans {
+			out.Plans[i] = in.Plans[i]
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	if in.NamespaceSelector != nil {
+		out.NamespaceSelector = make(map[string]string)
+		for key, val := range in.NamespaceSelector {
+			out.NamespaceSelector[key] = val
+		}
+	} else {
+		out.NamespaceSelector = nil
+	}
+	return nil
+}
+
+func convert_api_ServiceVisibility_To_v1_ServiceVisibility(in *servicebrokerapi.ServiceVisibility, out *servicebrokerapiv1.ServiceVisibility, s conversion.Scope) error {
+	return autoconvert_api_ServiceVisibility_To_v1_ServiceVisibility(in, out, s)
+}
+
+func autoconvert_v1_ProjectServiceBroker_To_api_ProjectServiceBroker(in *servicebrokerapiv1.ProjectServiceBroker, out *servicebrokerapi.ProjectServiceBroker, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.ProjectServiceBroker))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ServiceBrokerSpec_To_api_ServiceBrokerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ServiceBrokerStatus_To_api_ServiceBrokerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ProjectServiceBroker_To_api_ProjectServiceBroker(in *servicebrokerapiv1.ProjectServiceBroker, out *servicebrokerapi.ProjectServiceBroker, s conversion.Scope) error {
+	return autoconvert_v1_ProjectServiceBroker_To_api_ProjectServiceBroker(in, out, s)
+}
+
+func autoconvert_v1_ProjectServiceBrokerList_To_api_ProjectServiceBrokerList(in *servicebrokerapiv1.ProjectServiceBrokerList, out *servicebrokerapi.ProjectServiceBrokerList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.ProjectServiceBrokerList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]servicebrokerapi.ProjectServiceBroker, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ProjectServiceBroker_To_api_ProjectServiceBroker(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ProjectServiceBrokerList_To_api_ProjectServiceBrokerList(in *servicebrokerapiv1.ProjectServiceBrokerList, out *servicebrokerapi.ProjectServiceBrokerList, s conversion.Scope) error {
+	return autoconvert_v1_ProjectServiceBrokerList_To_api_ProjectServiceBrokerList(in, out, s)
+}
+
+func autoconvert_v1_SecretReference_To_api_SecretReference(in *servicebrokerapiv1.SecretReference, out *servicebrokerapi.SecretReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.SecretReference))(in)
+	}
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+func convert_v1_SecretReference_To_api_SecretReference(in *servicebrokerapiv1.SecretReference, out *servicebrokerapi.SecretReference, s conversion.Scope) error {
+	return autoconvert_v1_SecretReference_To_api_SecretReference(in, out, s)
+}
+
 func autoconvert_v1_ServiceBroker_To_api_ServiceBroker(in *servicebrokerapiv1.ServiceBroker, out *servicebrokerapi.ServiceBroker, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*servicebrokerapiv1.ServiceBroker))(in)
@@ -6327,6 +7796,41 @@ func convert_v1_ServiceBroker_To_api_ServiceBroker(in *servicebrokerapiv1.Servic
 	return autoconvert_v1_ServiceBroker_To_api_ServiceBroker(in, out, s)
 }
 
+func autoconvert_v1_ServiceBrokerCondition_To_api_ServiceBrokerCondition(in *servicebrokerapiv1.ServiceBrokerCondition, out *servicebrokerapi.ServiceBrokerCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.ServiceBrokerCondition))(in)
+	}
+	out.Type = servicebrokerapi.ServiceBrokerConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastProbeTime, &out.LastProbeTime, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_ServiceBrokerCondition_To_api_ServiceBrokerCondition(in *servicebrokerapiv1.ServiceBrokerCondition, out *servicebrokerapi.ServiceBrokerCondition, s conversion.Scope) error {
+	return autoconvert_v1_ServiceBrokerCondition_To_api_ServiceBrokerCondition(in, out, s)
+}
+
+func autoconvert_v1_ServiceBrokerHealthCheck_To_api_ServiceBrokerHealthCheck(in *servicebrokerapiv1.ServiceBrokerHealthCheck, out *servicebrokerapi.ServiceBrokerHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.ServiceBrokerHealthCheck))(in)
+	}
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.FailureThreshold = in.FailureThreshold
+	return nil
+}
+
+func convert_v1_ServiceBrokerHealthCheck_To_api_ServiceBrokerHealthCheck(in *servicebrokerapiv1.ServiceBrokerHealthCheck, out *servicebrokerapi.ServiceBrokerHealthCheck, s conversion.Scope) error {
+	return autoconvert_v1_ServiceBrokerHealthCheck_To_api_ServiceBrokerHealthCheck(in, out, s)
+}
+
 func autoconvert_v1_ServiceBrokerList_To_api_ServiceBrokerList(in *servicebrokerapiv1.ServiceBrokerList, out *servicebrokerapi.ServiceBrokerList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*servicebrokerapiv1.ServiceBrokerList))(in)
@@ -6362,6 +7866,52 @@ func autoconvert_v1_ServiceBrokerSpec_To_api_ServiceBrokerSpec(in *servicebroker
 	out.Name = in.Name
 	out.UserName = in.UserName
 	out.Password = in.Password
+	if in.AuthSecretRef != nil {
+		out.AuthSecretRef = new(servicebrokerapi.SecretReference)
+		if err := convert_v1_SecretReference_To_api_SecretReference(in.AuthSecretRef, out.AuthSecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuthSecretRef = nil
+	}
+	if err := s.Convert(&in.CABundle, &out.CABundle, 0); err != nil {
+		return err
+	}
+	if in.CASecretRef != nil {
+		out.CASecretRef = new(servicebrokerapi.SecretReference)
+		if err := convert_v1_SecretReference_To_api_SecretReference(in.CASecretRef, out.CASecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.CASecretRef = nil
+	}
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	if in.ClientCertSecretRef != nil {
+		out.ClientCertSecretRef = new(servicebrokerapi.SecretReference)
+		if err := convert_v1_SecretReference_To_api_SecretReference(in.ClientCertSecretRef, out.ClientCertSecretRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.Visibility != nil {
+		out.Visibility = make([]servicebrokerapi.ServiceVisibility, len(in.Visibility))
+		for i := range in.Visibility {
+			if err := convert_v1_ServiceVisibility_To_api_ServiceVisibility(&in.Visibility[i], &out.Visibility[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Visibility = nil
+	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(servicebrokerapi.ServiceBrokerHealthCheck)
+		if err := convert_v1_ServiceBrokerHealthCheck_To_api_ServiceBrokerHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapi.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -6382,6 +7932,19 @@ func autoconvert_v1_ServiceBrokerStatus_To_api_ServiceBrokerStatus(in *servicebr
 		defaulting.(func(*servicebrokerapiv1.ServiceBrokerStatus))(in)
 	}
 	out.Phase = servicebrokerapi.ServiceBrokerPhase(in.Phase)
+	if in.Conditions != nil {
+		out.Conditions = make([]servicebrokerapi.ServiceBrokerCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_ServiceBrokerCondition_To_api_ServiceBrokerCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.CatalogHash = in.CatalogHash
+	out.ServiceCount = in.ServiceCount
 	return nil
 }
 
@@ -6389,6 +7952,49 @@ func convert_v1_ServiceBrokerStatus_To_api_ServiceBrokerStatus(in *servicebroker
 	return autoconvert_v1_ServiceBrokerStatus_To_api_ServiceBrokerStatus(in, out, s)
 }
 
+func autoconvert_v1_ServiceVisibility_To_api_ServiceVisibility(in *servicebrokerapiv1.ServiceVisibility, out *servicebrokerapi.ServiceVisibility, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*servicebrokerapiv1.ServiceVisibility))(in)
+	}
+	if in.Services != nil {
+		out.Services = make([]string, len(in.Services))
+		for i := range in.Services {
+			out.Services[i] = in.Services[i]
+		}
+	} else {
+		out.Services = nil
+	}
+	if in.Plans != nil {
+		out.Plans = make([]string, len(in.Plans))
+		for i := range in.Plans {
+			out.Plans[i] = in.Plans[i]
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	if in.NamespaceSelector != nil {
+		out.NamespaceSelector = make(map[string]string)
+		for key, val := range in.NamespaceSelector {
+			out.NamespaceSelector[key] = val
+		}
+	} else {
+		out.NamespaceSelector = nil
+	}
+	return nil
+}
+
+func convert_v1_ServiceVisibility_To_api_ServiceVisibility(in *servicebrokerapiv1.ServiceVisibility, out *servicebrokerapi.ServiceVisibility, s conversion.Scope) error {
+	return autoconvert_v1_ServiceVisibility_To_api_ServiceVisibility(in, out, s)
+}
+
 func autoconvert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *templateapiv1.Parameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.Parameter))(in)
@@ -9007,10 +10613,23 @@ func autoconvert_v1_VolumeSource_To_api_VolumeSource(in *pkgapiv1.VolumeSource,
 func init() {
 	err := pkgapi.Scheme.AddGeneratedConversionFuncs(
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
+		autoconvert_api_ApplicationCondition_To_v1_ApplicationCondition,
+		autoconvert_api_ApplicationItemStatus_To_v1_ApplicationItemStatus,
 		autoconvert_api_ApplicationList_To_v1_ApplicationList,
+		autoconvert_api_ApplicationPromotionItemStatus_To_v1_ApplicationPromotionItemStatus,
+		autoconvert_api_ApplicationPromotionList_To_v1_ApplicationPromotionList,
+		autoconvert_api_ApplicationPromotionSpec_To_v1_ApplicationPromotionSpec,
+		autoconvert_api_ApplicationPromotionStatus_To_v1_ApplicationPromotionStatus,
+		autoconvert_api_ApplicationPromotion_To_v1_ApplicationPromotion,
 		autoconvert_api_ApplicationSpec_To_v1_ApplicationSpec,
 		autoconvert_api_ApplicationStatus_To_v1_ApplicationStatus,
 		autoconvert_api_Application_To_v1_Application,
+		autoconvert_api_BackingServiceBindingCondition_To_v1_BackingServiceBindingCondition,
+		autoconvert_api_BackingServiceBindingList_To_v1_BackingServiceBindingList,
+		autoconvert_api_BackingServiceBindingRotation_To_v1_BackingServiceBindingRotation,
+		autoconvert_api_BackingServiceBindingSpec_To_v1_BackingServiceBindingSpec,
+		autoconvert_api_BackingServiceBindingStatus_To_v1_BackingServiceBindingStatus,
+		autoconvert_api_BackingServiceBinding_To_v1_BackingServiceBinding,
 		autoconvert_api_BackingServiceInstanceList_To_v1_BackingServiceInstanceList,
 		autoconvert_api_BackingServiceInstanceSpec_To_v1_BackingServiceInstanceSpec,
 		autoconvert_api_BackingServiceInstanceStatus_To_v1_BackingServiceInstanceStatus,
@@ -9018,6 +10637,11 @@ func init() {
 		autoconvert_api_BackingServiceList_To_v1_BackingServiceList,
 		autoconvert_api_BackingServiceSpec_To_v1_BackingServiceSpec,
 		autoconvert_api_BackingServiceStatus_To_v1_BackingServiceStatus,
+		autoconvert_api_BackingServiceUsageList_To_v1_BackingServiceUsageList,
+		autoconvert_api_BackingServiceUsageReportSpec_To_v1_BackingServiceUsageReportSpec,
+		autoconvert_api_BackingServiceUsageReportStatus_To_v1_BackingServiceUsageReportStatus,
+		autoconvert_api_BackingServiceUsageReport_To_v1_BackingServiceUsageReport,
+		autoconvert_api_BackingServiceUsage_To_v1_BackingServiceUsage,
 		autoconvert_api_BackingService_To_v1_BackingService,
 		autoconvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoconvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
@@ -9107,6 +10731,7 @@ func init() {
 		autoconvert_api_ImageStream_To_v1_ImageStream,
 		autoconvert_api_Image_To_v1_Image,
 		autoconvert_api_InstanceBinding_To_v1_InstanceBinding,
+		autoconvert_api_InstanceDeletionStatus_To_v1_InstanceDeletionStatus,
 		autoconvert_api_InstanceProvisioning_To_v1_InstanceProvisioning,
 		autoconvert_api_IsPersonalSubjectAccessReview_To_v1_IsPersonalSubjectAccessReview,
 		autoconvert_api_Item_To_v1_Item,
@@ -9132,6 +10757,7 @@ func init() {
 		autoconvert_api_ObjectReference_To_v1_ObjectReference,
 		autoconvert_api_Parameter_To_v1_Parameter,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource,
+		autoconvert_api_PlanUsage_To_v1_PlanUsage,
 		autoconvert_api_PodSpec_To_v1_PodSpec,
 		autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
 		autoconvert_api_PolicyBindingList_To_v1_PolicyBindingList,
@@ -9142,6 +10768,8 @@ func init() {
 		autoconvert_api_Probe_To_v1_Probe,
 		autoconvert_api_ProjectList_To_v1_ProjectList,
 		autoconvert_api_ProjectRequest_To_v1_ProjectRequest,
+		autoconvert_api_ProjectServiceBrokerList_To_v1_ProjectServiceBrokerList,
+		autoconvert_api_ProjectServiceBroker_To_v1_ProjectServiceBroker,
 		autoconvert_api_ProjectSpec_To_v1_ProjectSpec,
 		autoconvert_api_ProjectStatus_To_v1_ProjectStatus,
 		autoconvert_api_Project_To_v1_Project,
@@ -9161,16 +10789,21 @@ func init() {
 		autoconvert_api_RouteStatus_To_v1_RouteStatus,
 		autoconvert_api_Route_To_v1_Route,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
+		autoconvert_api_SecretReference_To_v1_SecretReference,
 		autoconvert_api_SecretSpec_To_v1_SecretSpec,
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1_SecurityContext,
+		autoconvert_api_ServiceBrokerCondition_To_v1_ServiceBrokerCondition,
+		autoconvert_api_ServiceBrokerHealthCheck_To_v1_ServiceBrokerHealthCheck,
 		autoconvert_api_ServiceBrokerList_To_v1_ServiceBrokerList,
 		autoconvert_api_ServiceBrokerSpec_To_v1_ServiceBrokerSpec,
 		autoconvert_api_ServiceBrokerStatus_To_v1_ServiceBrokerStatus,
 		autoconvert_api_ServiceBroker_To_v1_ServiceBroker,
 		autoconvert_api_ServicePlanCost_To_v1_ServicePlanCost,
 		autoconvert_api_ServicePlanMetadata_To_v1_ServicePlanMetadata,
+		autoconvert_api_ServicePlanSchemas_To_v1_ServicePlanSchemas,
 		autoconvert_api_ServicePlan_To_v1_ServicePlan,
+		autoconvert_api_ServiceVisibility_To_v1_ServiceVisibility,
 		autoconvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		autoconvert_api_SourceControlUser_To_v1_SourceControlUser,
 		autoconvert_api_SourceRevision_To_v1_SourceRevision,
@@ -9180,6 +10813,7 @@ func init() {
 		autoconvert_api_TLSConfig_To_v1_TLSConfig,
 		autoconvert_api_TemplateList_To_v1_TemplateList,
 		autoconvert_api_Template_To_v1_Template,
+		autoconvert_api_UsageCost_To_v1_UsageCost,
 		autoconvert_api_UserIdentityMapping_To_v1_UserIdentityMapping,
 		autoconvert_api_UserList_To_v1_UserList,
 		autoconvert_api_User_To_v1_User,
@@ -9188,10 +10822,23 @@ func init() {
 		autoconvert_api_Volume_To_v1_Volume,
 		autoconvert_api_WebHookTrigger_To_v1_WebHookTrigger,
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
+		autoconvert_v1_ApplicationCondition_To_api_ApplicationCondition,
+		autoconvert_v1_ApplicationItemStatus_To_api_ApplicationItemStatus,
 		autoconvert_v1_ApplicationList_To_api_ApplicationList,
+		autoconvert_v1_ApplicationPromotionItemStatus_To_api_ApplicationPromotionItemStatus,
+		autoconvert_v1_ApplicationPromotionList_To_api_ApplicationPromotionList,
+		autoconvert_v1_ApplicationPromotionSpec_To_api_ApplicationPromotionSpec,
+		autoconvert_v1_ApplicationPromotionStatus_To_api_ApplicationPromotionStatus,
+		autoconvert_v1_ApplicationPromotion_To_api_ApplicationPromotion,
 		autoconvert_v1_ApplicationSpec_To_api_ApplicationSpec,
 		autoconvert_v1_ApplicationStatus_To_api_ApplicationStatus,
 		autoconvert_v1_Application_To_api_Application,
+		autoconvert_v1_BackingServiceBindingCondition_To_api_BackingServiceBindingCondition,
+		autoconvert_v1_BackingServiceBindingList_To_api_BackingServiceBindingList,
+		autoconvert_v1_BackingServiceBindingRotation_To_api_BackingServiceBindingRotation,
+		autoconvert_v1_BackingServiceBindingSpec_To_api_BackingServiceBindingSpec,
+		autoconvert_v1_BackingServiceBindingStatus_To_api_BackingServiceBindingStatus,
+		autoconvert_v1_BackingServiceBinding_To_api_BackingServiceBinding,
 		autoconvert_v1_BackingServiceInstanceList_To_api_BackingServiceInstanceList,
 		autoconvert_v1_BackingServiceInstanceSpec_To_api_BackingServiceInstanceSpec,
 		autoconvert_v1_BackingServiceInstanceStatus_To_api_BackingServiceInstanceStatus,
@@ -9199,6 +10846,11 @@ func init() {
 		autoconvert_v1_BackingServiceList_To_api_BackingServiceList,
 		autoconvert_v1_BackingServiceSpec_To_api_BackingServiceSpec,
 		autoconvert_v1_BackingServiceStatus_To_api_BackingServiceStatus,
+		autoconvert_v1_BackingServiceUsageList_To_api_BackingServiceUsageList,
+		autoconvert_v1_BackingServiceUsageReportSpec_To_api_BackingServiceUsageReportSpec,
+		autoconvert_v1_BackingServiceUsageReportStatus_To_api_BackingServiceUsageReportStatus,
+		autoconvert_v1_BackingServiceUsageReport_To_api_BackingServiceUsageReport,
+		autoconvert_v1_BackingServiceUsage_To_api_BackingServiceUsage,
 		autoconvert_v1_BackingService_To_api_BackingService,
 		autoconvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoconvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
@@ -9288,6 +10940,7 @@ func init() {
 		autoconvert_v1_ImageStream_To_api_ImageStream,
 		autoconvert_v1_Image_To_api_Image,
 		autoconvert_v1_InstanceBinding_To_api_InstanceBinding,
+		autoconvert_v1_InstanceDeletionStatus_To_api_InstanceDeletionStatus,
 		autoconvert_v1_InstanceProvisioning_To_api_InstanceProvisioning,
 		autoconvert_v1_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
 		autoconvert_v1_Item_To_api_Item,
@@ -9313,6 +10966,7 @@ func init() {
 		autoconvert_v1_ObjectReference_To_api_ObjectReference,
 		autoconvert_v1_Parameter_To_api_Parameter,
 		autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoconvert_v1_PlanUsage_To_api_PlanUsage,
 		autoconvert_v1_PodSpec_To_api_PodSpec,
 		autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoconvert_v1_PolicyBindingList_To_api_PolicyBindingList,
@@ -9323,6 +10977,8 @@ func init() {
 		autoconvert_v1_Probe_To_api_Probe,
 		autoconvert_v1_ProjectList_To_api_ProjectList,
 		autoconvert_v1_ProjectRequest_To_api_ProjectRequest,
+		autoconvert_v1_ProjectServiceBrokerList_To_api_ProjectServiceBrokerList,
+		autoconvert_v1_ProjectServiceBroker_To_api_ProjectServiceBroker,
 		autoconvert_v1_ProjectSpec_To_api_ProjectSpec,
 		autoconvert_v1_ProjectStatus_To_api_ProjectStatus,
 		autoconvert_v1_Project_To_api_Project,
@@ -9342,16 +10998,21 @@ func init() {
 		autoconvert_v1_RouteStatus_To_api_RouteStatus,
 		autoconvert_v1_Route_To_api_Route,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
+		autoconvert_v1_SecretReference_To_api_SecretReference,
 		autoconvert_v1_SecretSpec_To_api_SecretSpec,
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_SecurityContext_To_api_SecurityContext,
+		autoconvert_v1_ServiceBrokerCondition_To_api_ServiceBrokerCondition,
+		autoconvert_v1_ServiceBrokerHealthCheck_To_api_ServiceBrokerHealthCheck,
 		autoconvert_v1_ServiceBrokerList_To_api_ServiceBrokerList,
 		autoconvert_v1_ServiceBrokerSpec_To_api_ServiceBrokerSpec,
 		autoconvert_v1_ServiceBrokerStatus_To_api_ServiceBrokerStatus,
 		autoconvert_v1_ServiceBroker_To_api_ServiceBroker,
 		autoconvert_v1_ServicePlanCost_To_api_ServicePlanCost,
 		autoconvert_v1_ServicePlanMetadata_To_api_ServicePlanMetadata,
+		autoconvert_v1_ServicePlanSchemas_To_api_ServicePlanSchemas,
 		autoconvert_v1_ServicePlan_To_api_ServicePlan,
+		autoconvert_v1_ServiceVisibility_To_api_ServiceVisibility,
 		autoconvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoconvert_v1_SourceControlUser_To_api_SourceControlUser,
 		autoconvert_v1_SourceRevision_To_api_SourceRevision,
@@ -9361,6 +11022,7 @@ func init() {
 		autoconvert_v1_TLSConfig_To_api_TLSConfig,
 		autoconvert_v1_TemplateList_To_api_TemplateList,
 		autoconvert_v1_Template_To_api_Template,
+		autoconvert_v1_UsageCost_To_api_UsageCost,
 		autoconvert_v1_UserIdentityMapping_To_api_UserIdentityMapping,
 		autoconvert_v1_UserList_To_api_UserList,
 		autoconvert_v1_User_To_api_User,
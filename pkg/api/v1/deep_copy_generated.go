@@ -44,6 +44,28 @@ func deepCopy_v1_Application(in v1.Application, out *v1.Application, c *conversi
 	return nil
 }
 
+func deepCopy_v1_ApplicationCondition(in v1.ApplicationCondition, out *v1.ApplicationCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_ApplicationItemStatus(in v1.ApplicationItemStatus, out *v1.ApplicationItemStatus, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Health = in.Health
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_ApplicationList(in v1.ApplicationList, out *v1.ApplicationList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -68,6 +90,106 @@ func deepCopy_v1_ApplicationList(in v1.ApplicationList, out *v1.ApplicationList,
 	return nil
 }
 
+func deepCopy_v1_ApplicationPromotion(in v1.ApplicationPromotion, out *v1.ApplicationPromotion, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_ApplicationPromotionSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_ApplicationPromotionStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_ApplicationPromotionItemStatus(in v1.ApplicationPromotionItemStatus, out *v1.ApplicationPromotionItemStatus, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_ApplicationPromotionList(in v1.ApplicationPromotionList, out *v1.ApplicationPromotionList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationPromotion, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_ApplicationPromotion(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ApplicationPromotionSpec(in v1.ApplicationPromotionSpec, out *v1.ApplicationPromotionSpec, c *conversion.Cloner) error {
+	out.Application = in.Application
+	out.TargetNamespace = in.TargetNamespace
+	if in.Plans != nil {
+		out.Plans = make(map[string]string)
+		for key, val := range in.Plans {
+			out.Plans[key] = val
+		}
+	} else {
+		out.Plans = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ApplicationPromotionStatus(in v1.ApplicationPromotionStatus, out *v1.ApplicationPromotionStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationPromotionItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_ApplicationPromotionItemStatus(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_ApplicationSpec(in v1.ApplicationSpec, out *v1.ApplicationSpec, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if in.Items != nil {
@@ -94,6 +216,26 @@ func deepCopy_v1_ApplicationSpec(in v1.ApplicationSpec, out *v1.ApplicationSpec,
 
 func deepCopy_v1_ApplicationStatus(in v1.ApplicationStatus, out *v1.ApplicationStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.Items != nil {
+		out.Items = make([]v1.ApplicationItemStatus, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_ApplicationItemStatus(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]v1.ApplicationCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_ApplicationCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -879,6 +1021,10 @@ func deepCopy_v1_ServicePlan(in backingserviceapiv1.ServicePlan, out *backingser
 		return err
 	}
 	out.Free = in.Free
+	out.Deprecated = in.Deprecated
+	if err := deepCopy_v1_ServicePlanSchemas(in.Schemas, &out.Schemas, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -918,6 +1064,154 @@ func deepCopy_v1_ServicePlanMetadata(in backingserviceapiv1.ServicePlanMetadata,
 	return nil
 }
 
+func deepCopy_v1_ServicePlanSchemas(in backingserviceapiv1.ServicePlanSchemas, out *backingserviceapiv1.ServicePlanSchemas, c *conversion.Cloner) error {
+	out.InstanceCreate = in.InstanceCreate
+	out.InstanceUpdate = in.InstanceUpdate
+	out.BindingCreate = in.BindingCreate
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBinding(in backingserviceinstanceapiv1.BackingServiceBinding, out *backingserviceinstanceapiv1.BackingServiceBinding, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_BackingServiceBindingSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_BackingServiceBindingStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBindingCondition(in backingserviceinstanceapiv1.BackingServiceBindingCondition, out *backingserviceinstanceapiv1.BackingServiceBindingCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBindingList(in backingserviceinstanceapiv1.BackingServiceBindingList, out *backingserviceinstanceapiv1.BackingServiceBindingList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapiv1.BackingServiceBinding, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_BackingServiceBinding(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBindingRotation(in backingserviceinstanceapiv1.BackingServiceBindingRotation, out *backingserviceinstanceapiv1.BackingServiceBindingRotation, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.StartTime); err != nil {
+		return err
+	} else {
+		out.StartTime = newVal.(unversioned.Time)
+	}
+	out.BindUuid = in.BindUuid
+	out.RetiredBindUuid = in.RetiredBindUuid
+	out.RetiredCredentialsSecret = in.RetiredCredentialsSecret
+	out.LatestVersion = in.LatestVersion
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBindingSpec(in backingserviceinstanceapiv1.BackingServiceBindingSpec, out *backingserviceinstanceapiv1.BackingServiceBindingSpec, c *conversion.Cloner) error {
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BindKind = in.BindKind
+	out.ResourceName = in.ResourceName
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]string)
+		for key, val := range in.Parameters {
+			out.Parameters[key] = val
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.RotationPeriod != nil {
+		if newVal, err := c.DeepCopy(in.RotationPeriod); err != nil {
+			return err
+		} else {
+			out.RotationPeriod = newVal.(*unversioned.Duration)
+		}
+	} else {
+		out.RotationPeriod = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceBindingStatus(in backingserviceinstanceapiv1.BackingServiceBindingStatus, out *backingserviceinstanceapiv1.BackingServiceBindingStatus, c *conversion.Cloner) error {
+	out.Phase = in.Phase
+	out.BindUuid = in.BindUuid
+	if in.BoundTime != nil {
+		if newVal, err := c.DeepCopy(in.BoundTime); err != nil {
+			return err
+		} else {
+			out.BoundTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.BoundTime = nil
+	}
+	out.CredentialsSecret = in.CredentialsSecret
+	if in.Conditions != nil {
+		out.Conditions = make([]backingserviceinstanceapiv1.BackingServiceBindingCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_BackingServiceBindingCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.RouteServiceURL = in.RouteServiceURL
+	if in.RotatedTime != nil {
+		if newVal, err := c.DeepCopy(in.RotatedTime); err != nil {
+			return err
+		} else {
+			out.RotatedTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.RotatedTime = nil
+	}
+	out.RotationRequest = in.RotationRequest
+	if in.Rotation != nil {
+		out.Rotation = new(backingserviceinstanceapiv1.BackingServiceBindingRotation)
+		if err := deepCopy_v1_BackingServiceBindingRotation(*in.Rotation, out.Rotation, c); err != nil {
+			return err
+		}
+	} else {
+		out.Rotation = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_BackingServiceInstance(in backingserviceinstanceapiv1.BackingServiceInstance, out *backingserviceinstanceapiv1.BackingServiceInstance, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1000,6 +1294,151 @@ func deepCopy_v1_BackingServiceInstanceStatus(in backingserviceinstanceapiv1.Bac
 	} else {
 		out.LastOperation = nil
 	}
+	out.AppliedPlanGuid = in.AppliedPlanGuid
+	if in.AppliedParameters != nil {
+		out.AppliedParameters = make(map[string]string)
+		for key, val := range in.AppliedParameters {
+			out.AppliedParameters[key] = val
+		}
+	} else {
+		out.AppliedParameters = nil
+	}
+	if in.Deletion != nil {
+		out.Deletion = new(backingserviceinstanceapiv1.InstanceDeletionStatus)
+		if err := deepCopy_v1_InstanceDeletionStatus(*in.Deletion, out.Deletion, c); err != nil {
+			return err
+		}
+	} else {
+		out.Deletion = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceUsage(in backingserviceinstanceapiv1.BackingServiceUsage, out *backingserviceinstanceapiv1.BackingServiceUsage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	out.BackingServiceInstanceName = in.BackingServiceInstanceName
+	out.BackingServiceInstanceUID = in.BackingServiceInstanceUID
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	if in.Costs != nil {
+		out.Costs = make([]backingserviceinstanceapiv1.UsageCost, len(in.Costs))
+		for i := range in.Costs {
+			if err := deepCopy_v1_UsageCost(in.Costs[i], &out.Costs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Costs = nil
+	}
+	if newVal, err := c.DeepCopy(in.Start); err != nil {
+		return err
+	} else {
+		out.Start = newVal.(unversioned.Time)
+	}
+	if in.End != nil {
+		if newVal, err := c.DeepCopy(in.End); err != nil {
+			return err
+		} else {
+			out.End = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceUsageList(in backingserviceinstanceapiv1.BackingServiceUsageList, out *backingserviceinstanceapiv1.BackingServiceUsageList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]backingserviceinstanceapiv1.BackingServiceUsage, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_BackingServiceUsage(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceUsageReport(in backingserviceinstanceapiv1.BackingServiceUsageReport, out *backingserviceinstanceapiv1.BackingServiceUsageReport, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_BackingServiceUsageReportSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_BackingServiceUsageReportStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceUsageReportSpec(in backingserviceinstanceapiv1.BackingServiceUsageReportSpec, out *backingserviceinstanceapiv1.BackingServiceUsageReportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Start); err != nil {
+		return err
+	} else {
+		out.Start = newVal.(unversioned.Time)
+	}
+	if in.End != nil {
+		if newVal, err := c.DeepCopy(in.End); err != nil {
+			return err
+		} else {
+			out.End = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.End = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BackingServiceUsageReportStatus(in backingserviceinstanceapiv1.BackingServiceUsageReportStatus, out *backingserviceinstanceapiv1.BackingServiceUsageReportStatus, c *conversion.Cloner) error {
+	if in.Plans != nil {
+		out.Plans = make([]backingserviceinstanceapiv1.PlanUsage, len(in.Plans))
+		for i := range in.Plans {
+			if err := deepCopy_v1_PlanUsage(in.Plans[i], &out.Plans[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Total != nil {
+		out.Total = make(map[string]float64)
+		for key, val := range in.Total {
+			out.Total[key] = val
+		}
+	} else {
+		out.Total = nil
+	}
 	return nil
 }
 
@@ -1017,6 +1456,8 @@ func deepCopy_v1_BindingRequestOptions(in backingserviceinstanceapiv1.BindingReq
 	out.BindKind = in.BindKind
 	out.BindResourceVersion = in.BindResourceVersion
 	out.ResourceName = in.ResourceName
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
 	return nil
 }
 
@@ -1031,6 +1472,7 @@ func deepCopy_v1_InstanceBinding(in backingserviceinstanceapiv1.InstanceBinding,
 		out.BoundTime = nil
 	}
 	out.BindUuid = in.BindUuid
+	out.BindKind = in.BindKind
 	out.BindDeploymentConfig = in.BindDeploymentConfig
 	if in.Credentials != nil {
 		out.Credentials = make(map[string]string)
@@ -1040,12 +1482,30 @@ func deepCopy_v1_InstanceBinding(in backingserviceinstanceapiv1.InstanceBinding,
 	} else {
 		out.Credentials = nil
 	}
+	out.CredentialsSecret = in.CredentialsSecret
+	out.Injection = in.Injection
+	out.MountPath = in.MountPath
+	return nil
+}
+
+func deepCopy_v1_InstanceDeletionStatus(in backingserviceinstanceapiv1.InstanceDeletionStatus, out *backingserviceinstanceapiv1.InstanceDeletionStatus, c *conversion.Cloner) error {
+	out.RemainingBindings = in.RemainingBindings
+	if in.FailedBindings != nil {
+		out.FailedBindings = make([]string, len(in.FailedBindings))
+		for i := range in.FailedBindings {
+			out.FailedBindings[i] = in.FailedBindings[i]
+		}
+	} else {
+		out.FailedBindings = nil
+	}
+	out.Message = in.Message
 	return nil
 }
 
 func deepCopy_v1_InstanceProvisioning(in backingserviceinstanceapiv1.InstanceProvisioning, out *backingserviceinstanceapiv1.InstanceProvisioning, c *conversion.Cloner) error {
 	out.DashboardUrl = in.DashboardUrl
 	out.BackingServiceName = in.BackingServiceName
+	out.BackingServiceNamespace = in.BackingServiceNamespace
 	out.BackingServiceSpecID = in.BackingServiceSpecID
 	out.BackingServicePlanGuid = in.BackingServicePlanGuid
 	out.BackingServicePlanName = in.BackingServicePlanName
@@ -1064,6 +1524,47 @@ func deepCopy_v1_LastOperation(in backingserviceinstanceapiv1.LastOperation, out
 	out.State = in.State
 	out.Description = in.Description
 	out.AsyncPollIntervalSeconds = in.AsyncPollIntervalSeconds
+	out.Operation = in.Operation
+	out.Mitigation = in.Mitigation
+	return nil
+}
+
+func deepCopy_v1_PlanUsage(in backingserviceinstanceapiv1.PlanUsage, out *backingserviceinstanceapiv1.PlanUsage, c *conversion.Cloner) error {
+	out.BackingServiceName = in.BackingServiceName
+	out.BackingServicePlanGuid = in.BackingServicePlanGuid
+	out.BackingServicePlanName = in.BackingServicePlanName
+	out.Free = in.Free
+	out.Instances = in.Instances
+	out.Hours = in.Hours
+	if in.Cost != nil {
+		out.Cost = make(map[string]float64)
+		for key, val := range in.Cost {
+			out.Cost[key] = val
+		}
+	} else {
+		out.Cost = nil
+	}
+	if in.UnmeteredUnits != nil {
+		out.UnmeteredUnits = make([]string, len(in.UnmeteredUnits))
+		for i := range in.UnmeteredUnits {
+			out.UnmeteredUnits[i] = in.UnmeteredUnits[i]
+		}
+	} else {
+		out.UnmeteredUnits = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_UsageCost(in backingserviceinstanceapiv1.UsageCost, out *backingserviceinstanceapiv1.UsageCost, c *conversion.Cloner) error {
+	if in.Amount != nil {
+		out.Amount = make(map[string]float64)
+		for key, val := range in.Amount {
+			out.Amount[key] = val
+		}
+	} else {
+		out.Amount = nil
+	}
+	out.Unit = in.Unit
 	return nil
 }
 
@@ -2923,6 +3424,56 @@ func deepCopy_v1_NetNamespaceList(in sdnapiv1.NetNamespaceList, out *sdnapiv1.Ne
 	return nil
 }
 
+func deepCopy_v1_ProjectServiceBroker(in servicebrokerapiv1.ProjectServiceBroker, out *servicebrokerapiv1.ProjectServiceBroker, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_ServiceBrokerSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_ServiceBrokerStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_ProjectServiceBrokerList(in servicebrokerapiv1.ProjectServiceBrokerList, out *servicebrokerapiv1.ProjectServiceBrokerList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]servicebrokerapiv1.ProjectServiceBroker, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_ProjectServiceBroker(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_SecretReference(in servicebrokerapiv1.SecretReference, out *servicebrokerapiv1.SecretReference, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_v1_ServiceBroker(in servicebrokerapiv1.ServiceBroker, out *servicebrokerapiv1.ServiceBroker, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2943,6 +3494,31 @@ func deepCopy_v1_ServiceBroker(in servicebrokerapiv1.ServiceBroker, out *service
 	return nil
 }
 
+func deepCopy_v1_ServiceBrokerCondition(in servicebrokerapiv1.ServiceBrokerCondition, out *servicebrokerapiv1.ServiceBrokerCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastProbeTime); err != nil {
+		return err
+	} else {
+		out.LastProbeTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_ServiceBrokerHealthCheck(in servicebrokerapiv1.ServiceBrokerHealthCheck, out *servicebrokerapiv1.ServiceBrokerHealthCheck, c *conversion.Cloner) error {
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.FailureThreshold = in.FailureThreshold
+	return nil
+}
+
 func deepCopy_v1_ServiceBrokerList(in servicebrokerapiv1.ServiceBrokerList, out *servicebrokerapiv1.ServiceBrokerList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2972,6 +3548,57 @@ func deepCopy_v1_ServiceBrokerSpec(in servicebrokerapiv1.ServiceBrokerSpec, out
 	out.Name = in.Name
 	out.UserName = in.UserName
 	out.Password = in.Password
+	if in.AuthSecretRef != nil {
+		out.AuthSecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := deepCopy_v1_SecretReference(*in.AuthSecretRef, out.AuthSecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.AuthSecretRef = nil
+	}
+	if in.CABundle != nil {
+		out.CABundle = make([]uint8, len(in.CABundle))
+		for i := range in.CABundle {
+			out.CABundle[i] = in.CABundle[i]
+		}
+	} else {
+		out.CABundle = nil
+	}
+	if in.CASecretRef != nil {
+		out.CASecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := deepCopy_v1_SecretReference(*in.CASecretRef, out.CASecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.CASecretRef = nil
+	}
+	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
+	if in.ClientCertSecretRef != nil {
+		out.ClientCertSecretRef = new(servicebrokerapiv1.SecretReference)
+		if err := deepCopy_v1_SecretReference(*in.ClientCertSecretRef, out.ClientCertSecretRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.Visibility != nil {
+		out.Visibility = make([]servicebrokerapiv1.ServiceVisibility, len(in.Visibility))
+		for i := range in.Visibility {
+			if err := deepCopy_v1_ServiceVisibility(in.Visibility[i], &out.Visibility[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Visibility = nil
+	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(servicebrokerapiv1.ServiceBrokerHealthCheck)
+		if err := deepCopy_v1_ServiceBrokerHealthCheck(*in.HealthCheck, out.HealthCheck, c); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -2985,6 +3612,55 @@ func deepCopy_v1_ServiceBrokerSpec(in servicebrokerapiv1.ServiceBrokerSpec, out
 
 func deepCopy_v1_ServiceBrokerStatus(in servicebrokerapiv1.ServiceBrokerStatus, out *servicebrokerapiv1.ServiceBrokerStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.Conditions != nil {
+		out.Conditions = make([]servicebrokerapiv1.ServiceBrokerCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_ServiceBrokerCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.CatalogHash = in.CatalogHash
+	out.ServiceCount = in.ServiceCount
+	return nil
+}
+
+func deepCopy_v1_ServiceVisibility(in servicebrokerapiv1.ServiceVisibility, out *servicebrokerapiv1.ServiceVisibility, c *conversion.Cloner) error {
+	if in.Services != nil {
+		out.Services = make([]string, len(in.Services))
+		for i := range in.Services {
+			out.Services[i] = in.Services[i]
+		}
+	} else {
+		out.Services = nil
+	}
+	if in.Plans != nil {
+		out.Plans = make([]string, len(in.Plans))
+		for i := range in.Plans {
+			out.Plans[i] = in.Plans[i]
+		}
+	} else {
+		out.Plans = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	if in.NamespaceSelector != nil {
+		out.NamespaceSelector = make(map[string]string)
+		for key, val := range in.NamespaceSelector {
+			out.NamespaceSelector[key] = val
+		}
+	} else {
+		out.NamespaceSelector = nil
+	}
 	return nil
 }
 
@@ -3248,7 +3924,14 @@ func deepCopy_v1_UserList(in userapiv1.UserList, out *userapiv1.UserList, c *con
 func init() {
 	err := api.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_v1_Application,
+		deepCopy_v1_ApplicationCondition,
+		deepCopy_v1_ApplicationItemStatus,
 		deepCopy_v1_ApplicationList,
+		deepCopy_v1_ApplicationPromotion,
+		deepCopy_v1_ApplicationPromotionItemStatus,
+		deepCopy_v1_ApplicationPromotionList,
+		deepCopy_v1_ApplicationPromotionSpec,
+		deepCopy_v1_ApplicationPromotionStatus,
 		deepCopy_v1_ApplicationSpec,
 		deepCopy_v1_ApplicationStatus,
 		deepCopy_v1_Item,
@@ -3288,14 +3971,29 @@ func init() {
 		deepCopy_v1_ServicePlan,
 		deepCopy_v1_ServicePlanCost,
 		deepCopy_v1_ServicePlanMetadata,
+		deepCopy_v1_ServicePlanSchemas,
+		deepCopy_v1_BackingServiceBinding,
+		deepCopy_v1_BackingServiceBindingCondition,
+		deepCopy_v1_BackingServiceBindingList,
+		deepCopy_v1_BackingServiceBindingRotation,
+		deepCopy_v1_BackingServiceBindingSpec,
+		deepCopy_v1_BackingServiceBindingStatus,
 		deepCopy_v1_BackingServiceInstance,
 		deepCopy_v1_BackingServiceInstanceList,
 		deepCopy_v1_BackingServiceInstanceSpec,
 		deepCopy_v1_BackingServiceInstanceStatus,
+		deepCopy_v1_BackingServiceUsage,
+		deepCopy_v1_BackingServiceUsageList,
+		deepCopy_v1_BackingServiceUsageReport,
+		deepCopy_v1_BackingServiceUsageReportSpec,
+		deepCopy_v1_BackingServiceUsageReportStatus,
 		deepCopy_v1_BindingRequestOptions,
 		deepCopy_v1_InstanceBinding,
+		deepCopy_v1_InstanceDeletionStatus,
 		deepCopy_v1_InstanceProvisioning,
 		deepCopy_v1_LastOperation,
+		deepCopy_v1_PlanUsage,
+		deepCopy_v1_UsageCost,
 		deepCopy_v1_BinaryBuildRequestOptions,
 		deepCopy_v1_BinaryBuildSource,
 		deepCopy_v1_Build,
@@ -3382,10 +4080,16 @@ func init() {
 		deepCopy_v1_HostSubnetList,
 		deepCopy_v1_NetNamespace,
 		deepCopy_v1_NetNamespaceList,
+		deepCopy_v1_ProjectServiceBroker,
+		deepCopy_v1_ProjectServiceBrokerList,
+		deepCopy_v1_SecretReference,
 		deepCopy_v1_ServiceBroker,
+		deepCopy_v1_ServiceBrokerCondition,
+		deepCopy_v1_ServiceBrokerHealthCheck,
 		deepCopy_v1_ServiceBrokerList,
 		deepCopy_v1_ServiceBrokerSpec,
 		deepCopy_v1_ServiceBrokerStatus,
+		deepCopy_v1_ServiceVisibility,
 		deepCopy_v1_Parameter,
 		deepCopy_v1_Template,
 		deepCopy_v1_TemplateList,
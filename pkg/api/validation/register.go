@@ -1,6 +1,7 @@
 package validation
 
 import (
+	applicationvalidation "github.com/openshift/origin/pkg/application/api/validation"
 	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
 	backingservicevalidation "github.com/openshift/origin/pkg/backingservice/api/validation"
 	backingserviceinstancevalidation "github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
@@ -16,6 +17,7 @@ import (
 	uservalidation "github.com/openshift/origin/pkg/user/api/validation"
 	extvalidation "k8s.io/kubernetes/pkg/apis/extensions/validation"
 
+	applicationapi "github.com/openshift/origin/pkg/application/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
@@ -85,8 +87,13 @@ func init() {
 	Validator.Register(&userapi.Group{}, uservalidation.ValidateGroup, uservalidation.ValidateGroupUpdate)
 
 	Validator.Register(&servicebrokerapi.ServiceBroker{}, servicebrokervalidation.ValidateServiceBroker, servicebrokervalidation.ValidateServiceBrokerUpdate)
+	Validator.Register(&servicebrokerapi.ProjectServiceBroker{}, servicebrokervalidation.ValidateProjectServiceBroker, servicebrokervalidation.ValidateProjectServiceBrokerUpdate)
 	Validator.Register(&backingserviceapi.BackingService{}, backingservicevalidation.ValidateBackingService, backingservicevalidation.ValidateBackingServiceUpdate)
 	Validator.Register(&backingserviceinstanceapi.BackingServiceInstance{}, backingserviceinstancevalidation.ValidateBackingServiceInstance, backingserviceinstancevalidation.ValidateBackingServiceInstanceUpdate)
 	//Validator.Register(&backingserviceinstanceapi.BindingRequest{}, backingserviceinstancevalidation.ValidateBackingServiceInstanceBindingRequest, nil)
 	Validator.Register(&backingserviceinstanceapi.BindingRequestOptions{}, backingserviceinstancevalidation.ValidateBackingServiceInstanceBindingRequestOptions, nil)
+	Validator.Register(&backingserviceinstanceapi.BackingServiceBinding{}, backingserviceinstancevalidation.ValidateBackingServiceBinding, backingserviceinstancevalidation.ValidateBackingServiceBindingUpdate)
+	Validator.Register(&backingserviceinstanceapi.BackingServiceUsage{}, backingserviceinstancevalidation.ValidateBackingServiceUsage, backingserviceinstancevalidation.ValidateBackingServiceUsageUpdate)
+	Validator.Register(&backingserviceinstanceapi.BackingServiceUsageReport{}, backingserviceinstancevalidation.ValidateBackingServiceUsageReport, nil)
+	Validator.Register(&applicationapi.ApplicationPromotion{}, applicationvalidation.ValidateApplicationPromotion, applicationvalidation.ValidateApplicationPromotionUpdate)
 }
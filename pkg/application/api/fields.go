@@ -7,3 +7,13 @@ func ApplicationToSelectableFields(application *Application) fields.Set {
 		"metadata.name": application.Name,
 	}
 }
+
+// ApplicationPromotionToSelectableFields returns a label set that represents the object
+func ApplicationPromotionToSelectableFields(promotion *ApplicationPromotion) fields.Set {
+	return fields.Set{
+		"metadata.name":        promotion.Name,
+		"spec.application":     promotion.Spec.Application,
+		"spec.targetNamespace": promotion.Spec.TargetNamespace,
+		"status.phase":         string(promotion.Status.Phase),
+	}
+}
@@ -8,8 +8,12 @@ func init() {
 	api.Scheme.AddKnownTypes("",
 		&Application{},
 		&ApplicationList{},
+		&ApplicationPromotion{},
+		&ApplicationPromotionList{},
 	)
 }
 
-func (*Application) IsAnAPIObject()     {}
-func (*ApplicationList) IsAnAPIObject() {}
+func (*Application) IsAnAPIObject()              {}
+func (*ApplicationList) IsAnAPIObject()          {}
+func (*ApplicationPromotion) IsAnAPIObject()     {}
+func (*ApplicationPromotionList) IsAnAPIObject() {}
@@ -30,12 +30,21 @@ const (
 	ApplicationItemLabelDelete string = "Resource Label Not Found"
 )
 
+// ApplicationItemSupportKinds are the kinds of the resources Applications may hold.
+// Items are labelled, checked and deleted through the RESTMapper, so a kind it maps
+// only needs to be listed here to be supported. Items of the cluster scoped kinds are
+// only checked: they are shared by every project and never labelled or deleted.
 var ApplicationItemSupportKinds = []string{
-	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "ImageStreamTag", "ImageStreamImage", //openshift kind
-	"Event", "Node", "Job", "Pod", "ReplicationController", "Service", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
-	"ServiceBroker", "BackingServiceInstance",
+	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "Route", "Template", //openshift kind
+	"Node", "Pod", "ReplicationController", "Service", "Secret", "ServiceAccount", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
+	"ServiceBroker", "BackingServiceInstance", "BackingServiceBinding",
 }
 
+// LastAppliedManifestAnnotation records on an Application the definitions of the items
+// apply-application last created or updated, as a List. The items dropped from the next
+// manifest applied are deleted, the others are patched with a three-way merge.
+const LastAppliedManifestAnnotation = "asiainfo.io/last-applied-manifest"
+
 type ApplicationPhase string
 
 type Application struct {
@@ -67,6 +76,53 @@ type ApplicationSpec struct {
 
 type ApplicationStatus struct {
 	Phase ApplicationPhase
+	// Items is the health of each item of the Application.
+	Items []ApplicationItemStatus
+	// Conditions roll the health of the items up.
+	Conditions []ApplicationCondition
+}
+
+// ApplicationItemHealth is the health of an item of an Application.
+type ApplicationItemHealth string
+
+const (
+	// ApplicationItemReady items work: their deployments completed and pods are ready,
+	// their last build succeeded, they are bound...
+	ApplicationItemReady ApplicationItemHealth = "Ready"
+	// ApplicationItemProgressing items are being deployed, built, bound...
+	ApplicationItemProgressing ApplicationItemHealth = "Progressing"
+	// ApplicationItemFailed items are missing, or their deployment, build... failed.
+	ApplicationItemFailed ApplicationItemHealth = "Failed"
+)
+
+// ApplicationItemStatus is the health of an item of an Application.
+type ApplicationItemStatus struct {
+	Kind    string
+	Name    string
+	Health  ApplicationItemHealth
+	Reason  string
+	Message string
+}
+
+type ApplicationConditionType string
+
+const (
+	// ApplicationAvailable is true when all the items of the Application are ready.
+	ApplicationAvailable ApplicationConditionType = "Available"
+	// ApplicationProgressing is true when some items are being deployed, built, bound...
+	ApplicationProgressing ApplicationConditionType = "Progressing"
+	// ApplicationDegraded is true when some items failed.
+	ApplicationDegraded ApplicationConditionType = "Degraded"
+)
+
+// ApplicationCondition is the state of an Application as a whole.
+type ApplicationCondition struct {
+	Type   ApplicationConditionType
+	Status kapi.ConditionStatus
+	// LastTransitionTime is the last time Status changed.
+	LastTransitionTime unversioned.Time
+	Reason             string
+	Message            string
 }
 
 type ItemList []Item
@@ -83,3 +139,79 @@ const (
 	ApplicationItemStatusErr    = "error"
 	ApplicationItemStatusOk     = "ok"
 )
+
+// ApplicationPromotion copies the items of an Application into another project, and creates
+// or updates the Application of the same name there.
+type ApplicationPromotion struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	Spec ApplicationPromotionSpec
+
+	Status ApplicationPromotionStatus
+}
+
+type ApplicationPromotionList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ApplicationPromotion
+}
+
+type ApplicationPromotionSpec struct {
+	// Application is the name of the Application promoted, in the namespace of the promotion.
+	Application string
+	// TargetNamespace is the project the Application is promoted to.
+	TargetNamespace string
+	// Plans maps the plans of the BackingServiceInstances, by id or name, to the plans the
+	// instances are provisioned with in the target project. Plans not mapped are kept.
+	Plans map[string]string
+}
+
+type ApplicationPromotionPhase string
+
+const (
+	// ApplicationPromotionNew promotions are waiting for the controller.
+	ApplicationPromotionNew ApplicationPromotionPhase = "New"
+	// ApplicationPromotionRunning promotions are copying items.
+	ApplicationPromotionRunning ApplicationPromotionPhase = "Running"
+	// ApplicationPromotionComplete promotions copied all their items.
+	ApplicationPromotionComplete ApplicationPromotionPhase = "Complete"
+	// ApplicationPromotionFailed promotions failed to copy some items, or the Application.
+	ApplicationPromotionFailed ApplicationPromotionPhase = "Failed"
+)
+
+type ApplicationPromotionStatus struct {
+	Phase   ApplicationPromotionPhase
+	Message string
+	// StartTimestamp is when the controller started copying the items.
+	StartTimestamp *unversioned.Time
+	// CompletionTimestamp is when the promotion completed or failed.
+	CompletionTimestamp *unversioned.Time
+	// Items is the progress of each item, in the order they are promoted.
+	Items []ApplicationPromotionItemStatus
+}
+
+type ApplicationPromotionItemPhase string
+
+const (
+	// ApplicationPromotionItemPending items are not promoted yet.
+	ApplicationPromotionItemPending ApplicationPromotionItemPhase = "Pending"
+	// ApplicationPromotionItemCreated items were created in the target project.
+	ApplicationPromotionItemCreated ApplicationPromotionItemPhase = "Created"
+	// ApplicationPromotionItemUpdated items existed in the target project and were updated.
+	ApplicationPromotionItemUpdated ApplicationPromotionItemPhase = "Updated"
+	// ApplicationPromotionItemSkipped items are not promoted: they are created by other
+	// items, like the pods of deployments, or are not namespaced.
+	ApplicationPromotionItemSkipped ApplicationPromotionItemPhase = "Skipped"
+	// ApplicationPromotionItemFailed items could not be promoted.
+	ApplicationPromotionItemFailed ApplicationPromotionItemPhase = "Failed"
+)
+
+// ApplicationPromotionItemStatus is the progress of the promotion of an item.
+type ApplicationPromotionItemStatus struct {
+	Kind    string
+	Name    string
+	Phase   ApplicationPromotionItemPhase
+	Message string
+}
@@ -6,6 +6,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/namespace"
 
 	oapi "github.com/openshift/origin/pkg/api"
+	newer "github.com/openshift/origin/pkg/application/api"
 )
 
 func init() {
@@ -14,4 +15,9 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if err := kapi.Scheme.AddFieldLabelConversionFunc("v1", "ApplicationPromotion",
+		oapi.GetFieldLabelConversionFunc(newer.ApplicationPromotionToSelectableFields(&newer.ApplicationPromotion{}), nil),
+	); err != nil {
+		panic(err)
+	}
 }
@@ -8,8 +8,12 @@ func init() {
 	api.Scheme.AddKnownTypes("v1",
 		&Application{},
 		&ApplicationList{},
+		&ApplicationPromotion{},
+		&ApplicationPromotionList{},
 	)
 }
 
-func (*Application) IsAnAPIObject()     {}
-func (*ApplicationList) IsAnAPIObject() {}
+func (*Application) IsAnAPIObject()              {}
+func (*ApplicationList) IsAnAPIObject()          {}
+func (*ApplicationPromotion) IsAnAPIObject()     {}
+func (*ApplicationPromotionList) IsAnAPIObject() {}
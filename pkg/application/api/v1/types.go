@@ -31,9 +31,9 @@ const (
 )
 
 var ApplicationItemSupportKinds = []string{
-	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "ImageStreamTag", "ImageStreamImage", //openshift kind
-	"Event", "Node", "Job", "Pod", "ReplicationController", "Service", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
-	"ServiceBroker", "BackingServiceInstance",
+	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "Route", "Template", //openshift kind
+	"Node", "Pod", "ReplicationController", "Service", "Secret", "ServiceAccount", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
+	"ServiceBroker", "BackingServiceInstance", "BackingServiceBinding",
 }
 
 type ApplicationPhase string
@@ -71,6 +71,45 @@ type ApplicationSpec struct {
 // ApplicationStatus is information about the current status of a Application
 type ApplicationStatus struct {
 	Phase ApplicationPhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the Application"`
+	// Items is the health of each item of the Application.
+	Items []ApplicationItemStatus `json:"items,omitempty" description:"health of each item of the Application"`
+	// Conditions roll the health of the items up.
+	Conditions []ApplicationCondition `json:"conditions,omitempty" description:"overall state of the Application, rolled up from the health of its items"`
+}
+
+// ApplicationItemHealth is the health of an item of an Application.
+type ApplicationItemHealth string
+
+const (
+	ApplicationItemReady       ApplicationItemHealth = "Ready"
+	ApplicationItemProgressing ApplicationItemHealth = "Progressing"
+	ApplicationItemFailed      ApplicationItemHealth = "Failed"
+)
+
+// ApplicationItemStatus is the health of an item of an Application.
+type ApplicationItemStatus struct {
+	Kind    string                `json:"kind" description:"kind of the item"`
+	Name    string                `json:"name" description:"name of the item"`
+	Health  ApplicationItemHealth `json:"health" description:"health of the item: Ready, Progressing or Failed"`
+	Reason  string                `json:"reason,omitempty" description:"one-word CamelCase reason for the health of the item"`
+	Message string                `json:"message,omitempty" description:"human-readable message about the health of the item"`
+}
+
+type ApplicationConditionType string
+
+const (
+	ApplicationAvailable   ApplicationConditionType = "Available"
+	ApplicationProgressing ApplicationConditionType = "Progressing"
+	ApplicationDegraded    ApplicationConditionType = "Degraded"
+)
+
+// ApplicationCondition is the state of an Application as a whole.
+type ApplicationCondition struct {
+	Type               ApplicationConditionType `json:"type" description:"type of the condition: Available, Progressing or Degraded"`
+	Status             kapi.ConditionStatus     `json:"status" description:"status of the condition: True, False or Unknown"`
+	LastTransitionTime unversioned.Time         `json:"lastTransitionTime,omitempty" description:"last time the status of the condition changed"`
+	Reason             string                   `json:"reason,omitempty" description:"one-word CamelCase reason for the status of the condition"`
+	Message            string                   `json:"message,omitempty" description:"human-readable message about the status of the condition"`
 }
 
 type ItemList []Item
@@ -88,3 +127,66 @@ const (
 	ApplicationItemStatusErr    = "error"
 	ApplicationItemStatusOk     = "ok"
 )
+
+// ApplicationPromotion copies the items of an Application into another project, and creates
+// or updates the Application of the same name there.
+type ApplicationPromotion struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec defines the Application promoted and where to.
+	Spec ApplicationPromotionSpec `json:"spec" description:"spec defines the Application promoted and the project it is promoted to"`
+
+	// Status describes the progress of the promotion.
+	Status ApplicationPromotionStatus `json:"status,omitempty" description:"status describes the progress of the promotion; read-only"`
+}
+
+type ApplicationPromotionList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of application promotions
+	Items []ApplicationPromotion `json:"items" description:"list of ApplicationPromotions"`
+}
+
+type ApplicationPromotionSpec struct {
+	Application     string            `json:"application" description:"name of the Application promoted, in the namespace of the promotion"`
+	TargetNamespace string            `json:"targetNamespace" description:"project the Application is promoted to"`
+	Plans           map[string]string `json:"plans,omitempty" description:"maps the plans of the backing service instances, by id or name, to the plans they are provisioned with in the target project; plans not mapped are kept"`
+}
+
+type ApplicationPromotionPhase string
+
+const (
+	ApplicationPromotionNew      ApplicationPromotionPhase = "New"
+	ApplicationPromotionRunning  ApplicationPromotionPhase = "Running"
+	ApplicationPromotionComplete ApplicationPromotionPhase = "Complete"
+	ApplicationPromotionFailed   ApplicationPromotionPhase = "Failed"
+)
+
+// ApplicationPromotionStatus is the progress of an ApplicationPromotion.
+type ApplicationPromotionStatus struct {
+	Phase               ApplicationPromotionPhase        `json:"phase,omitempty" description:"phase of the promotion: New, Running, Complete or Failed"`
+	Message             string                           `json:"message,omitempty" description:"human-readable message about the phase of the promotion"`
+	StartTimestamp      *unversioned.Time                `json:"startTimestamp,omitempty" description:"when the items started being promoted"`
+	CompletionTimestamp *unversioned.Time                `json:"completionTimestamp,omitempty" description:"when the promotion completed or failed"`
+	Items               []ApplicationPromotionItemStatus `json:"items,omitempty" description:"progress of each item, in the order they are promoted"`
+}
+
+type ApplicationPromotionItemPhase string
+
+const (
+	ApplicationPromotionItemPending ApplicationPromotionItemPhase = "Pending"
+	ApplicationPromotionItemCreated ApplicationPromotionItemPhase = "Created"
+	ApplicationPromotionItemUpdated ApplicationPromotionItemPhase = "Updated"
+	ApplicationPromotionItemSkipped ApplicationPromotionItemPhase = "Skipped"
+	ApplicationPromotionItemFailed  ApplicationPromotionItemPhase = "Failed"
+)
+
+// ApplicationPromotionItemStatus is the progress of the promotion of an item.
+type ApplicationPromotionItemStatus struct {
+	Kind    string                        `json:"kind" description:"kind of the item"`
+	Name    string                        `json:"name" description:"name of the item"`
+	Phase   ApplicationPromotionItemPhase `json:"phase" description:"phase of the item: Pending, Created, Updated, Skipped or Failed"`
+	Message string                        `json:"message,omitempty" description:"human-readable message about the promotion of the item"`
+}
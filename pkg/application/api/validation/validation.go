@@ -45,75 +45,11 @@ func ValidationApplicationItemKind(items applicationapi.ItemList) (bool, string)
 }
 
 func ValidationApplicationItemName(namespace string, items applicationapi.ItemList, oClient *oclient.Client, kClient *kclient.Client) (bool, string) {
+	itemClient := applicationutil.NewItemClient(oClient, kClient)
 	for _, item := range items {
-		switch item.Kind {
-		case "ServiceBroker":
-			if _, err := oClient.ServiceBrokers().Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-		case "BackingServiceInstance":
-			if _, err := oClient.BackingServiceInstances(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "Build":
-			if _, err := oClient.Builds(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "BuildConfig":
-			if _, err := oClient.BuildConfigs(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "DeploymentConfig":
-			if _, err := oClient.DeploymentConfigs(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "ImageStream":
-			if _, err := oClient.ImageStreams(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "ReplicationController":
-			if _, err := kClient.ReplicationControllers(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "Node":
-			if _, err := kClient.Nodes().Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "Pod":
-			if _, err := kClient.Pods(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
-			}
-
-		case "Service":
-			if _, err := kClient.Services(namespace).Get(item.Name); err != nil {
-				if kerrors.IsNotFound(err) {
-					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
-				}
+		if _, err := itemClient.Get(namespace, item); err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
 			}
 		}
 	}
@@ -164,3 +100,48 @@ func ValidateApplicationUpdate(newApplication *applicationapi.Application, oldAp
 
 	return allErrs
 }
+
+// ValidateApplicationPromotion tests required fields for an ApplicationPromotion.
+func ValidateApplicationPromotion(promotion *applicationapi.ApplicationPromotion) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	result = append(result, validation.ValidateObjectMeta(&promotion.ObjectMeta, true, oapi.MinimalNameRequirements).Prefix("metadata")...)
+
+	if len(promotion.Spec.Application) == 0 {
+		result = append(result, fielderrors.NewFieldRequired("spec.application"))
+	} else if ok, reason := ValidateApplicationName(promotion.Spec.Application, false); !ok {
+		result = append(result, fielderrors.NewFieldInvalid("spec.application", promotion.Spec.Application, reason))
+	}
+
+	switch {
+	case len(promotion.Spec.TargetNamespace) == 0:
+		result = append(result, fielderrors.NewFieldRequired("spec.targetNamespace"))
+	case promotion.Spec.TargetNamespace == promotion.Namespace:
+		result = append(result, fielderrors.NewFieldInvalid("spec.targetNamespace", promotion.Spec.TargetNamespace, "must be another project than the one of the application"))
+	default:
+		if ok, reason := validation.ValidateNamespaceName(promotion.Spec.TargetNamespace, false); !ok {
+			result = append(result, fielderrors.NewFieldInvalid("spec.targetNamespace", promotion.Spec.TargetNamespace, reason))
+		}
+	}
+
+	for from, to := range promotion.Spec.Plans {
+		if len(from) == 0 || len(to) == 0 {
+			result = append(result, fielderrors.NewFieldInvalid("spec.plans", promotion.Spec.Plans, "plans must be mapped from and to a plan id or name"))
+			break
+		}
+	}
+
+	return result
+}
+
+// ValidateApplicationPromotionUpdate tests to make sure a promotion update can be applied,
+// only its status may change.
+func ValidateApplicationPromotionUpdate(newPromotion *applicationapi.ApplicationPromotion, oldPromotion *applicationapi.ApplicationPromotion) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&newPromotion.ObjectMeta, &oldPromotion.ObjectMeta).Prefix("metadata")...)
+
+	if !reflect.DeepEqual(newPromotion.Spec, oldPromotion.Spec) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec", newPromotion.Spec, "field is immutable"))
+	}
+
+	return allErrs
+}
@@ -1,9 +1,12 @@
 package controller
 
 import (
-	"errors"
 	api "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	osclient "github.com/openshift/origin/pkg/client"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	errutil "k8s.io/kubernetes/pkg/util/errors"
 )
@@ -15,6 +18,8 @@ type ApplicationController struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
+	// Items gets, updates and deletes the resources of the items of any kind.
+	Items *applicationutil.ItemClient
 }
 
 type fatalError string
@@ -60,52 +65,41 @@ func (c *ApplicationController) Handle(application *api.Application) (err error)
 }
 
 func (c *ApplicationController) unifyDaemon(application *api.Application) {
+	oldStatus := copyStatus(application.Status)
+	items := make([]api.ApplicationItemStatus, 0, len(application.Spec.Items))
 	for i := range application.Spec.Items {
-		switch application.Spec.Items[i].Kind {
-		case "ServiceBroker":
-			resource, err := c.Client.ServiceBrokers().Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "BackingServiceInstance":
-			resource, err := c.Client.BackingServiceInstances(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "Build":
-			resource, err := c.Client.Builds(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "BuildConfig":
-			resource, err := c.Client.BuildConfigs(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "DeploymentConfig":
-			resource, err := c.Client.DeploymentConfigs(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "ReplicationController":
-			resource, err := c.KubeClient.ReplicationControllers(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "ImageStream":
-			resource, err := c.Client.ImageStreams(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "Node":
-			resource, err := c.KubeClient.Nodes().Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "Pod":
-			resource, err := c.KubeClient.Pods(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
-
-		case "Service":
-			resource, err := c.KubeClient.Services(application.Namespace).Get(application.Spec.Items[i].Name)
-			errHandle(err, application, i, resource.Labels)
+		item := application.Spec.Items[i]
+		resource, err := c.Items.Get(application.Namespace, item)
+		var resourceLabels map[string]string
+		if err == nil {
+			if meta, err := kapi.ObjectMetaFor(resource); err == nil {
+				resourceLabels = meta.Labels
+			}
+		}
+		// cluster scoped items are never labelled, only check they exist.
+		if err != nil || !c.clusterScoped(item) {
+			errHandle(err, application, i, resourceLabels)
 		}
 
+		status, found := previousItemStatus(oldStatus.Items, item)
+		switch {
+		case err == nil:
+			if health, err := c.itemHealth(resource); err == nil {
+				status = health
+			} else if !found {
+				status = itemStatus(api.ApplicationItemProgressing, "Unknown", err.Error())
+			}
+		case kerrors.IsNotFound(err):
+			status = itemStatus(api.ApplicationItemFailed, "NotFound", "")
+		case !found:
+			status = itemStatus(api.ApplicationItemProgressing, "Unknown", err.Error())
+		}
+		status.Kind, status.Name = item.Kind, item.Name
+		items = append(items, status)
 	}
+	setHealth(&application.Status, items, unversioned.Now())
 
-	if application.Status.Phase == api.ApplicationChecking {
+	if application.Status.Phase == api.ApplicationChecking || statusChanged(oldStatus, application.Status) {
 		c.Client.Applications(application.Namespace).Update(application)
 	}
 }
@@ -118,45 +112,13 @@ func (c *ApplicationController) preHandleAllLabel(application *api.Application)
 	}
 
 	errs := []error{}
-
-	if err := unloadServiceBrokerLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadBackingServiceInstanceLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadBuildLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadBuildConfigLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadDeploymentConfigLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadImageStreamLabel(c.Client, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadReplicationControllerLabel(c.KubeClient, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadNodeLabel(c.KubeClient, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadPodLabel(c.KubeClient, application, selector); err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := unloadServiceLabel(c.KubeClient, application, selector); err != nil {
-		errs = append(errs, err)
+	for _, kind := range api.ApplicationItemSupportKinds {
+		if namespaced, err := c.Items.Namespaced(kind); err == nil && !namespaced {
+			continue
+		}
+		if err := unloadLabel(c.Items, application, kind, selector); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	return errutil.NewAggregate(errs)
@@ -169,54 +131,13 @@ func (c *ApplicationController) handleAllLabel(app *api.Application) error {
 
 	errs := []error{}
 	oldLength := len(app.Spec.Items)
-	for i, item := range app.Spec.Items {
+	for i := range app.Spec.Items {
 		newLength := len(app.Spec.Items)
 		deleteNum := oldLength - newLength
 		i = i - deleteNum
 
-		switch item.Kind {
-		case "ServiceBroker":
-			if err := c.handleServiceBrokerLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "BackingServiceInstance":
-			if err := c.handleBackingServiceInstanceLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "Build":
-			if err := c.handleBuildLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "BuildConfig":
-			if err := c.handleBuildConfigLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "DeploymentConfig":
-			if err := c.handleDeploymentConfigLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "ReplicationController":
-			if err := c.handleReplicationControllerLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "ImageStream":
-			if err := c.handleImageStreamLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "Node":
-			if err := c.handleNodeLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "Pod":
-			if err := c.handlePodLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		case "Service":
-			if err := c.handleServiceLabel(app, i); err != nil {
-				errs = append(errs, err)
-			}
-		default:
-			errs = append(errs, errors.New("unknown resource "+item.Kind+"="+item.Name))
+		if err := c.handleItemLabel(app, i); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
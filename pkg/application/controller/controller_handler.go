@@ -2,146 +2,28 @@ package controller
 
 import (
 	"fmt"
+
 	api "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 )
 
-func (c *ApplicationController) handleServiceBrokerLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.Client.ServiceBrokers()
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handleBackingServiceInstanceLabel(app *api.Application, itemIndex int) error {
+// handleItemLabel labels, unlabels or deletes the resource of an item of app, whatever
+// its kind, depending on the phase of app.
+func (c *ApplicationController) handleItemLabel(app *api.Application, itemIndex int) error {
 	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
 
-	client := c.Client.BackingServiceInstances(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
+	item := app.Spec.Items[itemIndex]
+	if c.clusterScoped(item) {
+		// shared by every project: only dropped from the application, never labelled or deleted.
+		if app.Status.Phase == api.ApplicationTerminating || app.Status.Phase == api.ApplicationTerminatingLabel {
+			c.removeItem(app, itemIndex)
 		}
+		return nil
 	}
 
-	return nil
-}
-
-func (c *ApplicationController) handleBuildLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.Client.Builds(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
+	resource, err := c.Items.Get(app.Namespace, item)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			c.deleteApplicationItem(app, itemIndex)
@@ -149,514 +31,71 @@ func (c *ApplicationController) handleBuildLabel(app *api.Application, itemIndex
 		}
 		return err
 	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handleBuildConfigLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.Client.BuildConfigs(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
+	meta, err := kapi.ObjectMetaFor(resource)
 	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
 		return err
 	}
 
 	switch app.Status.Phase {
 	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
+		if _, exists := meta.Labels[labelSelectorStr]; exists {
 			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
 			return nil
 		}
 		fallthrough
 	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
+		if meta.Labels == nil {
+			meta.Labels = make(map[string]string)
 		}
 
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
+		meta.Labels[labelSelectorStr] = app.Name
+		if err := c.Items.Update(app.Namespace, item, resource); err != nil {
 			return err
 		}
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
+		if !applicationutil.LabelExistsOtherApplicationKey(meta.Labels, labelSelectorStr) {
+			if err := c.Items.Delete(app.Namespace, item); err != nil {
 				return err
 			}
 		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
+			delete(meta.Labels, labelSelectorStr)
+			if err := c.Items.Update(app.Namespace, item, resource); err != nil {
 				return err
 			}
 		}
 
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
+		c.removeItem(app, itemIndex)
 
 	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
+		delete(meta.Labels, labelSelectorStr)
+		if err := c.Items.Update(app.Namespace, item, resource); err != nil {
 			return err
 		}
 
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
+		c.removeItem(app, itemIndex)
 	}
 
 	return nil
 }
 
-func (c *ApplicationController) handleDeploymentConfigLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.Client.DeploymentConfigs(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
+// clusterScoped returns true if the resource of item is not namespaced. The controller
+// acts with its own privileges, so it leaves such resources alone.
+func (c *ApplicationController) clusterScoped(item api.Item) bool {
+	namespaced, err := c.Items.Namespaced(item.Kind)
+	return err == nil && !namespaced
 }
 
-func (c *ApplicationController) handleImageStreamLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
+// removeItem drops the item at itemIndex from app, and deletes app once it has no item left.
+func (c *ApplicationController) removeItem(app *api.Application, itemIndex int) {
+	app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex+1:]...)
 
-	client := c.Client.ImageStreams(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
+	if len(app.Spec.Items) == 0 {
+		c.Client.Applications(app.Namespace).Delete(app.Name)
 	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handleReplicationControllerLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.KubeClient.ReplicationControllers(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handleNodeLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.KubeClient.Nodes()
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handlePodLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.KubeClient.Pods(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name, nil); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
-}
-
-func (c *ApplicationController) handleServiceLabel(app *api.Application, itemIndex int) error {
-	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
-
-	client := c.KubeClient.Services(app.Namespace)
-
-	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
-	if err != nil {
-		if kerrors.IsNotFound(err) {
-			c.deleteApplicationItem(app, itemIndex)
-			return nil
-		}
-		return err
-	}
-
-	switch app.Status.Phase {
-	case api.ApplicationActiveUpdate:
-		if _, exists := resource.Labels[labelSelectorStr]; exists {
-			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
-			return nil
-		}
-		fallthrough
-	case api.ApplicationNew:
-		if resource.Labels == nil {
-			resource.Labels = make(map[string]string)
-		}
-
-		resource.Labels[labelSelectorStr] = app.Name
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
-			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
-				return err
-			}
-		} else {
-			delete(resource.Labels, labelSelectorStr)
-			if _, err := client.Update(resource); err != nil {
-				return err
-			}
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-
-	case api.ApplicationTerminatingLabel:
-		delete(resource.Labels, labelSelectorStr)
-		if _, err := client.Update(resource); err != nil {
-			return err
-		}
-
-		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
-
-		if len(app.Spec.Items) == 0 {
-			c.Client.Applications(app.Namespace).Delete(app.Name)
-		}
-	}
-
-	return nil
 }
 
 func (c *ApplicationController) deleteApplicationItem(app *api.Application, itemIndex int) {
-	app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
+	app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex+1:]...)
 	c.Client.Applications(app.Namespace).Delete(app.Name)
 }
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/fake"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	osclient "github.com/openshift/origin/pkg/client"
+)
+
+// fakeApplications records the applications deleted in a namespace.
+type fakeApplications struct {
+	osclient.Interface
+	osclient.ApplicationInterface
+	deleted []string
+}
+
+func (f *fakeApplications) Applications(namespace string) osclient.ApplicationInterface {
+	return f
+}
+
+func (f *fakeApplications) Delete(name string) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+func TestHandleClusterScopedItem(t *testing.T) {
+	// any request to the resources of the items fails the test.
+	resources := &fake.RESTClient{
+		Client: fake.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL)
+			return nil, fmt.Errorf("unexpected request")
+		}),
+	}
+
+	for _, phase := range []api.ApplicationPhase{api.ApplicationNew, api.ApplicationActiveUpdate, api.ApplicationTerminating, api.ApplicationTerminatingLabel} {
+		applications := &fakeApplications{}
+		c := &ApplicationController{
+			Client: applications,
+			Items:  applicationutil.NewItemClient(resources, resources),
+		}
+		app := &api.Application{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "dev", Name: "app"},
+			Spec:       api.ApplicationSpec{Items: []api.Item{{Kind: "Node", Name: "node-1"}}},
+			Status:     api.ApplicationStatus{Phase: phase},
+		}
+
+		if err := c.handleItemLabel(app, 0); err != nil {
+			t.Errorf("%s: unexpected error: %v", phase, err)
+		}
+
+		terminating := phase == api.ApplicationTerminating || phase == api.ApplicationTerminatingLabel
+		if terminating != (len(app.Spec.Items) == 0) {
+			t.Errorf("%s: unexpected items %#v", phase, app.Spec.Items)
+		}
+		if terminating != (len(applications.deleted) == 1) {
+			t.Errorf("%s: unexpected deleted applications %v", phase, applications.deleted)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func itemStatus(health api.ApplicationItemHealth, reason, message string) api.ApplicationItemStatus {
+	return api.ApplicationItemStatus{Health: health, Reason: reason, Message: message}
+}
+
+// itemHealth returns the health of the resource of an item. Resources which have no
+// notion of readiness, like services or secrets, are ready once they exist.
+func (c *ApplicationController) itemHealth(obj runtime.Object) (api.ApplicationItemStatus, error) {
+	switch resource := obj.(type) {
+	case *deployapi.DeploymentConfig:
+		return c.deploymentConfigHealth(resource)
+	case *kapi.ReplicationController:
+		return c.podsHealth(resource)
+	case *kapi.Pod:
+		return podHealth(resource), nil
+	case *buildapi.BuildConfig:
+		return c.buildConfigHealth(resource)
+	case *buildapi.Build:
+		return buildHealth(resource), nil
+	case *backingserviceinstanceapi.BackingServiceInstance:
+		return backingServiceInstanceHealth(resource), nil
+	case *backingserviceinstanceapi.BackingServiceBinding:
+		return backingServiceBindingHealth(resource), nil
+	case *routeapi.Route:
+		return c.routeHealth(resource)
+	case *kapi.PersistentVolumeClaim:
+		if resource.Status.Phase == kapi.ClaimBound {
+			return itemStatus(api.ApplicationItemReady, "Bound", "bound to "+resource.Spec.VolumeName), nil
+		}
+		return itemStatus(api.ApplicationItemProgressing, string(resource.Status.Phase), "waiting for a volume"), nil
+	case *servicebrokerapi.ServiceBroker:
+		return serviceBrokerHealth(resource), nil
+	}
+	return itemStatus(api.ApplicationItemReady, "Exists", ""), nil
+}
+
+// deploymentConfigHealth is ready once the latest deployment of dc completed and its
+// pods are ready.
+func (c *ApplicationController) deploymentConfigHealth(dc *deployapi.DeploymentConfig) (api.ApplicationItemStatus, error) {
+	if dc.Status.LatestVersion == 0 {
+		return itemStatus(api.ApplicationItemProgressing, "NotDeployed", "not deployed yet"), nil
+	}
+	name := deployutil.LatestDeploymentNameForConfig(dc)
+	rc, err := c.KubeClient.ReplicationControllers(dc.Namespace).Get(name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return itemStatus(api.ApplicationItemProgressing, "Deploying", fmt.Sprintf("deployment %s is being created", name)), nil
+		}
+		return api.ApplicationItemStatus{}, err
+	}
+	switch status := deployutil.DeploymentStatusFor(rc); status {
+	case deployapi.DeploymentStatusComplete:
+		return c.podsHealth(rc)
+	case deployapi.DeploymentStatusFailed:
+		return itemStatus(api.ApplicationItemFailed, "DeploymentFailed", fmt.Sprintf("deployment %s failed", name)), nil
+	default:
+		return itemStatus(api.ApplicationItemProgressing, "Deploying", fmt.Sprintf("deployment %s is %s", name, strings.ToLower(string(status)))), nil
+	}
+}
+
+// podsHealth is ready once all the replicas of rc are ready.
+func (c *ApplicationController) podsHealth(rc *kapi.ReplicationController) (api.ApplicationItemStatus, error) {
+	pods, err := c.KubeClient.Pods(rc.Namespace).List(labels.SelectorFromSet(rc.Spec.Selector), fields.Everything())
+	if err != nil {
+		return api.ApplicationItemStatus{}, err
+	}
+	ready := 0
+	for i := range pods.Items {
+		if kapi.IsPodReady(&pods.Items[i]) {
+			ready++
+		}
+	}
+	message := fmt.Sprintf("%d/%d pods ready", ready, rc.Spec.Replicas)
+	if ready < rc.Spec.Replicas {
+		return itemStatus(api.ApplicationItemProgressing, "PodsNotReady", message), nil
+	}
+	return itemStatus(api.ApplicationItemReady, "PodsReady", message), nil
+}
+
+func podHealth(pod *kapi.Pod) api.ApplicationItemStatus {
+	switch pod.Status.Phase {
+	case kapi.PodSucceeded:
+		return itemStatus(api.ApplicationItemReady, "PodSucceeded", "")
+	case kapi.PodFailed:
+		return itemStatus(api.ApplicationItemFailed, "PodFailed", pod.Status.Message)
+	case kapi.PodRunning:
+		if kapi.IsPodReady(pod) {
+			return itemStatus(api.ApplicationItemReady, "PodReady", "")
+		}
+		return itemStatus(api.ApplicationItemProgressing, "PodNotReady", "")
+	}
+	return itemStatus(api.ApplicationItemProgressing, "Pod"+string(pod.Status.Phase), pod.Status.Message)
+}
+
+// buildConfigHealth is the health of the last build of bc.
+func (c *ApplicationController) buildConfigHealth(bc *buildapi.BuildConfig) (api.ApplicationItemStatus, error) {
+	if bc.Status.LastVersion == 0 {
+		return itemStatus(api.ApplicationItemProgressing, "NotBuilt", "not built yet"), nil
+	}
+	name := buildutil.BuildNameForConfigVersion(bc.Name, bc.Status.LastVersion)
+	build, err := c.Client.Builds(bc.Namespace).Get(name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return itemStatus(api.ApplicationItemProgressing, "Building", fmt.Sprintf("build %s is being created", name)), nil
+		}
+		return api.ApplicationItemStatus{}, err
+	}
+	status := buildHealth(build)
+	status.Message = fmt.Sprintf("build %s: %s", name, status.Message)
+	return status, nil
+}
+
+func buildHealth(build *buildapi.Build) api.ApplicationItemStatus {
+	message := strings.ToLower(string(build.Status.Phase))
+	if len(build.Status.Message) > 0 {
+		message += ", " + build.Status.Message
+	}
+	switch build.Status.Phase {
+	case buildapi.BuildPhaseComplete:
+		return itemStatus(api.ApplicationItemReady, "BuildComplete", message)
+	case buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+		return itemStatus(api.ApplicationItemFailed, "Build"+string(build.Status.Phase), message)
+	}
+	return itemStatus(api.ApplicationItemProgressing, "Building", message)
+}
+
+func backingServiceInstanceHealth(bsi *backingserviceinstanceapi.BackingServiceInstance) api.ApplicationItemStatus {
+	switch bsi.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+		return itemStatus(api.ApplicationItemReady, "Bound", "")
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound:
+		return itemStatus(api.ApplicationItemReady, "Unbound", "provisioned, not bound")
+	case backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning, backingserviceinstanceapi.BackingServiceInstancePhaseDeleted:
+		return itemStatus(api.ApplicationItemFailed, string(bsi.Status.Phase), "")
+	}
+	return itemStatus(api.ApplicationItemProgressing, "Provisioning", "")
+}
+
+func backingServiceBindingHealth(binding *backingserviceinstanceapi.BackingServiceBinding) api.ApplicationItemStatus {
+	switch binding.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceBindingPhaseBound:
+		return itemStatus(api.ApplicationItemReady, "Bound", "")
+	case backingserviceinstanceapi.BackingServiceBindingPhaseFailed, backingserviceinstanceapi.BackingServiceBindingPhaseUnbinding:
+		return itemStatus(api.ApplicationItemFailed, string(binding.Status.Phase), "")
+	}
+	return itemStatus(api.ApplicationItemProgressing, "Binding", "")
+}
+
+// routeHealth is ready once route has a host and the service it routes to exists,
+// routes have no admission status the router would report.
+func (c *ApplicationController) routeHealth(route *routeapi.Route) (api.ApplicationItemStatus, error) {
+	if len(route.Spec.Host) == 0 {
+		return itemStatus(api.ApplicationItemProgressing, "NoHost", "no host allocated yet"), nil
+	}
+	if _, err := c.KubeClient.Services(route.Namespace).Get(route.Spec.To.Name); err != nil {
+		if kerrors.IsNotFound(err) {
+			return itemStatus(api.ApplicationItemFailed, "ServiceNotFound", fmt.Sprintf("service %s not found", route.Spec.To.Name)), nil
+		}
+		return api.ApplicationItemStatus{}, err
+	}
+	return itemStatus(api.ApplicationItemReady, "Routed", route.Spec.Host), nil
+}
+
+func serviceBrokerHealth(sb *servicebrokerapi.ServiceBroker) api.ApplicationItemStatus {
+	for _, condition := range sb.Status.Conditions {
+		if condition.Type != servicebrokerapi.ServiceBrokerReady {
+			continue
+		}
+		switch condition.Status {
+		case kapi.ConditionTrue:
+			return itemStatus(api.ApplicationItemReady, condition.Reason, condition.Message)
+		case kapi.ConditionFalse:
+			return itemStatus(api.ApplicationItemFailed, condition.Reason, condition.Message)
+		}
+	}
+	return itemStatus(api.ApplicationItemProgressing, string(sb.Status.Phase), "not probed yet")
+}
+
+// setHealth records the health of the items of application and rolls it up into its
+// conditions, keeping the transition times of the conditions which did not change.
+func setHealth(status *api.ApplicationStatus, items []api.ApplicationItemStatus, now unversioned.Time) {
+	status.Items = items
+
+	var progressing, failed []string
+	for _, item := range items {
+		name := strings.ToLower(item.Kind) + "/" + item.Name
+		switch item.Health {
+		case api.ApplicationItemProgressing:
+			progressing = append(progressing, name)
+		case api.ApplicationItemFailed:
+			failed = append(failed, name)
+		}
+	}
+	sort.Strings(progressing)
+	sort.Strings(failed)
+
+	if len(progressing) == 0 && len(failed) == 0 {
+		setCondition(status, api.ApplicationAvailable, kapi.ConditionTrue, "AllItemsReady", "", now)
+	} else {
+		setCondition(status, api.ApplicationAvailable, kapi.ConditionFalse, "ItemsNotReady", strings.Join(append(failed, progressing...), ", "), now)
+	}
+	if len(progressing) > 0 {
+		setCondition(status, api.ApplicationProgressing, kapi.ConditionTrue, "ItemsProgressing", strings.Join(progressing, ", "), now)
+	} else {
+		setCondition(status, api.ApplicationProgressing, kapi.ConditionFalse, "", "", now)
+	}
+	if len(failed) > 0 {
+		setCondition(status, api.ApplicationDegraded, kapi.ConditionTrue, "ItemsFailed", strings.Join(failed, ", "), now)
+	} else {
+		setCondition(status, api.ApplicationDegraded, kapi.ConditionFalse, "", "", now)
+	}
+}
+
+func setCondition(status *api.ApplicationStatus, conditionType api.ApplicationConditionType, conditionStatus kapi.ConditionStatus, reason, message string, now unversioned.Time) {
+	condition := api.ApplicationCondition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+	for i := range status.Conditions {
+		if status.Conditions[i].Type != conditionType {
+			continue
+		}
+		if status.Conditions[i].Status == conditionStatus {
+			condition.LastTransitionTime = status.Conditions[i].LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return
+	}
+	status.Conditions = append(status.Conditions, condition)
+}
+
+// previousItemStatus returns the status recorded for item, it is kept when the health
+// of the item can't be evaluated.
+func previousItemStatus(items []api.ApplicationItemStatus, item api.Item) (api.ApplicationItemStatus, bool) {
+	for _, status := range items {
+		if status.Kind == item.Kind && status.Name == item.Name {
+			return status, true
+		}
+	}
+	return api.ApplicationItemStatus{}, false
+}
+
+func copyStatus(status api.ApplicationStatus) api.ApplicationStatus {
+	status.Items = append([]api.ApplicationItemStatus(nil), status.Items...)
+	status.Conditions = append([]api.ApplicationCondition(nil), status.Conditions...)
+	return status
+}
+
+func statusChanged(old, new api.ApplicationStatus) bool {
+	return !kapi.Semantic.DeepEqual(old, new)
+}
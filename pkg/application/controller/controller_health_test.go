@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+func conditionFor(status api.ApplicationStatus, conditionType api.ApplicationConditionType) api.ApplicationCondition {
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return condition
+		}
+	}
+	return api.ApplicationCondition{}
+}
+
+func TestSetHealth(t *testing.T) {
+	first := unversioned.NewTime(time.Unix(1000, 0))
+	second := unversioned.NewTime(time.Unix(2000, 0))
+
+	status := api.ApplicationStatus{}
+	setHealth(&status, []api.ApplicationItemStatus{
+		{Kind: "DeploymentConfig", Name: "web", Health: api.ApplicationItemProgressing},
+		{Kind: "Service", Name: "web", Health: api.ApplicationItemReady},
+	}, first)
+
+	if c := conditionFor(status, api.ApplicationAvailable); c.Status != kapi.ConditionFalse || c.Message != "deploymentconfig/web" {
+		t.Errorf("unexpected available condition %#v", c)
+	}
+	if c := conditionFor(status, api.ApplicationProgressing); c.Status != kapi.ConditionTrue {
+		t.Errorf("unexpected progressing condition %#v", c)
+	}
+	if c := conditionFor(status, api.ApplicationDegraded); c.Status != kapi.ConditionFalse {
+		t.Errorf("unexpected degraded condition %#v", c)
+	}
+
+	setHealth(&status, []api.ApplicationItemStatus{
+		{Kind: "DeploymentConfig", Name: "web", Health: api.ApplicationItemFailed},
+		{Kind: "Service", Name: "web", Health: api.ApplicationItemReady},
+	}, second)
+
+	if c := conditionFor(status, api.ApplicationAvailable); c.Status != kapi.ConditionFalse || !c.LastTransitionTime.Equal(first) {
+		t.Errorf("expected the available condition to keep its transition time, got %#v", c)
+	}
+	if c := conditionFor(status, api.ApplicationProgressing); c.Status != kapi.ConditionFalse || !c.LastTransitionTime.Equal(second) {
+		t.Errorf("expected the progressing condition to transition, got %#v", c)
+	}
+	if c := conditionFor(status, api.ApplicationDegraded); c.Status != kapi.ConditionTrue || c.Reason != "ItemsFailed" || !c.LastTransitionTime.Equal(second) {
+		t.Errorf("expected the degraded condition to transition, got %#v", c)
+	}
+	if len(status.Conditions) != 3 {
+		t.Errorf("expected 3 conditions, got %d", len(status.Conditions))
+	}
+
+	setHealth(&status, []api.ApplicationItemStatus{
+		{Kind: "DeploymentConfig", Name: "web", Health: api.ApplicationItemReady},
+	}, second)
+	if c := conditionFor(status, api.ApplicationAvailable); c.Status != kapi.ConditionTrue {
+		t.Errorf("unexpected available condition %#v", c)
+	}
+}
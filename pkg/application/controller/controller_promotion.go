@@ -0,0 +1,687 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	osautil "github.com/openshift/origin/pkg/serviceaccounts/util"
+)
+
+// ApplicationPromotionController copies the items of the Applications promoted into the
+// target projects of the promotions, and creates or updates the Applications there.
+// Use the ApplicationPromotionControllerFactory to create this controller.
+type ApplicationPromotionController struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// Items gets, creates and updates the resources of the items of any kind.
+	Items *applicationutil.ItemClient
+}
+
+// promotionKindOrder is the order the items are promoted in: the image streams are
+// tagged before the deployments using them, and the instances are provisioned before
+// they are bound. The kinds not listed come last.
+var promotionKindOrder = []string{
+	"ImageStream", "Secret", "ServiceAccount", "PersistentVolumeClaim", "Service",
+	"BackingServiceInstance", "Template", "BuildConfig", "DeploymentConfig", "ReplicationController",
+	"Route", "BackingServiceBinding",
+}
+
+// promotedObject is an item of the Application promoted, or a binding of one of its instances.
+type promotedObject struct {
+	Item   api.Item
+	Object runtime.Object
+	// Err is why the object could not be got.
+	Err error
+}
+
+type byPromotionOrder []promotedObject
+
+func (o byPromotionOrder) Len() int      { return len(o) }
+func (o byPromotionOrder) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o byPromotionOrder) Less(i, j int) bool {
+	return promotionRank(o[i].Item.Kind) < promotionRank(o[j].Item.Kind)
+}
+
+func promotionRank(kind string) int {
+	for i := range promotionKindOrder {
+		if promotionKindOrder[i] == kind {
+			return i
+		}
+	}
+	return len(promotionKindOrder)
+}
+
+// Handle promotes the items of the Application of promotion one after the other, and
+// records the progress of each. Promotions interrupted resume with the items pending.
+func (c *ApplicationPromotionController) Handle(promotion *api.ApplicationPromotion) error {
+	// the queue may hold a version older than the progress the last run recorded
+	promotion, err := c.Client.ApplicationPromotions(promotion.Namespace).Get(promotion.Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	switch promotion.Status.Phase {
+	case api.ApplicationPromotionComplete, api.ApplicationPromotionFailed:
+		return nil
+	}
+
+	app, err := c.Client.Applications(promotion.Namespace).Get(promotion.Spec.Application)
+	if kerrors.IsNotFound(err) {
+		return c.finish(promotion, fmt.Sprintf("application %s not found", promotion.Spec.Application))
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := c.Client.Projects().Get(promotion.Spec.TargetNamespace); err != nil {
+		if kerrors.IsNotFound(err) {
+			return c.finish(promotion, fmt.Sprintf("project %s not found", promotion.Spec.TargetNamespace))
+		}
+		return err
+	}
+
+	objects, err := c.promotedObjects(app)
+	if err != nil {
+		return err
+	}
+
+	if promotion.Status.Phase != api.ApplicationPromotionRunning {
+		now := unversioned.Now()
+		promotion.Status.Phase = api.ApplicationPromotionRunning
+		promotion.Status.StartTimestamp = &now
+		promotion.Status.Items = make([]api.ApplicationPromotionItemStatus, 0, len(objects))
+		for _, obj := range objects {
+			promotion.Status.Items = append(promotion.Status.Items, api.ApplicationPromotionItemStatus{
+				Kind:  obj.Item.Kind,
+				Name:  obj.Item.Name,
+				Phase: api.ApplicationPromotionItemPending,
+			})
+		}
+		if promotion, err = c.Client.ApplicationPromotions(promotion.Namespace).Update(promotion); err != nil {
+			return err
+		}
+	}
+
+	p := &promoter{
+		client:  c.Client,
+		items:   c.Items,
+		source:  promotion.Namespace,
+		target:  promotion.Spec.TargetNamespace,
+		plans:   promotion.Spec.Plans,
+		streams: sets.NewString(),
+	}
+	for _, obj := range objects {
+		if obj.Item.Kind == "ImageStream" {
+			p.streams.Insert(obj.Item.Name)
+		}
+	}
+
+	for _, obj := range objects {
+		status := promotionItemStatus(promotion, obj.Item)
+		if status == nil {
+			// an item added to the Application since the promotion started
+			promotion.Status.Items = append(promotion.Status.Items, api.ApplicationPromotionItemStatus{Kind: obj.Item.Kind, Name: obj.Item.Name})
+			status = &promotion.Status.Items[len(promotion.Status.Items)-1]
+		} else if status.Phase != api.ApplicationPromotionItemPending {
+			continue
+		}
+
+		status.Phase, status.Message = p.promote(obj)
+		if promotion, err = c.Client.ApplicationPromotions(promotion.Namespace).Update(promotion); err != nil {
+			return err
+		}
+	}
+
+	if err := c.promoteApplication(promotion, app); err != nil {
+		return c.finish(promotion, fmt.Sprintf("unable to promote application %s: %v", app.Name, err))
+	}
+	return c.finish(promotion, "")
+}
+
+// finish completes promotion, it fails with message if not empty, or if items failed.
+func (c *ApplicationPromotionController) finish(promotion *api.ApplicationPromotion, message string) error {
+	failed := []string{}
+	for _, item := range promotion.Status.Items {
+		if item.Phase == api.ApplicationPromotionItemFailed {
+			failed = append(failed, strings.ToLower(item.Kind)+"/"+item.Name)
+		}
+	}
+
+	now := unversioned.Now()
+	promotion.Status.CompletionTimestamp = &now
+	switch {
+	case len(message) > 0:
+		promotion.Status.Phase = api.ApplicationPromotionFailed
+		promotion.Status.Message = message
+	case len(failed) > 0:
+		promotion.Status.Phase = api.ApplicationPromotionFailed
+		promotion.Status.Message = fmt.Sprintf("unable to promote %s", strings.Join(failed, ", "))
+	default:
+		promotion.Status.Phase = api.ApplicationPromotionComplete
+		promotion.Status.Message = fmt.Sprintf("application %s promoted to %s", promotion.Spec.Application, promotion.Spec.TargetNamespace)
+	}
+
+	_, err := c.Client.ApplicationPromotions(promotion.Namespace).Update(promotion)
+	return err
+}
+
+// promotedObjects returns the items of app and the bindings of its instances to its
+// other items, in the order they are promoted.
+func (c *ApplicationPromotionController) promotedObjects(app *api.Application) ([]promotedObject, error) {
+	objects := []promotedObject{}
+	instances := []*backingserviceinstanceapi.BackingServiceInstance{}
+	for _, item := range app.Spec.Items {
+		item = api.Item{Kind: item.Kind, Name: item.Name}
+		obj, err := c.Items.Get(app.Namespace, item)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		objects = append(objects, promotedObject{Item: item, Object: obj, Err: err})
+		if bsi, ok := obj.(*backingserviceinstanceapi.BackingServiceInstance); ok && err == nil {
+			instances = append(instances, bsi)
+		}
+	}
+
+	items := api.ItemList{}
+	for _, obj := range objects {
+		items = append(items, obj.Item)
+	}
+	for _, bsi := range instances {
+		bindings, err := applicationutil.ApplicationBindings(c.Client, app.Namespace, bsi, app.Spec.Items)
+		if err != nil {
+			return nil, err
+		}
+		for i := range bindings {
+			item := api.Item{Kind: "BackingServiceBinding", Name: bindings[i].Name}
+			if hasItem(items, item) {
+				continue
+			}
+			items = append(items, item)
+			objects = append(objects, promotedObject{Item: item, Object: &bindings[i]})
+		}
+	}
+
+	sort.Stable(byPromotionOrder(objects))
+	return objects, nil
+}
+
+// promoteApplication creates the Application of app in the target project of promotion
+// with the items promoted, or adds them to the Application there.
+func (c *ApplicationPromotionController) promoteApplication(promotion *api.ApplicationPromotion, app *api.Application) error {
+	target := promotion.Spec.TargetNamespace
+	items := api.ItemList{}
+	for _, item := range app.Spec.Items {
+		status := promotionItemStatus(promotion, item)
+		if status == nil || status.Phase == api.ApplicationPromotionItemFailed || status.Phase == api.ApplicationPromotionItemPending {
+			continue
+		}
+		// skipped items are held by the Application if they exist there
+		if _, err := c.Items.Get(target, item); err != nil {
+			continue
+		}
+		items = append(items, api.Item{Kind: item.Kind, Name: item.Name})
+	}
+
+	promoted, err := c.Client.Applications(target).Get(app.Name)
+	if kerrors.IsNotFound(err) {
+		promoted = &api.Application{}
+		promoted.Name = app.Name
+		promoted.Labels = app.Labels
+		promoted.Annotations = map[string]string{}
+		for k, v := range app.Annotations {
+			// the manifest applied describes the items of the source project
+			if k != api.LastAppliedManifestAnnotation {
+				promoted.Annotations[k] = v
+			}
+		}
+		promoted.Spec.Name = app.Spec.Name
+		promoted.Spec.Items = items
+		_, err = c.Client.Applications(target).Create(promoted)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if !hasItem(promoted.Spec.Items, item) {
+			promoted.Spec.Items = append(promoted.Spec.Items, item)
+		}
+	}
+	_, err = c.Client.Applications(target).Update(promoted)
+	return err
+}
+
+func promotionItemStatus(promotion *api.ApplicationPromotion, item api.Item) *api.ApplicationPromotionItemStatus {
+	for i := range promotion.Status.Items {
+		if promotion.Status.Items[i].Kind == item.Kind && promotion.Status.Items[i].Name == item.Name {
+			return &promotion.Status.Items[i]
+		}
+	}
+	return nil
+}
+
+// promoter copies objects of a source project into a target project.
+type promoter struct {
+	client osclient.Interface
+	items  *applicationutil.ItemClient
+
+	source string
+	target string
+	// plans maps the plans of the instances, by id or name, to the plans in the target.
+	plans map[string]string
+	// streams are the names of the image streams promoted.
+	streams sets.String
+}
+
+// promote creates or updates obj in the target project, and returns the phase of its
+// promotion and a message about it.
+func (p *promoter) promote(obj promotedObject) (api.ApplicationPromotionItemPhase, string) {
+	if obj.Err != nil {
+		return api.ApplicationPromotionItemFailed, obj.Err.Error()
+	}
+	namespaced, err := p.items.Namespaced(obj.Item.Kind)
+	if err != nil {
+		return api.ApplicationPromotionItemFailed, err.Error()
+	}
+	if !namespaced {
+		return api.ApplicationPromotionItemSkipped, "not namespaced, the projects share it"
+	}
+	if reason := promotionSkipReason(obj.Object); len(reason) > 0 {
+		return api.ApplicationPromotionItemSkipped, reason
+	}
+	if err := p.prepare(obj.Object); err != nil {
+		return api.ApplicationPromotionItemFailed, err.Error()
+	}
+
+	existing, err := p.items.Get(p.target, obj.Item)
+	if kerrors.IsNotFound(err) {
+		if _, err := p.items.Create(p.target, obj.Item, obj.Object); err != nil {
+			if kerrors.IsAlreadyExists(err) && obj.Item.Kind == "BackingServiceBinding" {
+				return api.ApplicationPromotionItemSkipped, "the resource is already bound to the instance"
+			}
+			return api.ApplicationPromotionItemFailed, err.Error()
+		}
+		return api.ApplicationPromotionItemCreated, ""
+	}
+	if err != nil {
+		return api.ApplicationPromotionItemFailed, err.Error()
+	}
+
+	updated, message := p.merge(obj.Object, existing)
+	if updated == nil {
+		return api.ApplicationPromotionItemSkipped, message
+	}
+	if err := p.items.Update(p.target, obj.Item, updated); err != nil {
+		return api.ApplicationPromotionItemFailed, err.Error()
+	}
+	return api.ApplicationPromotionItemUpdated, message
+}
+
+// promotionSkipReason returns why obj is not promoted, if it is created by another item
+// or generated by the project.
+func promotionSkipReason(obj runtime.Object) string {
+	switch t := obj.(type) {
+	case *buildapi.Build:
+		return "builds are started by their build configs"
+	case *kapi.Pod:
+		return "pods are created by their controllers"
+	case *kapi.ReplicationController:
+		if dc := t.Annotations[deployapi.DeploymentConfigAnnotation]; len(dc) > 0 {
+			return fmt.Sprintf("deployed by deployment config %s", dc)
+		}
+	case *kapi.Secret:
+		if t.Type == kapi.SecretTypeServiceAccountToken || len(t.Annotations[kapi.ServiceAccountUIDKey]) > 0 {
+			return "generated for a service account"
+		}
+	}
+	return ""
+}
+
+// prepare turns obj, got from the source project, into its copy in the target project.
+func (p *promoter) prepare(obj runtime.Object) error {
+	if meta, err := kapi.ObjectMetaFor(obj); err == nil {
+		meta.Namespace = p.target
+		meta.UID = ""
+		meta.ResourceVersion = ""
+		meta.SelfLink = ""
+		meta.CreationTimestamp = unversioned.Time{}
+		meta.DeletionTimestamp = nil
+		// the labels of the applications of the target are set by their controller
+		for key := range meta.Labels {
+			if strings.Contains(key, ".application.") {
+				delete(meta.Labels, key)
+			}
+		}
+	}
+
+	switch t := obj.(type) {
+	case *imageapi.ImageStream:
+		p.promoteImageStream(t)
+	case *kapi.Service:
+		t.Status = kapi.ServiceStatus{}
+		if t.Spec.ClusterIP != kapi.ClusterIPNone {
+			t.Spec.ClusterIP = ""
+		}
+		for i := range t.Spec.Ports {
+			t.Spec.Ports[i].NodePort = 0
+		}
+	case *kapi.ServiceAccount:
+		dockercfgSecretPrefix := osautil.GetDockercfgSecretNamePrefix(t)
+		tokenSecretPrefix := osautil.GetTokenSecretNamePrefix(t)
+		imagePullSecrets := []kapi.LocalObjectReference{}
+		for _, secretRef := range t.ImagePullSecrets {
+			if !strings.HasPrefix(secretRef.Name, dockercfgSecretPrefix) {
+				imagePullSecrets = append(imagePullSecrets, secretRef)
+			}
+		}
+		t.ImagePullSecrets = imagePullSecrets
+		secrets := []kapi.ObjectReference{}
+		for _, secretRef := range t.Secrets {
+			if !strings.HasPrefix(secretRef.Name, dockercfgSecretPrefix) && !strings.HasPrefix(secretRef.Name, tokenSecretPrefix) {
+				secrets = append(secrets, secretRef)
+			}
+		}
+		t.Secrets = secrets
+	case *kapi.PersistentVolumeClaim:
+		t.Spec.VolumeName = ""
+		t.Status = kapi.PersistentVolumeClaimStatus{}
+	case *kapi.ReplicationController:
+		t.Status = kapi.ReplicationControllerStatus{}
+	case *backingserviceinstanceapi.BackingServiceInstance:
+		// the instance is provisioned again, and bound again by the bindings
+		t.Status = backingserviceinstanceapi.BackingServiceInstanceStatus{}
+		t.Spec.DashboardUrl = ""
+		t.Spec.Parameters = backingserviceinstanceapi.ParametersOf(t)
+		t.Spec.Binding = nil
+		t.Spec.Bound = 0
+		t.Spec.InstanceID = ""
+		return p.mapPlan(t)
+	case *backingserviceinstanceapi.BackingServiceBinding:
+		t.Status = backingserviceinstanceapi.BackingServiceBindingStatus{}
+		delete(t.Labels, backingserviceinstanceapi.BackingServiceInstanceLabel)
+	case *deployapi.DeploymentConfig:
+		p.promoteDeploymentConfig(t)
+	case *buildapi.BuildConfig:
+		t.Status.LastVersion = 0
+		for i := range t.Spec.Triggers {
+			if params := t.Spec.Triggers[i].ImageChange; params != nil {
+				params.LastTriggeredImageID = ""
+				p.promotedReference(params.From)
+			}
+		}
+		p.promotedReference(t.Spec.Output.To)
+		if strategy := t.Spec.Strategy.DockerStrategy; strategy != nil {
+			p.promotedReference(strategy.From)
+		}
+		if strategy := t.Spec.Strategy.SourceStrategy; strategy != nil {
+			p.promotedReference(&strategy.From)
+		}
+		if strategy := t.Spec.Strategy.CustomStrategy; strategy != nil {
+			p.promotedReference(&strategy.From)
+		}
+	case *routeapi.Route:
+		// hosts are unique, the target generates its own
+		t.Spec.Host = ""
+		t.Status = routeapi.RouteStatus{}
+		// the route service is set by the bindings of the target.
+		delete(t.Annotations, routeapi.RouteServiceURLAnnotation)
+	}
+	return nil
+}
+
+// promoteImageStream tags the promoted image stream with the images the tags of the
+// source stream are at, the target deploys what the source tested.
+func (p *promoter) promoteImageStream(stream *imageapi.ImageStream) {
+	spec := imageapi.ImageStreamSpec{
+		DockerImageRepository: stream.Spec.DockerImageRepository,
+		Tags:                  map[string]imageapi.TagReference{},
+	}
+	for tag, ref := range stream.Spec.Tags {
+		if ref.From != nil {
+			from := *ref.From
+			p.promotedReference(&from)
+			ref.From = &from
+		}
+		spec.Tags[tag] = ref
+	}
+	for tag, history := range stream.Status.Tags {
+		if len(history.Items) == 0 || len(history.Items[0].Image) == 0 {
+			continue
+		}
+		ref := stream.Spec.Tags[tag]
+		ref.From = &kapi.ObjectReference{
+			Kind:      "ImageStreamImage",
+			Namespace: p.source,
+			Name:      stream.Name + "@" + history.Items[0].Image,
+		}
+		spec.Tags[tag] = ref
+	}
+	stream.Spec = spec
+	stream.Status = imageapi.ImageStreamStatus{}
+}
+
+// promoteDeploymentConfig points the image change triggers of dc to the promoted image
+// streams, and its containers to the images they are tagged with.
+func (p *promoter) promoteDeploymentConfig(dc *deployapi.DeploymentConfig) {
+	dc.Status.LatestVersion = 0
+	dc.Status.Details = nil
+	for i := range dc.Spec.Triggers {
+		params := dc.Spec.Triggers[i].ImageChangeParams
+		if params == nil {
+			continue
+		}
+		params.LastTriggeredImage = ""
+		p.promotedReference(&params.From)
+
+		image := p.promotedImage(params.From)
+		if len(image) == 0 || dc.Spec.Template == nil {
+			continue
+		}
+		names := sets.NewString(params.ContainerNames...)
+		for j := range dc.Spec.Template.Spec.Containers {
+			if names.Has(dc.Spec.Template.Spec.Containers[j].Name) {
+				dc.Spec.Template.Spec.Containers[j].Image = image
+			}
+		}
+	}
+}
+
+// promotedReference points ref, a reference of an object of the source project to an
+// image, to the image streams promoted with it. The other image streams of the source
+// project are still referred to there.
+func (p *promoter) promotedReference(ref *kapi.ObjectReference) {
+	if ref == nil {
+		return
+	}
+	switch ref.Kind {
+	case "ImageStream", "ImageStreamTag", "ImageStreamImage":
+	default:
+		return
+	}
+	if len(ref.Namespace) > 0 && ref.Namespace != p.source {
+		return
+	}
+	name := ref.Name
+	if i := strings.IndexAny(name, ":@"); i >= 0 {
+		name = name[:i]
+	}
+	if p.streams.Has(name) {
+		ref.Namespace = ""
+	} else {
+		ref.Namespace = p.source
+	}
+}
+
+// promotedImage returns the image the ImageStreamTag from of the target project is at.
+func (p *promoter) promotedImage(from kapi.ObjectReference) string {
+	if from.Kind != "ImageStreamTag" || len(from.Namespace) > 0 {
+		return ""
+	}
+	name, tag, ok := imageapi.SplitImageStreamTag(from.Name)
+	if !ok {
+		return ""
+	}
+	stream, err := p.client.ImageStreams(p.target).Get(name)
+	if err != nil {
+		return ""
+	}
+	if event := imageapi.LatestTaggedImage(stream, tag); event != nil {
+		return event.DockerImageReference
+	}
+	return ""
+}
+
+// mapPlan sets the plan bsi is provisioned with in the target, if its plan is mapped.
+func (p *promoter) mapPlan(bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	to, ok := p.plans[bsi.Spec.BackingServicePlanGuid]
+	if !ok && len(bsi.Spec.BackingServicePlanName) > 0 {
+		to, ok = p.plans[bsi.Spec.BackingServicePlanName]
+	}
+	if !ok {
+		return nil
+	}
+
+	bs, err := p.client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		return err
+	}
+	for _, plan := range bs.Spec.Plans {
+		if plan.Id == to || plan.Name == to {
+			bsi.Spec.BackingServicePlanGuid = plan.Id
+			bsi.Spec.BackingServicePlanName = plan.Name
+			return nil
+		}
+	}
+	return fmt.Errorf("backing service %s has no plan %s", bs.Name, to)
+}
+
+// merge returns the update of existing, the object in the target project, to obj, with
+// the fields the target project set kept. It returns nil if existing is left alone.
+func (p *promoter) merge(obj, existing runtime.Object) (runtime.Object, string) {
+	if meta, err := kapi.ObjectMetaFor(obj); err == nil {
+		if existingMeta, err := kapi.ObjectMetaFor(existing); err == nil {
+			meta.ResourceVersion = existingMeta.ResourceVersion
+			for key, value := range existingMeta.Labels {
+				if strings.Contains(key, ".application.") {
+					if meta.Labels == nil {
+						meta.Labels = map[string]string{}
+					}
+					meta.Labels[key] = value
+				}
+			}
+		}
+	}
+
+	switch t := obj.(type) {
+	case *imageapi.ImageStream:
+		old := existing.(*imageapi.ImageStream)
+		tags := map[string]imageapi.TagReference{}
+		for tag, ref := range old.Spec.Tags {
+			tags[tag] = ref
+		}
+		for tag, ref := range t.Spec.Tags {
+			tags[tag] = ref
+		}
+		t.Spec.Tags = tags
+		t.Status = old.Status
+	case *kapi.Service:
+		old := existing.(*kapi.Service)
+		t.Spec.ClusterIP = old.Spec.ClusterIP
+		for i := range t.Spec.Ports {
+			for _, port := range old.Spec.Ports {
+				if port.Name == t.Spec.Ports[i].Name {
+					t.Spec.Ports[i].NodePort = port.NodePort
+				}
+			}
+		}
+	case *kapi.ServiceAccount:
+		old := existing.(*kapi.ServiceAccount)
+		for _, secretRef := range old.Secrets {
+			if !hasObjectReference(t.Secrets, secretRef.Name) {
+				t.Secrets = append(t.Secrets, secretRef)
+			}
+		}
+		for _, secretRef := range old.ImagePullSecrets {
+			found := false
+			for _, ref := range t.ImagePullSecrets {
+				found = found || ref.Name == secretRef.Name
+			}
+			if !found {
+				t.ImagePullSecrets = append(t.ImagePullSecrets, secretRef)
+			}
+		}
+	case *kapi.PersistentVolumeClaim:
+		old := existing.(*kapi.PersistentVolumeClaim)
+		t.Spec.VolumeName = old.Spec.VolumeName
+		t.Status = old.Status
+	case *kapi.ReplicationController:
+		t.Status = existing.(*kapi.ReplicationController).Status
+	case *deployapi.DeploymentConfig:
+		old := existing.(*deployapi.DeploymentConfig)
+		t.Status = old.Status
+		// the images deployed already are not deployed again
+		for i := range t.Spec.Triggers {
+			params := t.Spec.Triggers[i].ImageChangeParams
+			if params == nil {
+				continue
+			}
+			for _, trigger := range old.Spec.Triggers {
+				if trigger.ImageChangeParams != nil && trigger.ImageChangeParams.From == params.From {
+					params.LastTriggeredImage = trigger.ImageChangeParams.LastTriggeredImage
+				}
+			}
+		}
+	case *buildapi.BuildConfig:
+		t.Status = existing.(*buildapi.BuildConfig).Status
+	case *routeapi.Route:
+		old := existing.(*routeapi.Route)
+		if len(t.Spec.Host) == 0 {
+			t.Spec.Host = old.Spec.Host
+		}
+		t.Status = old.Status
+		if url, ok := old.Annotations[routeapi.RouteServiceURLAnnotation]; ok {
+			if t.Annotations == nil {
+				t.Annotations = map[string]string{}
+			}
+			t.Annotations[routeapi.RouteServiceURLAnnotation] = url
+		}
+	case *backingserviceinstanceapi.BackingServiceInstance:
+		// the instance provisioned in the target is kept, only its plan follows the mapping
+		old := existing.(*backingserviceinstanceapi.BackingServiceInstance)
+		if old.Spec.BackingServicePlanGuid == t.Spec.BackingServicePlanGuid {
+			return nil, "already provisioned with the same plan"
+		}
+		old.Spec.BackingServicePlanGuid = t.Spec.BackingServicePlanGuid
+		old.Spec.BackingServicePlanName = t.Spec.BackingServicePlanName
+		return old, fmt.Sprintf("plan changed to %s", t.Spec.BackingServicePlanGuid)
+	case *backingserviceinstanceapi.BackingServiceBinding:
+		return nil, "already bound"
+	}
+	return obj, ""
+}
+
+func hasObjectReference(refs []kapi.ObjectReference, name string) bool {
+	for _, ref := range refs {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"sort"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestPromotionOrder(t *testing.T) {
+	objects := []promotedObject{
+		{Item: api.Item{Kind: "BackingServiceBinding", Name: "db-web"}},
+		{Item: api.Item{Kind: "Pod", Name: "web-1-abcde"}},
+		{Item: api.Item{Kind: "DeploymentConfig", Name: "web"}},
+		{Item: api.Item{Kind: "BackingServiceInstance", Name: "db"}},
+		{Item: api.Item{Kind: "ImageStream", Name: "web"}},
+	}
+	sort.Stable(byPromotionOrder(objects))
+
+	expected := []string{"ImageStream", "BackingServiceInstance", "DeploymentConfig", "BackingServiceBinding", "Pod"}
+	for i, kind := range expected {
+		if objects[i].Item.Kind != kind {
+			t.Errorf("%d: expected %s, got %s", i, kind, objects[i].Item.Kind)
+		}
+	}
+}
+
+func TestPromotedReference(t *testing.T) {
+	p := &promoter{source: "dev", target: "test", streams: sets.NewString("web")}
+
+	tests := []struct {
+		ref       kapi.ObjectReference
+		namespace string
+	}{
+		{ref: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"}, namespace: ""},
+		{ref: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "dev", Name: "web:latest"}, namespace: ""},
+		{ref: kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "dev", Name: "web@sha256:abc"}, namespace: ""},
+		{ref: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "builder:latest"}, namespace: "dev"},
+		{ref: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.2"}, namespace: "openshift"},
+		{ref: kapi.ObjectReference{Kind: "DockerImage", Name: "centos:7"}, namespace: ""},
+	}
+	for _, test := range tests {
+		ref := test.ref
+		p.promotedReference(&ref)
+		if ref.Namespace != test.namespace {
+			t.Errorf("%s %s/%s: expected namespace %q, got %q", test.ref.Kind, test.ref.Namespace, test.ref.Name, test.namespace, ref.Namespace)
+		}
+	}
+}
+
+func TestPromoteImageStream(t *testing.T) {
+	p := &promoter{source: "dev", target: "test", streams: sets.NewString("web")}
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "dev", ResourceVersion: "10", Labels: map[string]string{"dev.application.shop": "shop", "app": "shop"}},
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{
+				"latest": {Annotations: map[string]string{"tags": "web"}},
+				"stable": {From: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"}},
+			},
+		},
+		Status: imageapi.ImageStreamStatus{
+			DockerImageRepository: "172.30.1.1:5000/dev/web",
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{Image: "sha256:new", DockerImageReference: "172.30.1.1:5000/dev/web@sha256:new"}, {Image: "sha256:old"}}},
+			},
+		},
+	}
+	if err := p.prepare(stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stream.Namespace != "test" || len(stream.ResourceVersion) > 0 {
+		t.Errorf("unexpected metadata %#v", stream.ObjectMeta)
+	}
+	if _, ok := stream.Labels["dev.application.shop"]; ok || stream.Labels["app"] != "shop" {
+		t.Errorf("expected the application label to be removed, got %v", stream.Labels)
+	}
+	latest := stream.Spec.Tags["latest"]
+	if latest.From == nil || *latest.From != (kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "dev", Name: "web@sha256:new"}) || latest.Annotations["tags"] != "web" {
+		t.Errorf("unexpected latest tag %#v", latest)
+	}
+	if stable := stream.Spec.Tags["stable"]; stable.From == nil || len(stable.From.Namespace) > 0 {
+		t.Errorf("expected the stable tag to track the promoted stream, got %#v", stable.From)
+	}
+	if len(stream.Status.Tags) > 0 || len(stream.Status.DockerImageRepository) > 0 {
+		t.Errorf("expected the status to be cleared, got %#v", stream.Status)
+	}
+}
+
+func TestPromoteDeploymentConfig(t *testing.T) {
+	p := &promoter{source: "dev", target: "test", streams: sets.NewString()}
+	dc := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "dev"},
+		Spec: deployapi.DeploymentConfigSpec{
+			Triggers: []deployapi.DeploymentTriggerPolicy{{
+				Type: deployapi.DeploymentTriggerOnImageChange,
+				ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+					From:               kapi.ObjectReference{Kind: "ImageStreamTag", Name: "base:latest"},
+					ContainerNames:     []string{"web"},
+					LastTriggeredImage: "172.30.1.1:5000/dev/base@sha256:abc",
+				},
+			}},
+		},
+		Status: deployapi.DeploymentConfigStatus{LatestVersion: 4},
+	}
+	if err := p.prepare(dc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	params := dc.Spec.Triggers[0].ImageChangeParams
+	if params.From.Namespace != "dev" || len(params.LastTriggeredImage) > 0 {
+		t.Errorf("expected the trigger to keep watching the stream of the source project, got %#v", params)
+	}
+	if dc.Status.LatestVersion != 0 {
+		t.Errorf("expected the latest version to be reset, got %d", dc.Status.LatestVersion)
+	}
+}
+
+func TestPromotionSkipReason(t *testing.T) {
+	deployed := &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{Name: "web-1", Annotations: map[string]string{deployapi.DeploymentConfigAnnotation: "web"}}}
+	if reason := promotionSkipReason(deployed); len(reason) == 0 {
+		t.Errorf("expected the replication controllers of deployment configs to be skipped")
+	}
+	token := &kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "default-token-abcde"}, Type: kapi.SecretTypeServiceAccountToken}
+	if reason := promotionSkipReason(token); len(reason) == 0 {
+		t.Errorf("expected service account tokens to be skipped")
+	}
+	if reason := promotionSkipReason(&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "db-password"}}); len(reason) > 0 {
+		t.Errorf("unexpected skip of a secret: %s", reason)
+	}
+}
+
+func TestPromotionMerge(t *testing.T) {
+	p := &promoter{source: "dev", target: "test"}
+
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "test"},
+		Spec:       kapi.ServiceSpec{Ports: []kapi.ServicePort{{Name: "http", Port: 8080}}},
+	}
+	existing := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "test", ResourceVersion: "7", Labels: map[string]string{"test.application.shop": "shop"}},
+		Spec:       kapi.ServiceSpec{ClusterIP: "172.30.0.10", Ports: []kapi.ServicePort{{Name: "http", Port: 80, NodePort: 30080}}},
+	}
+	obj, _ := p.merge(service, existing)
+	updated := obj.(*kapi.Service)
+	if updated.ResourceVersion != "7" || updated.Spec.ClusterIP != "172.30.0.10" || updated.Spec.Ports[0].Port != 8080 || updated.Spec.Ports[0].NodePort != 30080 {
+		t.Errorf("unexpected update %#v", updated)
+	}
+	if updated.Labels["test.application.shop"] != "shop" {
+		t.Errorf("expected the application label of the target to be kept, got %v", updated.Labels)
+	}
+
+	instance := &backingserviceinstanceapi.BackingServiceInstance{ObjectMeta: kapi.ObjectMeta{Name: "db"}}
+	instance.Spec.BackingServicePlanGuid = "large"
+	provisioned := &backingserviceinstanceapi.BackingServiceInstance{ObjectMeta: kapi.ObjectMeta{Name: "db"}}
+	provisioned.Spec.BackingServicePlanGuid = "large"
+	provisioned.Spec.InstanceID = "id"
+	if obj, message := p.merge(instance, provisioned); obj != nil {
+		t.Errorf("expected an instance with the same plan to be left alone, got %#v (%s)", obj, message)
+	}
+	instance.Spec.BackingServicePlanGuid = "xlarge"
+	obj, _ = p.merge(instance, provisioned)
+	if updated, ok := obj.(*backingserviceinstanceapi.BackingServiceInstance); !ok || updated.Spec.BackingServicePlanGuid != "xlarge" || updated.Spec.InstanceID != "id" {
+		t.Errorf("expected the plan of the provisioned instance to change, got %#v", obj)
+	}
+}
@@ -13,18 +13,6 @@ func getLabelSelectorByApplication(application *api.Application) (labels.Selecto
 	return labels.Parse(fmt.Sprintf("%s.application.%s=%s", application.Namespace, application.Name, application.Name))
 }
 
-func labelExistsOtherApplicationKey(label map[string]string, labelString string) bool {
-	m := filterMapByKey(label, ".application.", strings.Contains)
-
-	if len(m) > 1 {
-		if _, exist := m[labelString]; exist {
-			return exist
-		}
-	}
-
-	return false
-}
-
 func labelExistsApplicationKey(label map[string]string, keyString string) bool {
 
 	m := filterMapByKey(label, ".application.", strings.Contains)
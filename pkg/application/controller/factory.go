@@ -12,6 +12,7 @@ import (
 	"time"
 
 	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
 )
@@ -21,6 +22,8 @@ type ApplicationControllerFactory struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
+	// Items gets, updates and deletes the resources of the items of Applications.
+	Items *applicationutil.ItemClient
 }
 
 // Create creates a ApplicationControllerFactory.
@@ -41,6 +44,7 @@ func (factory *ApplicationControllerFactory) Create() controller.RunnableControl
 	applicationController := &ApplicationController{
 		Client:     factory.Client,
 		KubeClient: factory.KubeClient,
+		Items:      factory.Items,
 	}
 
 	return &controller.RetryController{
@@ -66,3 +70,47 @@ func (factory *ApplicationControllerFactory) Create() controller.RunnableControl
 		},
 	}
 }
+
+type ApplicationPromotionControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// Items gets, creates and updates the resources of the items promoted.
+	Items *applicationutil.ItemClient
+}
+
+// Create creates an ApplicationPromotionController.
+func (factory *ApplicationPromotionControllerFactory) Create() controller.RunnableController {
+	promotionLW := &cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return factory.Client.ApplicationPromotions(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return factory.Client.ApplicationPromotions(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(promotionLW, &applicationapi.ApplicationPromotion{}, queue, 2*time.Minute).Run()
+
+	promotionController := &ApplicationPromotionController{
+		Client: factory.Client,
+		Items:  factory.Items,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				// the items promoted are kept, a retry resumes with the others
+				return retries.Count < 3
+			},
+			kutil.NewTokenBucketRateLimiter(10, 1),
+		),
+		Handle: func(obj interface{}) error {
+			promotion := obj.(*applicationapi.ApplicationPromotion)
+			return promotionController.Handle(promotion)
+		},
+	}
+}
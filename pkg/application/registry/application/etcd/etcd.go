@@ -99,6 +99,11 @@ func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, boo
 
 		oldObj, _ := r.store.Get(ctx, newApp.Name)
 		if oldApp, ok := oldObj.(*api.Application); ok {
+			// health reports of the controller leave the items alone, they don't need relabeling
+			if newApp.Status.Phase == oldApp.Status.Phase && kapi.Semantic.DeepEqual(oldApp.Spec, newApp.Spec) {
+				return r.store.Update(ctx, obj)
+			}
+
 			switch oldApp.Status.Phase {
 			case api.ApplicationActiveUpdate:
 				newApp.Status.Phase = api.ApplicationActive
@@ -0,0 +1,91 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/application/api"
+	"github.com/openshift/origin/pkg/application/registry/applicationpromotion"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+)
+
+const ApplicationPromotionPath = "/applicationpromotions"
+
+type REST struct {
+	store *etcdgeneric.Etcd
+}
+
+// NewREST returns a new REST, applications gets the Applications promoted and
+// subjectAccessReviewClient checks the users promoting them may read and write them.
+func NewREST(s storage.Interface, applications rest.Getter, subjectAccessReviewClient subjectaccessreview.Registry) *REST {
+	strategy := applicationpromotion.NewStrategy(applications, subjectAccessReviewClient)
+	store := &etcdgeneric.Etcd{
+		NewFunc: func() runtime.Object {
+			return &api.ApplicationPromotion{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &api.ApplicationPromotionList{}
+		},
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, ApplicationPromotionPath)
+		},
+		KeyFunc: func(ctx kapi.Context, id string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, ApplicationPromotionPath, id)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ApplicationPromotion).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return applicationpromotion.Matcher(label, field)
+		},
+		EndpointName: "applicationpromotion",
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+
+		ReturnDeletedObject: false,
+
+		Storage: s,
+	}
+
+	return &REST{store: store}
+}
+
+func (r *REST) New() runtime.Object {
+	return r.store.NewFunc()
+}
+
+func (r *REST) NewList() runtime.Object {
+	return r.store.NewListFunc()
+}
+
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return r.store.Get(ctx, name)
+}
+
+func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return r.store.List(ctx, label, field)
+}
+
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	return r.store.Create(ctx, obj)
+}
+
+func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
+
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	return r.store.Delete(ctx, name, options)
+}
+
+func (r *REST) Watch(ctx kapi.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return r.store.Watch(ctx, label, field, resourceVersion)
+}
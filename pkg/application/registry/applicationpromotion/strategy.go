@@ -0,0 +1,160 @@
+package applicationpromotion
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	api "github.com/openshift/origin/pkg/application/api"
+	"github.com/openshift/origin/pkg/application/api/validation"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+)
+
+// Strategy implements behavior for ApplicationPromotions
+type Strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+	applications              rest.Getter
+	subjectAccessReviewClient subjectaccessreview.Registry
+}
+
+// NewStrategy is the default logic that applies when creating and updating
+// ApplicationPromotion objects via the REST API. applications gets the Applications
+// promoted, and subjectAccessReviewClient checks the users promoting them may read
+// them in the source project and write them in the target project.
+func NewStrategy(applications rest.Getter, subjectAccessReviewClient subjectaccessreview.Registry) Strategy {
+	return Strategy{
+		ObjectTyper:               kapi.Scheme,
+		NameGenerator:             kapi.SimpleNameGenerator,
+		applications:              applications,
+		subjectAccessReviewClient: subjectAccessReviewClient,
+	}
+}
+
+// NamespaceScoped is true, promotions live with the Application they promote.
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status of a new promotion, it is left to the controller.
+func (Strategy) PrepareForCreate(obj runtime.Object) {
+	promotion := obj.(*api.ApplicationPromotion)
+	promotion.Status = api.ApplicationPromotionStatus{Phase: api.ApplicationPromotionNew}
+}
+
+func (Strategy) PrepareForUpdate(obj, old runtime.Object) {}
+
+// Validate validates a new promotion, and that its user may do what the controller does
+// with its own privileges: read the Application, its items and the Secrets of the source
+// project, and create or update the Application and its items in the target project.
+func (s Strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	promotion := obj.(*api.ApplicationPromotion)
+	errs := validation.ValidateApplicationPromotion(promotion)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	user, ok := kapi.UserFrom(ctx)
+	if !ok {
+		return fielderrors.ValidationErrorList{kerrors.NewForbidden("applicationPromotion", promotion.Name, fmt.Errorf("unable to promote an Application without a user on the context"))}
+	}
+	app, err := s.applications.Get(ctx, promotion.Spec.Application)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fielderrors.ValidationErrorList{fielderrors.NewFieldNotFound("spec.application", promotion.Spec.Application)}
+		}
+		return fielderrors.ValidationErrorList{err}
+	}
+	resources, err := promotedResources(app.(*api.Application))
+	if err != nil {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldInvalid("spec.application", promotion.Spec.Application, err.Error())}
+	}
+
+	source := kapi.NamespaceValue(ctx)
+	for _, resource := range append(resources, "secrets") {
+		if !s.allowed(user, "get", resource, source) {
+			errs = append(errs, fielderrors.NewFieldForbidden("spec.application", fmt.Sprintf("get %s in %s", resource, source)))
+		}
+	}
+	for _, resource := range resources {
+		for _, verb := range []string{"create", "update"} {
+			if !s.allowed(user, verb, resource, promotion.Spec.TargetNamespace) {
+				errs = append(errs, fielderrors.NewFieldForbidden("spec.targetNamespace", fmt.Sprintf("%s %s in %s", verb, resource, promotion.Spec.TargetNamespace)))
+			}
+		}
+	}
+	return errs
+}
+
+// promotedResources returns the resources a promotion of app reads and writes: the
+// applications and the namespaced resources of its items, cluster scoped items are
+// shared by the projects and left alone by the controller.
+func promotedResources(app *api.Application) ([]string, error) {
+	resources := sets.NewString("applications")
+	for _, item := range app.Spec.Items {
+		mapping, err := latest.RESTMapper.RESTMapping(item.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			resources.Insert(mapping.Resource)
+		}
+	}
+	return resources.List(), nil
+}
+
+// allowed returns true if user may verb resource in namespace.
+func (s Strategy) allowed(info user.Info, verb, resource, namespace string) bool {
+	subjectAccessReview := authorizationapi.SubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:     verb,
+			Resource: resource,
+		},
+		User:   info.GetName(),
+		Groups: sets.NewString(info.GetGroups()...),
+	}
+	ctx := kapi.WithNamespace(kapi.NewContext(), namespace)
+	glog.V(4).Infof("Performing SubjectAccessReview for user=%s, groups=%v to %s %s in %s", info.GetName(), info.GetGroups(), verb, resource, namespace)
+	resp, err := s.subjectAccessReviewClient.CreateSubjectAccessReview(ctx, &subjectAccessReview)
+	return err == nil && resp != nil && resp.Allowed
+}
+
+// AllowCreateOnUpdate is false for promotions
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for a promotion
+func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateApplicationPromotionUpdate(obj.(*api.ApplicationPromotion), old.(*api.ApplicationPromotion))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: getAttrs}
+}
+
+func getAttrs(obj runtime.Object) (objLabels labels.Set, objFields fields.Set, err error) {
+	promotion, ok := obj.(*api.ApplicationPromotion)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an ApplicationPromotion")
+	}
+	return labels.Set(promotion.Labels), api.ApplicationPromotionToSelectableFields(promotion), nil
+}
@@ -0,0 +1,85 @@
+package applicationpromotion
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	api "github.com/openshift/origin/pkg/application/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+)
+
+type fakeApplications map[string]*api.Application
+
+func (f fakeApplications) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	if app, ok := f[name]; ok {
+		return app, nil
+	}
+	return nil, kerrors.NewNotFound("application", name)
+}
+
+// fakeSubjectAccessReviewRegistry allows everything but the denied "verb resource namespace".
+type fakeSubjectAccessReviewRegistry struct {
+	denied   sets.String
+	requests sets.String
+}
+
+var _ subjectaccessreview.Registry = &fakeSubjectAccessReviewRegistry{}
+
+func (f *fakeSubjectAccessReviewRegistry) CreateSubjectAccessReview(ctx kapi.Context, subjectAccessReview *authorizationapi.SubjectAccessReview) (*authorizationapi.SubjectAccessReviewResponse, error) {
+	request := subjectAccessReview.Action.Verb + " " + subjectAccessReview.Action.Resource + " " + kapi.NamespaceValue(ctx)
+	f.requests.Insert(request)
+	return &authorizationapi.SubjectAccessReviewResponse{Allowed: !f.denied.Has(request)}, nil
+}
+
+func TestValidateAccess(t *testing.T) {
+	applications := fakeApplications{
+		"web": {
+			ObjectMeta: kapi.ObjectMeta{Namespace: "dev", Name: "web"},
+			Spec: api.ApplicationSpec{Items: []api.Item{
+				{Kind: "DeploymentConfig", Name: "web"},
+				{Kind: "Service", Name: "web"},
+				{Kind: "PersistentVolume", Name: "data"},
+			}},
+		},
+	}
+	expected := sets.NewString(
+		"get applications dev", "get deploymentconfigs dev", "get services dev", "get secrets dev",
+		"create applications test", "create deploymentconfigs test", "create services test",
+		"update applications test", "update deploymentconfigs test", "update services test",
+	)
+
+	tests := []struct {
+		name        string
+		application string
+		denied      sets.String
+		errs        int
+	}{
+		{name: "allowed", application: "web", denied: sets.NewString()},
+		{name: "no secrets in the source", application: "web", denied: sets.NewString("get secrets dev"), errs: 1},
+		{name: "no items in the target", application: "web", denied: sets.NewString("create services test", "update deploymentconfigs test"), errs: 2},
+		{name: "no application", application: "db", denied: sets.NewString(), errs: 1},
+	}
+	for _, test := range tests {
+		sar := &fakeSubjectAccessReviewRegistry{denied: test.denied, requests: sets.NewString()}
+		strategy := NewStrategy(applications, sar)
+		promotion := &api.ApplicationPromotion{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "dev", Name: "promotion"},
+			Spec:       api.ApplicationPromotionSpec{Application: test.application, TargetNamespace: "test"},
+		}
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "dev"), &user.DefaultInfo{Name: "alice"})
+
+		errs := strategy.Validate(ctx, promotion)
+		if len(errs) != test.errs {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.errs, errs)
+		}
+		if test.application == "web" && !sar.requests.Equal(expected) {
+			t.Errorf("%s: expected the access checks %v, got %v", test.name, expected.List(), sar.requests.List())
+		}
+	}
+}
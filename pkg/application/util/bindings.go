@@ -0,0 +1,69 @@
+package util
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client"
+)
+
+// ApplicationBindings returns the bindings of bsi to the items, so that the instance
+// is bound again once provisioned. The bindings made before BackingServiceBindings
+// existed are turned into BackingServiceBindings.
+func ApplicationBindings(osClient client.Interface, namespace string, bsi *backingserviceinstanceapi.BackingServiceInstance, items applicationapi.ItemList) ([]backingserviceinstanceapi.BackingServiceBinding, error) {
+	isItem := func(kind, name string) bool {
+		if len(kind) == 0 {
+			kind = backingserviceinstanceapi.BindKind_DeploymentConfig
+		}
+		for _, item := range items {
+			if item.Kind == kind && item.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: bsi.Name})
+	list, err := osClient.BackingServiceBindings(namespace).List(selector, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := []backingserviceinstanceapi.BackingServiceBinding{}
+	bound := sets.NewString()
+	for _, binding := range list.Items {
+		key := backingserviceinstanceapi.BindingAnnotationKey(binding.Spec.BindKind, binding.Spec.ResourceName)
+		if binding.DeletionTimestamp != nil || bound.Has(key) || !isItem(binding.Spec.BindKind, binding.Spec.ResourceName) {
+			continue
+		}
+		bound.Insert(key)
+		bindings = append(bindings, binding)
+	}
+
+	for _, legacy := range bsi.Spec.Binding {
+		key := backingserviceinstanceapi.BindingAnnotationKey(legacy.BindKind, legacy.BindDeploymentConfig)
+		if len(legacy.BindUuid) == 0 || bound.Has(key) || !isItem(legacy.BindKind, legacy.BindDeploymentConfig) {
+			continue
+		}
+		bound.Insert(key)
+		binding := backingserviceinstanceapi.BackingServiceBinding{
+			ObjectMeta: kapi.ObjectMeta{Name: bsi.Name + "-" + legacy.BindDeploymentConfig},
+			Spec: backingserviceinstanceapi.BackingServiceBindingSpec{
+				BackingServiceInstanceName: bsi.Name,
+				BindKind:                   legacy.BindKind,
+				ResourceName:               legacy.BindDeploymentConfig,
+				Injection:                  legacy.Injection,
+				MountPath:                  legacy.MountPath,
+			},
+		}
+		if len(binding.Spec.BindKind) == 0 {
+			binding.Spec.BindKind = backingserviceinstanceapi.BindKind_DeploymentConfig
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
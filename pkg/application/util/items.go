@@ -0,0 +1,109 @@
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+// ItemClient gets, lists, updates and deletes the resources of Application items of
+// any of the ApplicationItemSupportKinds, which the RESTMapper maps to their resources.
+type ItemClient struct {
+	Mapper meta.RESTMapper
+	// OriginClient serves the kinds OpenShift owns, KubeClient the others.
+	OriginClient resource.RESTClient
+	KubeClient   resource.RESTClient
+}
+
+// NewItemClient returns an ItemClient mapping kinds with the RESTMapper of OpenShift.
+func NewItemClient(originClient, kubeClient resource.RESTClient) *ItemClient {
+	return &ItemClient{
+		Mapper:       latest.RESTMapper,
+		OriginClient: originClient,
+		KubeClient:   kubeClient,
+	}
+}
+
+// helper returns the resource.Helper of the resources of kind.
+func (c *ItemClient) helper(kind string) (*resource.Helper, error) {
+	if !Contains(applicationapi.ApplicationItemSupportKinds, kind) {
+		return nil, fmt.Errorf("unsupported item kind %s", kind)
+	}
+	mapping, err := c.Mapper.RESTMapping(kind)
+	if err != nil {
+		return nil, err
+	}
+	client := c.KubeClient
+	if latest.OriginKind(kind, mapping.APIVersion) {
+		client = c.OriginClient
+	}
+	if client == nil {
+		return nil, fmt.Errorf("no client for item kind %s", kind)
+	}
+	return resource.NewHelper(client, mapping), nil
+}
+
+// Get returns the resource of item, in namespace unless its kind is not namespaced.
+func (c *ItemClient) Get(namespace string, item applicationapi.Item) (runtime.Object, error) {
+	helper, err := c.helper(item.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return helper.Get(namespace, item.Name)
+}
+
+// Create creates obj, the resource of item, in namespace.
+func (c *ItemClient) Create(namespace string, item applicationapi.Item, obj runtime.Object) (runtime.Object, error) {
+	helper, err := c.helper(item.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return helper.Create(namespace, false, obj)
+}
+
+// Namespaced returns true if the resources of kind are namespaced.
+func (c *ItemClient) Namespaced(kind string) (bool, error) {
+	helper, err := c.helper(kind)
+	if err != nil {
+		return false, err
+	}
+	return helper.NamespaceScoped, nil
+}
+
+// Update replaces the resource of item by obj, which is got with Get.
+func (c *ItemClient) Update(namespace string, item applicationapi.Item, obj runtime.Object) error {
+	helper, err := c.helper(item.Kind)
+	if err != nil {
+		return err
+	}
+	_, err = helper.Replace(namespace, item.Name, false, obj)
+	return err
+}
+
+// Delete deletes the resource of item.
+func (c *ItemClient) Delete(namespace string, item applicationapi.Item) error {
+	helper, err := c.helper(item.Kind)
+	if err != nil {
+		return err
+	}
+	return helper.Delete(namespace, item.Name)
+}
+
+// List returns the resources of kind matching selector.
+func (c *ItemClient) List(namespace, kind string, selector labels.Selector) ([]runtime.Object, error) {
+	helper, err := c.helper(kind)
+	if err != nil {
+		return nil, err
+	}
+	list, err := helper.List(namespace, "", selector)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.ExtractList(list)
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"testing"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+func TestItemClientHelper(t *testing.T) {
+	originClient, kubeClient := &kclient.RESTClient{}, &kclient.RESTClient{}
+	items := NewItemClient(originClient, kubeClient)
+
+	tests := []struct {
+		kind       string
+		resource   string
+		namespaced bool
+		origin     bool
+	}{
+		{kind: "Route", resource: "routes", namespaced: true, origin: true},
+		{kind: "Template", resource: "templates", namespaced: true, origin: true},
+		{kind: "BackingServiceInstance", resource: "backingserviceinstances", namespaced: true, origin: true},
+		{kind: "ServiceBroker", resource: "servicebrokers", origin: true},
+		{kind: "Secret", resource: "secrets", namespaced: true},
+		{kind: "PersistentVolumeClaim", resource: "persistentvolumeclaims", namespaced: true},
+		{kind: "Node", resource: "nodes"},
+	}
+	for _, test := range tests {
+		helper, err := items.helper(test.kind)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.kind, err)
+			continue
+		}
+		if helper.Resource != test.resource || helper.NamespaceScoped != test.namespaced {
+			t.Errorf("%s: expected %s namespaced=%t, got %s namespaced=%t", test.kind, test.resource, test.namespaced, helper.Resource, helper.NamespaceScoped)
+		}
+		expected := kubeClient
+		if test.origin {
+			expected = originClient
+		}
+		if helper.RESTClient != expected {
+			t.Errorf("%s: served by the wrong client", test.kind)
+		}
+	}
+
+	for _, kind := range []string{"Job", "Project", "Unknown"} {
+		if _, err := items.helper(kind); err == nil {
+			t.Errorf("%s: expected an unsupported kind", kind)
+		}
+	}
+}
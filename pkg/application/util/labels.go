@@ -0,0 +1,25 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LabelKey returns the key of the label the items of the application name in namespace
+// are labelled with.
+func LabelKey(namespace, name string) string {
+	return fmt.Sprintf("%s.application.%s", namespace, name)
+}
+
+// LabelExistsOtherApplicationKey returns true if labels include the label key of an
+// application and of at least another one, the resource is shared between them.
+func LabelExistsOtherApplicationKey(labels map[string]string, key string) bool {
+	applications := 0
+	for k := range labels {
+		if strings.Contains(k, ".application.") {
+			applications++
+		}
+	}
+	_, exists := labels[key]
+	return exists && applications > 1
+}
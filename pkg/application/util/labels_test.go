@@ -0,0 +1,26 @@
+package util
+
+import "testing"
+
+func TestLabelExistsOtherApplicationKey(t *testing.T) {
+	key := LabelKey("shop", "web")
+	if key != "shop.application.web" {
+		t.Fatalf("unexpected label key %q", key)
+	}
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		shared bool
+	}{
+		{name: "only this application", labels: map[string]string{key: "web", "app": "web"}},
+		{name: "another application", labels: map[string]string{key: "web", "shop.application.api": "api"}, shared: true},
+		{name: "not labelled by this application", labels: map[string]string{"shop.application.api": "api", "shop.application.db": "db"}},
+		{name: "no labels"},
+	}
+	for _, test := range tests {
+		if shared := LabelExistsOtherApplicationKey(test.labels, key); shared != test.shared {
+			t.Errorf("%s: expected shared %t, got %t", test.name, test.shared, shared)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package util
 
 import (
 	"errors"
-	applicationapi "github.com/openshift/origin/pkg/application/api"
 	"strings"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
 )
 
 func Contains(arr []string, str string) bool {
@@ -57,5 +59,9 @@ func expandKindShortcut(kind string) string {
 	if expanded, ok := shortForms[kind]; ok {
 		return expanded
 	}
+	// resource names, like routes or secret, are mapped to their kinds.
+	if _, expanded, err := latest.RESTMapper.VersionAndKindForResource(strings.ToLower(kind)); err == nil {
+		return expanded
+	}
 	return kind
 }
\ No newline at end of file
@@ -79,7 +79,7 @@ const (
 var (
 	GroupsToResources = map[string][]string{
 		BackingServiceGroupName:         {"backingservices"},
-		BackingServiceInstanceGroupName: {"backingserviceinstances", "backingserviceinstances/binding"},
+		BackingServiceInstanceGroupName: {"backingserviceinstances", "backingserviceinstances/binding", "backingservicebindings"},
 
 		BuildGroupName:       {"builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "builds/log", "builds/clone", "buildconfigs/webhooks"},
 		ImageGroupName:       {"imagestreams", "imagestreammappings", "imagestreamtags", "imagestreamimages"},
@@ -93,11 +93,11 @@ var (
 		// RAR and SAR are in this list to support backwards compatibility with clients that expect access to those resource in a namespace scope and a cluster scope.
 		// TODO remove once we have eliminated the namespace scoped resource.
 		PermissionGrantingGroupName: {"roles", "rolebindings", "resourceaccessreviews" /* cluster scoped*/, "subjectaccessreviews" /* cluster scoped*/, "localresourceaccessreviews", "localsubjectaccessreviews"},
-		OpenshiftExposedGroupName:   {"applications", BackingServiceInstanceGroupName, BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes"},
+		OpenshiftExposedGroupName:   {"applications", "applicationpromotions", "projectservicebrokers", BackingServiceInstanceGroupName, BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes"},
 		OpenshiftAllGroupName: {OpenshiftExposedGroupName, UserGroupName, OAuthGroupName, PolicyOwnerGroupName, SDNGroupName, PermissionGrantingGroupName, OpenshiftStatusGroupName, "projects",
 			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details",
-			"servicebrokers"},
-		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status",BackingServiceGroupName},
+			"servicebrokers", "backingserviceusagereports"},
+		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status",BackingServiceGroupName, "backingserviceusages"},
 
 		QuotaGroupName:         {"limitranges", "resourcequotas", "resourcequotausages"},
 		KubeExposedGroupName:   {"pods", "replicationcontrollers", "serviceaccounts", "services", "endpoints", "persistentvolumeclaims", "pods/log"},
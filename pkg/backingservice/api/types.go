@@ -5,6 +5,10 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 )
 
+// BackingServiceSharedNamespace holds the BackingServices of the cluster brokers,
+// visible to the projects their broker's visibility rules allow.
+const BackingServiceSharedNamespace = "openshift"
+
 type BackingService struct {
 	unversioned.TypeMeta
 	kapi.ObjectMeta
@@ -61,6 +65,23 @@ type ServicePlan struct {
 	Description string
 	Metadata    ServicePlanMetadata
 	Free        bool
+	// Deprecated is set on plans the broker no longer offers, they are kept
+	// until no instance uses them.
+	Deprecated bool
+	// Schemas are the JSON schemas of the parameters the plan accepts.
+	Schemas ServicePlanSchemas
+}
+
+// ServicePlanSchemas holds the json encoded JSON schemas of the parameters of a
+// plan, imported from the catalog of the broker or set by an administrator. Empty
+// schemas accept any parameters.
+type ServicePlanSchemas struct {
+	// InstanceCreate checks the parameters instances are provisioned with.
+	InstanceCreate string
+	// InstanceUpdate checks the parameters instances are updated with.
+	InstanceUpdate string
+	// BindingCreate checks the parameters of bindings.
+	BindingCreate string
 }
 
 type ServicePlanMetadata struct {
@@ -85,4 +106,7 @@ type BackingServicePhase string
 const (
 	BackingServicePhaseActive   BackingServicePhase = "Active"
 	BackingServicePhaseInactive BackingServicePhase = "Inactive"
+	// BackingServicePhaseDeprecated is a service the broker no longer offers,
+	// it is deleted once no instance uses it.
+	BackingServicePhaseDeprecated BackingServicePhase = "Deprecated"
 )
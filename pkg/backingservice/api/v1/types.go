@@ -61,6 +61,15 @@ type ServicePlan struct {
 	Description string              `json:"description"`
 	Metadata    ServicePlanMetadata `json:"metadata, omitempty"`
 	Free        bool                `json:"free, omitempty"`
+	Deprecated  bool                `json:"deprecated,omitempty" description:"deprecated is set on plans the broker no longer offers, they are kept until no instance uses them"`
+	Schemas     ServicePlanSchemas  `json:"schemas,omitempty" description:"JSON schemas of the parameters the plan accepts"`
+}
+
+// ServicePlanSchemas holds the json encoded JSON schemas of the parameters of a plan.
+type ServicePlanSchemas struct {
+	InstanceCreate string `json:"instance_create,omitempty" description:"json encoded JSON schema of the parameters instances are provisioned with"`
+	InstanceUpdate string `json:"instance_update,omitempty" description:"json encoded JSON schema of the parameters instances are updated with"`
+	BindingCreate  string `json:"binding_create,omitempty" description:"json encoded JSON schema of the parameters of bindings"`
 }
 
 type ServicePlanMetadata struct {
@@ -85,4 +94,7 @@ type BackingServicePhase string
 const (
 	BackingServicePhaseActive   BackingServicePhase = "Active"
 	BackingServicePhaseInactive BackingServicePhase = "Inactive"
+	// BackingServicePhaseDeprecated is a service the broker no longer offers,
+	// it is deleted once no instance uses it.
+	BackingServicePhaseDeprecated BackingServicePhase = "Deprecated"
 )
@@ -34,8 +34,13 @@ func ValidateBackingServiceUpdate(bs *backingserviceapi.BackingService, older *b
 
 	allErrs = append(allErrs, ValidateBackingService(bs)...)
 
-	if older.Status.Phase != bs.Status.Phase {
-		allErrs = append(allErrs, fielderrors.NewFieldInvalid("status.Phase", bs.Status.Phase, "phase cannot be updated from a terminal state"))
+	switch bs.Status.Phase {
+	case backingserviceapi.BackingServicePhaseActive, backingserviceapi.BackingServicePhaseInactive, backingserviceapi.BackingServicePhaseDeprecated:
+	default:
+		if older.Status.Phase != bs.Status.Phase {
+			allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("status.Phase", bs.Status.Phase,
+				[]string{string(backingserviceapi.BackingServicePhaseActive), string(backingserviceapi.BackingServicePhaseInactive), string(backingserviceapi.BackingServicePhaseDeprecated)}))
+		}
 	}
 	return allErrs
 }
@@ -2,11 +2,15 @@ package etcd
 
 import (
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
 	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
 	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/backingservice/api"
@@ -16,10 +20,15 @@ import (
 
 type REST struct {
 	store *etcdgeneric.Etcd
+
+	brokers    rest.Getter
+	namespaces kclient.NamespaceInterface
 }
 
-// NewREST returns a new REST.
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a new REST. The BackingServices of a project include the ones of
+// the shared namespace visible to it according to the rules of their brokers, which
+// are got from brokers.
+func NewREST(s storage.Interface, brokers rest.Getter, namespaces kclient.NamespaceInterface) *REST {
 	prefix := "/backingservices"
 	store := &etcdgeneric.Etcd{
 		NewFunc:     func() runtime.Object { return &api.BackingService{} },
@@ -53,7 +62,7 @@ func NewREST(s storage.Interface) *REST {
 		Storage: s,
 	}
 
-	return &REST{store: store}
+	return &REST{store: store, brokers: brokers, namespaces: namespaces}
 }
 
 /// New returns a new object
@@ -66,13 +75,75 @@ func (r *REST) NewList() runtime.Object {
 	return r.store.NewListFunc()
 }
 
-// Get gets a specific image specified by its ID.
+// Get gets a BackingService of the namespace, or a shared one visible to it.
 func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
-	return r.store.Get(ctx, name)
+	obj, err := r.store.Get(ctx, name)
+	namespace := kapi.NamespaceValue(ctx)
+	if err == nil || !kerrors.IsNotFound(err) || !isProject(namespace) {
+		return obj, err
+	}
+
+	shared, sharedErr := r.store.Get(kapi.WithNamespace(ctx, api.BackingServiceSharedNamespace), name)
+	if sharedErr != nil {
+		return nil, err
+	}
+	view, viewErr := r.projectView(ctx, namespace)
+	if viewErr != nil {
+		return nil, viewErr
+	}
+	bs, visible, viewErr := view.filter(shared.(*api.BackingService))
+	if viewErr != nil {
+		return nil, viewErr
+	}
+	if !visible {
+		return nil, err
+	}
+	return bs, nil
 }
 
+// List lists the BackingServices of the namespace, and the shared ones visible to
+// it unless shadowed by one of the same name.
 func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
-	return r.store.List(ctx, label, field)
+	obj, err := r.store.List(ctx, label, field)
+	namespace := kapi.NamespaceValue(ctx)
+	if err != nil || !isProject(namespace) {
+		return obj, err
+	}
+	list := obj.(*api.BackingServiceList)
+
+	sharedObj, err := r.store.List(kapi.WithNamespace(ctx, api.BackingServiceSharedNamespace), label, field)
+	if err != nil {
+		return nil, err
+	}
+	view, err := r.projectView(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	own := sets.NewString()
+	for i := range list.Items {
+		own.Insert(list.Items[i].Name)
+	}
+	for i := range sharedObj.(*api.BackingServiceList).Items {
+		shared := &sharedObj.(*api.BackingServiceList).Items[i]
+		if own.Has(shared.Name) {
+			continue
+		}
+		bs, visible, err := view.filter(shared)
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			list.Items = append(list.Items, *bs)
+		}
+	}
+	return list, nil
+}
+
+// isProject returns false for the shared namespace and all namespaces, which are
+// listed unfiltered.
+func isProject(namespace string) bool {
+	return len(namespace) > 0 && namespace != api.BackingServiceSharedNamespace
 }
 
 // Create creates an image based on a specification.
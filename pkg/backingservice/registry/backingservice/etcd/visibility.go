@@ -0,0 +1,81 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+
+	"github.com/openshift/origin/pkg/backingservice/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+)
+
+// projectView filters the BackingServices of the shared namespace down to the
+// services and plans visible to a project.
+type projectView struct {
+	namespace string
+	labels    map[string]string
+
+	ctx     kapi.Context
+	brokers rest.Getter
+	rules   map[string][]servicebrokerapi.ServiceVisibility
+}
+
+func (r *REST) projectView(ctx kapi.Context, namespace string) (*projectView, error) {
+	ns, err := r.namespaces.Get(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &projectView{
+		namespace: namespace,
+		labels:    ns.Labels,
+		ctx:       kapi.WithNamespace(ctx, kapi.NamespaceNone),
+		brokers:   r.brokers,
+		rules:     map[string][]servicebrokerapi.ServiceVisibility{},
+	}, nil
+}
+
+// filter returns bs with only the plans visible to the project, or false if bs
+// itself is not visible.
+func (v *projectView) filter(bs *api.BackingService) (*api.BackingService, bool, error) {
+	rules, err := v.brokerRules(bs.Labels[servicebrokerapi.ServiceBrokerLabel])
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rules) == 0 {
+		return bs, true, nil
+	}
+	if !servicebrokerapi.IsVisible(rules, bs.Name, "", v.namespace, v.labels) {
+		return nil, false, nil
+	}
+
+	visible := *bs
+	visible.Spec.Plans = nil
+	for _, plan := range bs.Spec.Plans {
+		if servicebrokerapi.IsVisible(rules, bs.Name, plan.Name, v.namespace, v.labels) {
+			visible.Spec.Plans = append(visible.Spec.Plans, plan)
+		}
+	}
+	return &visible, true, nil
+}
+
+// brokerRules returns the visibility rules of the broker name, none if it is gone.
+func (v *projectView) brokerRules(name string) ([]servicebrokerapi.ServiceVisibility, error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	if rules, ok := v.rules[name]; ok {
+		return rules, nil
+	}
+
+	obj, err := v.brokers.Get(v.ctx, name)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		v.rules[name] = nil
+		return nil, nil
+	}
+	rules := obj.(*servicebrokerapi.ServiceBroker).Spec.Visibility
+	v.rules[name] = rules
+	return rules, nil
+}
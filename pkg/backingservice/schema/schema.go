@@ -0,0 +1,227 @@
+// Package schema checks the parameters of backing service instances and bindings
+// against the JSON schemas of their plans.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Schema is the subset of JSON schema parameters are checked against. Keywords it
+// doesn't know are left for the broker to check.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+}
+
+// ParameterError is a parameter which doesn't match the schema.
+type ParameterError struct {
+	// Name is the path of the parameter, nested properties are dot separated.
+	Name   string
+	Value  interface{}
+	Reason string
+}
+
+func (e *ParameterError) Error() string {
+	return fmt.Sprintf("parameter %s: %s", e.Name, e.Reason)
+}
+
+// Parse parses a json encoded schema. An empty schema is nil, which accepts any parameters.
+func Parse(data string) (*Schema, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	s := &Schema{}
+	if err := json.Unmarshal([]byte(data), s); err != nil {
+		return nil, fmt.Errorf("invalid parameters schema: %v", err)
+	}
+	return s, nil
+}
+
+// Convert returns params typed as the properties of s declare, and checks them
+// against s. Values are strings, or json encoded for the other types. Without a
+// schema every value is a string.
+func (s *Schema) Convert(params map[string]string) (map[string]interface{}, []*ParameterError) {
+	if len(params) == 0 && s == nil {
+		return nil, nil
+	}
+
+	values := make(map[string]interface{}, len(params))
+	errs := []*ParameterError{}
+	for _, name := range sortedKeys(params) {
+		value, err := s.property(name).convert(params[name])
+		if err != nil {
+			errs = append(errs, &ParameterError{Name: name, Value: params[name], Reason: err.Error()})
+			continue
+		}
+		values[name] = value
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	if s != nil {
+		s.validate("", values, &errs)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return values, nil
+}
+
+// property returns the schema of the property name, nil if it has none.
+func (s *Schema) property(name string) *Schema {
+	if s == nil {
+		return nil
+	}
+	return s.Properties[name]
+}
+
+func (s *Schema) convert(value string) (interface{}, error) {
+	if s == nil {
+		return value, nil
+	}
+	switch s.Type {
+	case "", "string":
+		return value, nil
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "integer", "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be of type %s", s.Type)
+		}
+		return f, nil
+	default:
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("must be a json encoded %s", s.Type)
+		}
+		return v, nil
+	}
+}
+
+func (s *Schema) validate(name string, value interface{}, errs *[]*ParameterError) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, &ParameterError{Name: name, Value: value, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if !hasType(s.Type, value) {
+		fail("must be of type %s", s.Type)
+		return
+	}
+	if len(s.Enum) > 0 && !inEnum(s.Enum, value) {
+		fail("must be one of %v", s.Enum)
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			fail("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			fail("must be at most %v", *s.Maximum)
+		}
+	case string:
+		if s.MinLength != nil && len(v) < *s.MinLength {
+			fail("must be at least %d characters long", *s.MinLength)
+		}
+		if s.MaxLength != nil && len(v) > *s.MaxLength {
+			fail("must be at most %d characters long", *s.MaxLength)
+		}
+		if len(s.Pattern) > 0 {
+			if re, err := regexp.Compile(s.Pattern); err == nil && !re.MatchString(v) {
+				fail("must match %s", s.Pattern)
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i := range v {
+				s.Items.validate(fmt.Sprintf("%s[%d]", name, i), v[i], errs)
+			}
+		}
+	case map[string]interface{}:
+		for _, required := range s.Required {
+			if _, ok := v[required]; !ok {
+				*errs = append(*errs, &ParameterError{Name: join(name, required), Reason: "is required"})
+			}
+		}
+		for _, key := range sortedKeys(v) {
+			if property := s.Properties[key]; property != nil {
+				property.validate(join(name, key), v[key], errs)
+			} else if string(s.AdditionalProperties) == "false" {
+				*errs = append(*errs, &ParameterError{Name: join(name, key), Value: v[key], Reason: "is not a parameter of the plan"})
+			}
+		}
+	}
+}
+
+func hasType(t string, value interface{}) bool {
+	switch t {
+	case "":
+		return true
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+	// types of newer drafts are left for the broker to check.
+	return true
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(e, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func join(name, key string) string {
+	if len(name) == 0 {
+		return key
+	}
+	return name + "." + key
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+	"type": "object",
+	"required": ["size"],
+	"additionalProperties": false,
+	"properties": {
+		"size": {"type": "integer", "minimum": 1, "maximum": 10},
+		"engine": {"type": "string", "enum": ["innodb", "myisam"]},
+		"backup": {"type": "boolean"},
+		"tags": {"type": "array", "items": {"type": "string"}}
+	}
+}`
+
+func TestConvert(t *testing.T) {
+	s, err := Parse(testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		params   map[string]string
+		expected map[string]interface{}
+		errors   []string
+	}{
+		{
+			params:   map[string]string{"size": "2", "engine": "innodb", "backup": "true", "tags": `["a","b"]`},
+			expected: map[string]interface{}{"size": 2.0, "engine": "innodb", "backup": true, "tags": []interface{}{"a", "b"}},
+		},
+		{
+			params: map[string]string{"engine": "innodb"},
+			errors: []string{"size"},
+		},
+		{
+			params: map[string]string{"size": "1.5"},
+			errors: []string{"size"},
+		},
+		{
+			params: map[string]string{"size": "11", "engine": "memory"},
+			errors: []string{"engine", "size"},
+		},
+		{
+			params: map[string]string{"size": "1", "color": "red"},
+			errors: []string{"color"},
+		},
+		{
+			params: map[string]string{"size": "1", "tags": `[1]`},
+			errors: []string{"tags[0]"},
+		},
+	}
+
+	for i, test := range tests {
+		values, errs := s.Convert(test.params)
+		names := []string{}
+		for _, err := range errs {
+			names = append(names, err.Name)
+		}
+		if len(test.errors) != len(names) || (len(names) > 0 && !reflect.DeepEqual(test.errors, names)) {
+			t.Errorf("%d: expected errors for %v, got %v", i, test.errors, errs)
+			continue
+		}
+		if len(test.errors) == 0 && !reflect.DeepEqual(test.expected, values) {
+			t.Errorf("%d: expected %#v, got %#v", i, test.expected, values)
+		}
+	}
+}
+
+func TestConvertWithoutSchema(t *testing.T) {
+	values, errs := (*Schema)(nil).Convert(map[string]string{"size": "2"})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if !reflect.DeepEqual(values, map[string]interface{}{"size": "2"}) {
+		t.Errorf("expected values to stay strings, got %#v", values)
+	}
+}
@@ -0,0 +1,191 @@
+package admission
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+)
+
+func init() {
+	admission.RegisterPlugin("BackingServiceInstanceQuota", func(c kclient.Interface, config io.Reader) (admission.Interface, error) {
+		osClient, ok := c.(client.Interface)
+		if !ok {
+			return nil, errors.New("client is not an Openshift client")
+		}
+		return NewBackingServiceInstanceQuota(c, osClient), nil
+	})
+}
+
+type instanceQuota struct {
+	*admission.Handler
+	kclient kclient.Interface
+	client  client.Interface
+}
+
+// NewBackingServiceInstanceQuota returns an admission control for backing service
+// instances limiting how many a project may have in total, of a service and of a
+// plan, with the ResourceQuotas of the project. Plans which aren't free may only be
+// used by projects whose namespace allows it.
+func NewBackingServiceInstanceQuota(kclient kclient.Interface, client client.Interface) admission.Interface {
+	return &instanceQuota{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		kclient: kclient,
+		client:  client,
+	}
+}
+
+const backingServiceInstancesResource = "backingserviceinstances"
+
+func (a *instanceQuota) Admit(attr admission.Attributes) error {
+	if attr.GetResource() != backingServiceInstancesResource || attr.GetSubresource() != "" {
+		return nil
+	}
+	bsi, ok := attr.GetObject().(*backingserviceinstanceapi.BackingServiceInstance)
+	if !ok {
+		return nil
+	}
+
+	// only new instances and plan changes are admitted, the storage reports missing
+	// instances, services and plans.
+	var old *backingserviceinstanceapi.BackingServiceInstance
+	if attr.GetOperation() == admission.Update {
+		var err error
+		old, err = a.client.BackingServiceInstances(attr.GetNamespace()).Get(bsi.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			return admission.NewForbidden(attr, err)
+		}
+		if old.Spec.BackingServicePlanGuid == bsi.Spec.BackingServicePlanGuid {
+			return nil
+		}
+	}
+
+	bs, err := a.backingService(attr.GetNamespace(), bsi, old)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return admission.NewForbidden(attr, err)
+	}
+	var plan *backingserviceapi.ServicePlan
+	for i := range bs.Spec.Plans {
+		if bs.Spec.Plans[i].Id == bsi.Spec.BackingServicePlanGuid {
+			plan = &bs.Spec.Plans[i]
+		}
+	}
+	if plan == nil {
+		return nil
+	}
+
+	if !plan.Free {
+		if err := a.checkPaidPlans(attr.GetNamespace()); err != nil {
+			return admission.NewForbidden(attr, fmt.Errorf("plan %s of backingservice %s is not free: %v", plan.Name, bs.Name, err))
+		}
+	}
+	return a.checkQuota(attr, bsi, old == nil, bs, plan)
+}
+
+// backingService returns the BackingService of bsi, the one of old on updates.
+func (a *instanceQuota) backingService(namespace string, bsi, old *backingserviceinstanceapi.BackingServiceInstance) (*backingserviceapi.BackingService, error) {
+	if old != nil {
+		namespace = backingserviceinstanceapi.BackingServiceNamespaceOf(old)
+	}
+	return a.client.BackingServices(namespace).Get(bsi.Spec.BackingServiceName)
+}
+
+// checkPaidPlans returns an error unless the namespace allows plans which aren't free.
+func (a *instanceQuota) checkPaidPlans(namespace string) error {
+	projects, err := projectcache.GetProjectCache()
+	if err != nil {
+		return err
+	}
+	ns, err := projects.GetNamespaceObject(namespace)
+	if err != nil {
+		return err
+	}
+	if ns.Annotations[backingserviceinstanceapi.AllowPaidPlansAnnotation] != "true" {
+		return fmt.Errorf("project %s is not allowed to use plans which are not free", namespace)
+	}
+	return nil
+}
+
+// checkQuota checks one more instance of plan fits in the ResourceQuotas of the
+// namespace of attr. A plan change only counts against the quota of the new plan.
+func (a *instanceQuota) checkQuota(attr admission.Attributes, bsi *backingserviceinstanceapi.BackingServiceInstance, create bool, bs *backingserviceapi.BackingService, plan *backingserviceapi.ServicePlan) error {
+	quotas, err := a.kclient.ResourceQuotas(attr.GetNamespace()).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return admission.NewForbidden(attr, err)
+	}
+	requested := []kapi.ResourceName{backingserviceinstanceapi.QuotaResourceOf(bs.Name, plan.Name)}
+	if create {
+		requested = append(requested, backingserviceinstanceapi.ResourceBackingServiceInstances, backingserviceinstanceapi.QuotaResourceOf(bs.Name, ""))
+	}
+	if !limits(quotas.Items, requested) {
+		return nil
+	}
+
+	instances, err := a.client.BackingServiceInstances(attr.GetNamespace()).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return admission.NewForbidden(attr, err)
+	}
+	used := usage(instances.Items, bsi.Name, bs.Name, plan)
+
+	for _, quota := range quotas.Items {
+		for _, resource := range requested {
+			hard, ok := quota.Spec.Hard[resource]
+			if !ok {
+				continue
+			}
+			if used[resource]+1 > hard.Value() {
+				return admission.NewForbidden(attr, fmt.Errorf("limited to %s=%s by quota %s, %d used", resource, hard.String(), quota.Name, used[resource]))
+			}
+		}
+	}
+	return nil
+}
+
+// limits returns whether any of quotas limits one of resources.
+func limits(quotas []kapi.ResourceQuota, resources []kapi.ResourceName) bool {
+	for _, quota := range quotas {
+		for _, resource := range resources {
+			if _, ok := quota.Spec.Hard[resource]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// usage counts the instances other than the one named name, in total, of service and of
+// its plan. Instances being deleted still count, until they are deprovisioned.
+func usage(instances []backingserviceinstanceapi.BackingServiceInstance, name, service string, plan *backingserviceapi.ServicePlan) map[kapi.ResourceName]int64 {
+	used := map[kapi.ResourceName]int64{}
+	for _, bsi := range instances {
+		if bsi.Name == name {
+			continue
+		}
+		used[backingserviceinstanceapi.ResourceBackingServiceInstances]++
+		if bsi.Spec.BackingServiceName != service {
+			continue
+		}
+		used[backingserviceinstanceapi.QuotaResourceOf(service, "")]++
+		if bsi.Spec.BackingServicePlanGuid == plan.Id {
+			used[backingserviceinstanceapi.QuotaResourceOf(service, plan.Name)]++
+		}
+	}
+	return used
+}
@@ -8,3 +8,18 @@ func BackingServiceInstanceToSelectableFields(backingServiceInstance *BackingSer
 		"metadata.name": backingServiceInstance.Name,
 	}
 }
+
+// BackingServiceBindingToSelectableFields returns a label set that represents the object
+func BackingServiceBindingToSelectableFields(binding *BackingServiceBinding) fields.Set {
+	return fields.Set{
+		"metadata.name": binding.Name,
+	}
+}
+
+// BackingServiceUsageToSelectableFields returns a label set that represents the object
+func BackingServiceUsageToSelectableFields(usage *BackingServiceUsage) fields.Set {
+	return fields.Set{
+		"metadata.name":              usage.Name,
+		"backingServiceInstanceName": usage.BackingServiceInstanceName,
+	}
+}
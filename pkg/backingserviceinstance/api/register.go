@@ -10,10 +10,20 @@ func init() {
 		&BackingServiceInstanceList{},
 		//&BindingRequest{},
 		&BindingRequestOptions{},
+		&BackingServiceBinding{},
+		&BackingServiceBindingList{},
+		&BackingServiceUsage{},
+		&BackingServiceUsageList{},
+		&BackingServiceUsageReport{},
 	)
 }
 
 func (*BackingServiceInstance) IsAnAPIObject()     {}
 func (*BackingServiceInstanceList) IsAnAPIObject() {}
 //func (*BindingRequest) IsAnAPIObject()             {}
-func (*BindingRequestOptions) IsAnAPIObject()      {}
\ No newline at end of file
+func (*BindingRequestOptions) IsAnAPIObject()      {}
+func (*BackingServiceBinding) IsAnAPIObject()      {}
+func (*BackingServiceBindingList) IsAnAPIObject()  {}
+func (*BackingServiceUsage) IsAnAPIObject()        {}
+func (*BackingServiceUsageList) IsAnAPIObject()    {}
+func (*BackingServiceUsageReport) IsAnAPIObject()  {}
\ No newline at end of file
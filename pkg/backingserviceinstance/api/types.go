@@ -1,6 +1,10 @@
 package api
 
 import (
+	"encoding/json"
+	"strings"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 )
@@ -61,46 +65,149 @@ type InstanceProvisioning struct {
 */
 
 type InstanceProvisioning struct {
-	DashboardUrl           string
-	BackingServiceName     string
-	BackingServiceSpecID   string
-	BackingServicePlanGuid string
-	BackingServicePlanName string
-	Parameters             map[string]string
+	DashboardUrl       string
+	BackingServiceName string
+	// BackingServiceNamespace is where the BackingService is, the shared namespace
+	// of the cluster brokers if empty.
+	BackingServiceNamespace string
+	BackingServiceSpecID    string
+	BackingServicePlanGuid  string
+	BackingServicePlanName  string
+	Parameters              map[string]string
 	// len(Parameters) == 0 means not inited
 }
 
 type InstanceBinding struct {
 	// BindUuid is blank for not bound (Bound=false) or to unbind (Bound=true)
 	// BindUuid != "" and Bound=false means to bind
-	BindUuid             string
-	BoundTime            *unversioned.Time
+	BindUuid  string
+	BoundTime *unversioned.Time
+	// BindKind is the kind of the bound resource, DeploymentConfig if empty.
+	BindKind string
+	// BindDeploymentConfig is the name of the bound resource.
 	BindDeploymentConfig string
-	Credentials          map[string]string
+	// Credentials is only set for bindings made before credentials were kept in CredentialsSecret.
+	Credentials map[string]string
+	// CredentialsSecret is the Secret holding the credentials the broker returned for the binding.
+	CredentialsSecret string
+	// Injection is how the credentials are made available to the bound resource.
+	Injection BindingInjection
+	// MountPath is where the credentials are mounted with the Volume injection.
+	MountPath string
 }
 
+// BindingInjection is how the credentials of a binding are made available to the bound resource.
+type BindingInjection string
+
+const (
+	// BindingInjectionEnv sets an environment variable per credential, and VCAP_SERVICES.
+	// The vendored Kubernetes can't refer to Secrets from environment variables, so the
+	// values end up in the bound resource. It has to be asked for, except for BuildConfigs
+	// which support nothing else.
+	BindingInjectionEnv BindingInjection = "Env"
+	// BindingInjectionVolume mounts the Secret of the binding, a file per credential.
+	BindingInjectionVolume BindingInjection = "Volume"
+
+	// DefaultBindingMountPath is the directory the Secret of a binding is mounted under,
+	// in a subdirectory named after the instance.
+	DefaultBindingMountPath = "/var/run/secrets/backingservices"
+
+	// BackingServiceInstanceLabel labels the Secrets holding the credentials of the bindings of an instance.
+	BackingServiceInstanceLabel = "asiainfo.io/backingserviceinstance"
+
+	// RouteForwardingRequirement is required by the services offering route services.
+	RouteForwardingRequirement = "route_forwarding"
+)
+
 // ProjectStatus is information about the current status of a Project
 type BackingServiceInstanceStatus struct {
 	Phase  BackingServiceInstancePhase
 	Action BackingServiceInstanceAction
 
 	LastOperation *LastOperation
+
+	// AppliedPlanGuid is the plan the broker has provisioned (or last updated) the instance with.
+	// It differs from Spec.BackingServicePlanGuid while a plan change is pending.
+	AppliedPlanGuid string
+	// AppliedParameters are the parameters the broker has provisioned (or last updated)
+	// the instance with.
+	AppliedParameters map[string]string
+
+	// Deletion reports the progress of the deletion of the instance.
+	Deletion *InstanceDeletionStatus
+}
+
+// InstanceDeletionStatus is the progress of the deletion of an instance, which waits
+// for its bindings to be unbound before it is deprovisioned.
+type InstanceDeletionStatus struct {
+	// RemainingBindings is how many bindings are left to unbind.
+	RemainingBindings int
+	// FailedBindings are the bindings which failed to unbind, they are retried.
+	FailedBindings []string
+	// Message describes the step the deletion is at.
+	Message string
+}
+
+// CascadeDeletionAnnotation set to "true" on an instance lets it be deleted while it
+// is bound, its bindings are unbound first.
+const CascadeDeletionAnnotation = "asiainfo.io/cascade-delete"
+
+// ExternalBindingsAnnotation records the bindings of an instance made through the
+// service broker facade, which bind no resource of the cluster. It holds a JSON
+// object mapping their binding ids to a digest of their bind request. They count
+// in the Bound of the instance.
+const ExternalBindingsAnnotation = "asiainfo.io/external-bindings"
+
+// ExternalBindings returns the digests of the bind requests recorded in the
+// ExternalBindingsAnnotation of bsi, keyed by binding id.
+func ExternalBindings(bsi *BackingServiceInstance) map[string]string {
+	bindings := map[string]string{}
+	if value, ok := bsi.Annotations[ExternalBindingsAnnotation]; ok {
+		json.Unmarshal([]byte(value), &bindings)
+	}
+	return bindings
+}
+
+// SetExternalBindings records bindings in the ExternalBindingsAnnotation of bsi.
+func SetExternalBindings(bsi *BackingServiceInstance, bindings map[string]string) {
+	if len(bindings) == 0 {
+		delete(bsi.Annotations, ExternalBindingsAnnotation)
+		return
+	}
+	data, _ := json.Marshal(bindings)
+	if bsi.Annotations == nil {
+		bsi.Annotations = map[string]string{}
+	}
+	bsi.Annotations[ExternalBindingsAnnotation] = string(data)
 }
 
 type LastOperation struct {
 	State                    string
 	Description              string
 	AsyncPollIntervalSeconds int
+	// Operation is the opaque operation token returned by the broker with
+	// a 202 response, it is sent back when polling last_operation.
+	Operation string
+	// Mitigation is true for the deprovisioning of an instance whose provisioning
+	// failed ambiguously, provisioning is retried once it is over.
+	Mitigation bool
 }
 
+const (
+	LastOperationStateInProgress = "in progress"
+	LastOperationStateSucceeded  = "succeeded"
+	LastOperationStateFailed     = "failed"
+)
+
 type BackingServiceInstancePhase string
 type BackingServiceInstanceAction string
 
 const (
-	BackingServiceInstancePhaseProvisioning BackingServiceInstancePhase = "Provisioning"
-	BackingServiceInstancePhaseUnbound      BackingServiceInstancePhase = "Unbound"
-	BackingServiceInstancePhaseBound        BackingServiceInstancePhase = "Bound"
-	BackingServiceInstancePhaseDeleted      BackingServiceInstancePhase = "Deleted"
+	BackingServiceInstancePhaseProvisioning   BackingServiceInstancePhase = "Provisioning"
+	BackingServiceInstancePhaseUnbound        BackingServiceInstancePhase = "Unbound"
+	BackingServiceInstancePhaseBound          BackingServiceInstancePhase = "Bound"
+	BackingServiceInstancePhaseDeprovisioning BackingServiceInstancePhase = "Deprovisioning"
+	BackingServiceInstancePhaseDeleted        BackingServiceInstancePhase = "Deleted"
 
 	BackingServiceInstanceActionToBind   BackingServiceInstanceAction = "_ToBind"
 	BackingServiceInstanceActionToUnbind BackingServiceInstanceAction = "_ToUnbind"
@@ -115,7 +222,102 @@ const (
 //
 //=====================================================
 
-const BindKind_DeploymentConfig = "DeploymentConfig"
+// The kinds of resources a BackingServiceInstance can be bound to. The spec of
+// a Pod can't be changed once it is created, so Pods can't be bound.
+const (
+	BindKind_DeploymentConfig      = "DeploymentConfig"
+	BindKind_ReplicationController = "ReplicationController"
+	BindKind_PodTemplate           = "PodTemplate"
+	BindKind_Job                   = "Job"
+	BindKind_DaemonSet             = "DaemonSet"
+	BindKind_Deployment            = "Deployment"
+	// BuildConfigs only get the credentials in the environment of their builds.
+	BindKind_BuildConfig = "BuildConfig"
+	// Routes get no credentials, their requests are forwarded through the route
+	// service of the broker.
+	BindKind_Route = "Route"
+)
+
+// BindKinds are all the kinds of resources a BackingServiceInstance can be bound to.
+var BindKinds = []string{
+	BindKind_DeploymentConfig,
+	BindKind_ReplicationController,
+	BindKind_PodTemplate,
+	BindKind_Job,
+	BindKind_DaemonSet,
+	BindKind_Deployment,
+	BindKind_BuildConfig,
+	BindKind_Route,
+}
+
+// LegacyInstanceIDParameter was added to the parameters of instances by older
+// controllers, it is never sent to the broker.
+const LegacyInstanceIDParameter = "instance_id"
+
+// ParametersOf returns the parameters bsi is provisioned or updated with.
+func ParametersOf(bsi *BackingServiceInstance) map[string]string {
+	if _, ok := bsi.Spec.Parameters[LegacyInstanceIDParameter]; !ok {
+		return bsi.Spec.Parameters
+	}
+	params := make(map[string]string, len(bsi.Spec.Parameters))
+	for k, v := range bsi.Spec.Parameters {
+		if k != LegacyInstanceIDParameter {
+			params[k] = v
+		}
+	}
+	return params
+}
+
+// ParametersEqual returns true if the parameters a and b are the same, nil and empty
+// parameters are.
+func ParametersEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultBindingInjection returns the injection of the bindings of kind that
+// don't ask for one: the credentials are mounted from their Secret, so that
+// they don't end up in the bound resource, unless the kind has no pod template.
+func DefaultBindingInjection(kind string) BindingInjection {
+	switch kind {
+	case BindKind_BuildConfig, BindKind_Route:
+		return BindingInjectionEnv
+	}
+	return BindingInjectionVolume
+}
+
+// BackingServiceNamespaceOf returns the namespace of the BackingService of bsi.
+func BackingServiceNamespaceOf(bsi *BackingServiceInstance) string {
+	if len(bsi.Spec.BackingServiceNamespace) > 0 {
+		return bsi.Spec.BackingServiceNamespace
+	}
+	return backingserviceapi.BackingServiceSharedNamespace
+}
+
+// BindingAnnotationKey returns the key of the annotation tracking the binding of
+// the resource name of kind. DeploymentConfigs are keyed by their name alone, as
+// they were before other kinds could be bound.
+func BindingAnnotationKey(kind, name string) string {
+	if len(kind) == 0 || kind == BindKind_DeploymentConfig {
+		return name
+	}
+	return kind + "/" + name
+}
+
+// ParseBindingAnnotationKey is the reverse of BindingAnnotationKey.
+func ParseBindingAnnotationKey(key string) (kind, name string) {
+	if i := strings.Index(key, "/"); i >= 0 {
+		return key[:i], key[i+1:]
+	}
+	return BindKind_DeploymentConfig, key
+}
 
 //type BindingRequest struct {
 //	unversioned.TypeMeta
@@ -131,6 +333,11 @@ type BindingRequestOptions struct {
 	BindKind            string
 	BindResourceVersion string
 	ResourceName        string
+	// Injection is how the credentials are made available to the bound resource,
+	// DefaultBindingInjection of BindKind if empty.
+	Injection BindingInjection
+	// MountPath is where the credentials are mounted with the Volume injection.
+	MountPath string
 }
 
 func NewBindingRequestOptions(kind, version, name string) *BindingRequestOptions {
@@ -140,3 +347,213 @@ func NewBindingRequestOptions(kind, version, name string) *BindingRequestOptions
 		ResourceName:        name,
 	}
 }
+
+// BackingServiceBinding binds a BackingServiceInstance to a resource of its namespace.
+// Each binding is handled on its own, creating it binds and deleting it unbinds.
+type BackingServiceBinding struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	Spec   BackingServiceBindingSpec
+	Status BackingServiceBindingStatus
+}
+
+type BackingServiceBindingList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []BackingServiceBinding
+}
+
+type BackingServiceBindingSpec struct {
+	// BackingServiceInstanceName is the instance to bind.
+	BackingServiceInstanceName string
+	// BindKind is the kind of the bound resource, one of BindKinds.
+	BindKind string
+	// ResourceName is the name of the bound resource.
+	ResourceName string
+	// Injection is how the credentials are made available to the bound resource,
+	// DefaultBindingInjection of BindKind if empty. Bindings created before the
+	// default was set have no Injection and use Env.
+	Injection BindingInjection
+	// MountPath is where the credentials are mounted with the Volume injection.
+	MountPath string
+	// Parameters are sent to the broker with the bind request, typed as the binding
+	// schema of the plan declares.
+	Parameters map[string]string
+	// RotationPeriod is how often the credentials are rotated, never if nil. Unlike
+	// the rest of the spec it can be changed once the binding is created.
+	RotationPeriod *unversioned.Duration
+}
+
+// RotateCredentialsAnnotation set on a BackingServiceBinding to a new value, the time
+// for instance, rotates its credentials.
+const RotateCredentialsAnnotation = "asiainfo.io/rotate-credentials"
+
+type BackingServiceBindingPhase string
+
+const (
+	// BackingServiceBindingPhasePending is a binding waiting for its instance to be provisioned.
+	BackingServiceBindingPhasePending BackingServiceBindingPhase = "Pending"
+	// BackingServiceBindingPhaseBound is a binding whose credentials are injected into the bound resource.
+	BackingServiceBindingPhaseBound BackingServiceBindingPhase = "Bound"
+	// BackingServiceBindingPhaseUnbinding is a deleted binding being unbound.
+	BackingServiceBindingPhaseUnbinding BackingServiceBindingPhase = "Unbinding"
+	// BackingServiceBindingPhaseFailed is a binding which can't be bound, it has to be deleted.
+	BackingServiceBindingPhaseFailed BackingServiceBindingPhase = "Failed"
+)
+
+type BackingServiceBindingStatus struct {
+	Phase BackingServiceBindingPhase
+	// BindUuid is the id of the binding at the service broker.
+	BindUuid  string
+	BoundTime *unversioned.Time
+	// CredentialsSecret is the Secret holding the credentials the broker returned.
+	CredentialsSecret string
+	Conditions        []BackingServiceBindingCondition
+
+	// RouteServiceURL is the route service the broker returned for a Route binding.
+	RouteServiceURL string
+
+	// RotatedTime is when the credentials were last rotated.
+	RotatedTime *unversioned.Time
+	// RotationRequest is the value of the RotateCredentialsAnnotation last acted on.
+	RotationRequest string
+	// Rotation is the rotation of the credentials in progress, nil if none is.
+	Rotation *BackingServiceBindingRotation
+}
+
+// BackingServiceBindingRotation replaces the binding at the broker with a new one. The
+// credentials of the new binding replace the old ones in a single update of the bound
+// resource, and the old binding is only unbound once a DeploymentConfig is deployed
+// with the new credentials. Other kinds have no deployment to wait for.
+type BackingServiceBindingRotation struct {
+	StartTime unversioned.Time
+	// BindUuid is the id of the new binding at the service broker, until its
+	// credentials are injected.
+	BindUuid string
+	// RetiredBindUuid and RetiredCredentialsSecret are the old binding, once the
+	// credentials of the new one are injected.
+	RetiredBindUuid          string
+	RetiredCredentialsSecret string
+	// LatestVersion is the version of the bound DeploymentConfig before the credentials
+	// were swapped, a later version has to be deployed.
+	LatestVersion int
+}
+
+type BackingServiceBindingConditionType string
+
+const (
+	// BackingServiceBindingReady is true once the credentials are injected into the bound resource.
+	BackingServiceBindingReady BackingServiceBindingConditionType = "Ready"
+)
+
+type BackingServiceBindingCondition struct {
+	Type               BackingServiceBindingConditionType
+	Status             kapi.ConditionStatus
+	LastTransitionTime unversioned.Time
+	// Reason is a one word CamelCase reason for the last transition.
+	Reason  string
+	Message string
+}
+
+// BackingServiceUsage records a period a BackingServiceInstance was provisioned with
+// a plan. Usages are kept once the instance is deleted, for the cost reports of its
+// project. They are maintained by the metering controller.
+type BackingServiceUsage struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// BackingServiceInstanceName and BackingServiceInstanceUID identify the metered instance.
+	BackingServiceInstanceName string
+	BackingServiceInstanceUID  string
+	BackingServiceName         string
+	BackingServicePlanGuid     string
+	BackingServicePlanName     string
+	// Free and Costs are copied from the plan when the period starts.
+	Free  bool
+	Costs []UsageCost
+	// Start is when the instance was first seen provisioned with the plan.
+	Start unversioned.Time
+	// End is when the instance was last seen provisioned with the plan, unset while
+	// it still is.
+	End *unversioned.Time
+}
+
+type BackingServiceUsageList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []BackingServiceUsage
+}
+
+// UsageCost is the cost of a plan in a unit, by currency.
+type UsageCost struct {
+	Amount map[string]float64
+	Unit   string
+}
+
+// UsageOpenLabel is set to "true" on the usages of periods not ended yet.
+const UsageOpenLabel = "asiainfo.io/usage-open"
+
+// BackingServiceUsageReport asks for the costs of the backing service instances of a
+// project over a period. Reports aren't stored, creating one returns it with its
+// status computed from the usages of the project.
+type BackingServiceUsageReport struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	Spec   BackingServiceUsageReportSpec
+	Status BackingServiceUsageReportStatus
+}
+
+type BackingServiceUsageReportSpec struct {
+	// Start is the beginning of the period reported.
+	Start unversioned.Time
+	// End is the end of the period reported, now if unset.
+	End *unversioned.Time
+}
+
+type BackingServiceUsageReportStatus struct {
+	// Plans are the usages of the plans used during the period.
+	Plans []PlanUsage
+	// Total is the cost of all plans, by currency.
+	Total map[string]float64
+}
+
+// PlanUsage is how much a plan was used during the period of a report.
+type PlanUsage struct {
+	BackingServiceName     string
+	BackingServicePlanGuid string
+	BackingServicePlanName string
+	Free                   bool
+	// Instances is how many instances were provisioned with the plan.
+	Instances int
+	// Hours is how long the instances were provisioned with the plan, summed up.
+	Hours float64
+	// Cost is the cost of the plan by currency, from its costs per unit of time.
+	Cost map[string]float64
+	// UnmeteredUnits are the units of the costs of the plan which aren't per unit of
+	// time. Those aren't accounted.
+	UnmeteredUnits []string
+}
+
+// The resources a ResourceQuota can limit BackingServiceInstances with. Instances of a
+// service and of a plan are counted with the resource suffixed by the name of the
+// service, and by the name of the service and the plan, e.g.
+// asiainfo.io/backingserviceinstances.mysql.small.
+const ResourceBackingServiceInstances kapi.ResourceName = "asiainfo.io/backingserviceinstances"
+
+// QuotaResourceOf returns the resource a ResourceQuota limits the instances of service
+// with, or those of its plan if plan isn't empty.
+func QuotaResourceOf(service, plan string) kapi.ResourceName {
+	name := string(ResourceBackingServiceInstances) + "." + service
+	if len(plan) > 0 {
+		name += "." + plan
+	}
+	return kapi.ResourceName(name)
+}
+
+// AllowPaidPlansAnnotation set to "true" on a namespace lets its instances use plans
+// which aren't free.
+const AllowPaidPlansAnnotation = "asiainfo.io/allow-paid-plans"
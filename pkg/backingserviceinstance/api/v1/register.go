@@ -10,6 +10,11 @@ func init() {
 		&BackingServiceInstanceList{},
 		//&BindingRequest{},
 		&BindingRequestOptions{},
+		&BackingServiceBinding{},
+		&BackingServiceBindingList{},
+		&BackingServiceUsage{},
+		&BackingServiceUsageList{},
+		&BackingServiceUsageReport{},
 	)
 }
 
@@ -17,3 +22,8 @@ func (*BackingServiceInstance) IsAnAPIObject()     {}
 func (*BackingServiceInstanceList) IsAnAPIObject() {}
 //func (*BindingRequest) IsAnAPIObject()             {}
 func (*BindingRequestOptions) IsAnAPIObject()      {}
+func (*BackingServiceBinding) IsAnAPIObject()      {}
+func (*BackingServiceBindingList) IsAnAPIObject()  {}
+func (*BackingServiceUsage) IsAnAPIObject()        {}
+func (*BackingServiceUsageList) IsAnAPIObject()    {}
+func (*BackingServiceUsageReport) IsAnAPIObject()  {}
@@ -54,49 +54,84 @@ type InstanceProvisioning struct {
 	BackingService         string            `json:"backingservice, omitempty"`
 	BackingServiceName     string            `json:"backingservice_name, omitempty"`
 	BackingServiceID       string            `json:"backingservice_id, omitempty"`
-	BackingServicePlanGuid string            `json:"backingservice_plan_guid, omitempty"`
-	BackingServicePlanName string            `json:"backingservice_plan_name, omitempty"`
-	Parameters             map[string]string `json:"parameters, omitempty"`
+	BackingServicePlanGuid  string            `json:"backingservice_plan_guid, omitempty"`
+	BackingServicePlanName  string            `json:"backingservice_plan_name, omitempty"`
+	Parameters              map[string]string `json:"parameters, omitempty"`
 }
 */
 
 type InstanceProvisioning struct {
-	DashboardUrl           string            `json:"dashboard_url, omitempty"`
-	BackingServiceName     string            `json:"backingservice_name, omitempty"`
-	BackingServiceSpecID   string            `json:"backingservice_spec_id, omitempty"`
-	BackingServicePlanGuid string            `json:"backingservice_plan_guid, omitempty"`
-	BackingServicePlanName string            `json:"backingservice_plan_name, omitempty"`
-	Parameters             map[string]string `json:"parameters, omitempty"`
+	DashboardUrl            string            `json:"dashboard_url, omitempty"`
+	BackingServiceName      string            `json:"backingservice_name, omitempty"`
+	BackingServiceNamespace string            `json:"backingservice_namespace,omitempty" description:"namespace of the backingservice, the shared openshift namespace if empty"`
+	BackingServiceSpecID    string            `json:"backingservice_spec_id, omitempty"`
+	BackingServicePlanGuid  string            `json:"backingservice_plan_guid, omitempty"`
+	BackingServicePlanName  string            `json:"backingservice_plan_name, omitempty"`
+	Parameters              map[string]string `json:"parameters, omitempty"`
 }
 
 type InstanceBinding struct {
 	BoundTime            *unversioned.Time `json:"bound_time,omitempty"`
 	BindUuid             string            `json:"bind_uuid, omitempty"`
+	BindKind             string            `json:"bind_kind,omitempty"`
 	BindDeploymentConfig string            `json:"bind_deploymentconfig, omitempty"`
 	Credentials          map[string]string `json:"credentials, omitempty"`
+	CredentialsSecret    string            `json:"credentials_secret,omitempty"`
+	Injection            BindingInjection  `json:"injection,omitempty"`
+	MountPath            string            `json:"mount_path,omitempty"`
 }
 
+// BindingInjection is how the credentials of a binding are made available to the bound resource.
+type BindingInjection string
+
+const (
+	BindingInjectionEnv    BindingInjection = "Env"
+	BindingInjectionVolume BindingInjection = "Volume"
+)
+
 type BackingServiceInstanceStatus struct {
 	Phase  BackingServiceInstancePhase  `json:"phase, omitempty"`
 	Action BackingServiceInstanceAction `json:"action, omitempty"`
 
 	LastOperation *LastOperation `json:"last_operation, omitempty"`
+
+	AppliedPlanGuid   string            `json:"applied_plan_guid,omitempty"`
+	AppliedParameters map[string]string `json:"applied_parameters,omitempty" description:"parameters the broker provisioned or last updated the instance with"`
+
+	Deletion *InstanceDeletionStatus `json:"deletion,omitempty" description:"progress of the deletion of the instance"`
+}
+
+// InstanceDeletionStatus is the progress of the deletion of an instance, which waits
+// for its bindings to be unbound before it is deprovisioned.
+type InstanceDeletionStatus struct {
+	RemainingBindings int      `json:"remaining_bindings" description:"bindings left to unbind"`
+	FailedBindings    []string `json:"failed_bindings,omitempty" description:"bindings which failed to unbind, they are retried"`
+	Message           string   `json:"message,omitempty" description:"step the deletion is at"`
 }
 
 type LastOperation struct {
 	State                    string `json:"state"`
 	Description              string `json:"description"`
 	AsyncPollIntervalSeconds int    `json:"async_poll_interval_seconds, omitempty"`
+	Operation                string `json:"operation,omitempty"`
+	Mitigation               bool   `json:"mitigation,omitempty"`
 }
 
+const (
+	LastOperationStateInProgress = "in progress"
+	LastOperationStateSucceeded  = "succeeded"
+	LastOperationStateFailed     = "failed"
+)
+
 type BackingServiceInstancePhase string
 type BackingServiceInstanceAction string
 
 const (
-	BackingServiceInstancePhaseProvisioning BackingServiceInstancePhase = "Provisioning"
-	BackingServiceInstancePhaseUnbound      BackingServiceInstancePhase = "Unbound"
-	BackingServiceInstancePhaseBound        BackingServiceInstancePhase = "Bound"
-	BackingServiceInstancePhaseDeleted      BackingServiceInstancePhase = "Deleted"
+	BackingServiceInstancePhaseProvisioning   BackingServiceInstancePhase = "Provisioning"
+	BackingServiceInstancePhaseUnbound        BackingServiceInstancePhase = "Unbound"
+	BackingServiceInstancePhaseBound          BackingServiceInstancePhase = "Bound"
+	BackingServiceInstancePhaseDeprovisioning BackingServiceInstancePhase = "Deprovisioning"
+	BackingServiceInstancePhaseDeleted        BackingServiceInstancePhase = "Deleted"
 
 	BackingServiceInstanceActionToBind   BackingServiceInstanceAction = "_ToBind"
 	BackingServiceInstanceActionToUnbind BackingServiceInstanceAction = "_ToUnbind"
@@ -111,7 +146,16 @@ const (
 //
 //=====================================================
 
-const BindKind_DeploymentConfig = "DeploymentConfig"
+const (
+	BindKind_DeploymentConfig      = "DeploymentConfig"
+	BindKind_ReplicationController = "ReplicationController"
+	BindKind_PodTemplate           = "PodTemplate"
+	BindKind_Job                   = "Job"
+	BindKind_DaemonSet             = "DaemonSet"
+	BindKind_Deployment            = "Deployment"
+	BindKind_BuildConfig           = "BuildConfig"
+	BindKind_Route                 = "Route"
+)
 
 //type BindingRequest struct {
 //	unversioned.TypeMeta
@@ -128,4 +172,139 @@ type BindingRequestOptions struct {
 	BindKind            string `json:"bindKind, omitempty"`
 	BindResourceVersion string `json:"bindResourceVersion, omitempty"`
 	ResourceName        string `json:"resourceName, omitempty"`
+
+	Injection BindingInjection `json:"injection,omitempty"`
+	MountPath string           `json:"mountPath,omitempty"`
+}
+
+// BackingServiceBinding binds a BackingServiceInstance to a resource of its namespace.
+type BackingServiceBinding struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec   BackingServiceBindingSpec   `json:"spec,omitempty" description:"spec is the instance and the resource to bind"`
+	Status BackingServiceBindingStatus `json:"status,omitempty" description:"status is the state of the binding; read-only"`
+}
+
+type BackingServiceBindingList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackingServiceBinding `json:"items" description:"list of BackingServiceBindings"`
+}
+
+type BackingServiceBindingSpec struct {
+	BackingServiceInstanceName string           `json:"backingServiceInstanceName" description:"the instance to bind"`
+	BindKind                   string           `json:"bindKind" description:"the kind of the bound resource"`
+	ResourceName               string           `json:"resourceName" description:"the name of the bound resource"`
+	Injection                  BindingInjection `json:"injection,omitempty" description:"how the credentials are given to the bound resource, Env or Volume; Volume if not set, Env for BuildConfigs"`
+	MountPath                  string            `json:"mountPath,omitempty" description:"where the credentials are mounted with the Volume injection"`
+	Parameters                 map[string]string `json:"parameters,omitempty" description:"parameters sent to the broker with the bind request, strings or json encoded values"`
+	RotationPeriod             *unversioned.Duration `json:"rotationPeriod,omitempty" description:"how often the credentials are rotated, never if not set"`
+}
+
+// RotateCredentialsAnnotation set on a BackingServiceBinding to a new value, the time
+// for instance, rotates its credentials.
+const RotateCredentialsAnnotation = "asiainfo.io/rotate-credentials"
+
+type BackingServiceBindingPhase string
+
+const (
+	BackingServiceBindingPhasePending   BackingServiceBindingPhase = "Pending"
+	BackingServiceBindingPhaseBound     BackingServiceBindingPhase = "Bound"
+	BackingServiceBindingPhaseUnbinding BackingServiceBindingPhase = "Unbinding"
+	BackingServiceBindingPhaseFailed    BackingServiceBindingPhase = "Failed"
+)
+
+type BackingServiceBindingStatus struct {
+	Phase             BackingServiceBindingPhase       `json:"phase,omitempty" description:"phase of the binding"`
+	BindUuid          string                           `json:"bindUuid,omitempty" description:"id of the binding at the service broker"`
+	BoundTime         *unversioned.Time                `json:"boundTime,omitempty" description:"when the binding was bound"`
+	CredentialsSecret string                           `json:"credentialsSecret,omitempty" description:"the secret holding the credentials of the binding"`
+	Conditions        []BackingServiceBindingCondition `json:"conditions,omitempty" description:"conditions of the binding"`
+
+	RouteServiceURL string `json:"routeServiceUrl,omitempty" description:"the route service the broker returned for a route binding"`
+
+	RotatedTime     *unversioned.Time              `json:"rotatedTime,omitempty" description:"when the credentials were last rotated"`
+	RotationRequest string                         `json:"rotationRequest,omitempty" description:"the value of the rotate-credentials annotation last acted on"`
+	Rotation        *BackingServiceBindingRotation `json:"rotation,omitempty" description:"the rotation of the credentials in progress"`
+}
+
+// BackingServiceBindingRotation replaces the binding at the broker with a new one.
+type BackingServiceBindingRotation struct {
+	StartTime                unversioned.Time `json:"startTime" description:"when the rotation started"`
+	BindUuid                 string           `json:"bindUuid,omitempty" description:"id of the new binding at the service broker, until its credentials are injected"`
+	RetiredBindUuid          string           `json:"retiredBindUuid,omitempty" description:"id of the old binding at the service broker, once the new credentials are injected"`
+	RetiredCredentialsSecret string           `json:"retiredCredentialsSecret,omitempty" description:"the secret holding the credentials of the old binding"`
+	LatestVersion            int              `json:"latestVersion,omitempty" description:"version of the bound deployment config before the credentials were swapped"`
+}
+
+type BackingServiceBindingConditionType string
+
+const (
+	BackingServiceBindingReady BackingServiceBindingConditionType = "Ready"
+)
+
+type BackingServiceBindingCondition struct {
+	Type               BackingServiceBindingConditionType `json:"type" description:"type of the condition"`
+	Status             kapi.ConditionStatus               `json:"status" description:"status of the condition, True, False or Unknown"`
+	LastTransitionTime unversioned.Time                   `json:"lastTransitionTime,omitempty" description:"last time the condition changed"`
+	Reason             string                             `json:"reason,omitempty" description:"one word CamelCase reason for the last transition"`
+	Message            string                             `json:"message,omitempty" description:"human readable details of the last transition"`
+}
+
+type BackingServiceUsage struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	BackingServiceInstanceName string            `json:"backingServiceInstanceName" description:"the metered instance"`
+	BackingServiceInstanceUID  string            `json:"backingServiceInstanceUID" description:"the uid of the metered instance"`
+	BackingServiceName         string            `json:"backingServiceName" description:"the service of the instance"`
+	BackingServicePlanGuid     string            `json:"backingServicePlanGuid" description:"the plan the instance was provisioned with"`
+	BackingServicePlanName     string            `json:"backingServicePlanName,omitempty" description:"the name of the plan"`
+	Free                       bool              `json:"free,omitempty" description:"whether the plan was free"`
+	Costs                      []UsageCost       `json:"costs,omitempty" description:"the costs of the plan"`
+	Start                      unversioned.Time  `json:"start" description:"when the instance was first seen provisioned with the plan"`
+	End                        *unversioned.Time `json:"end,omitempty" description:"when the instance was last seen provisioned with the plan, unset while it still is"`
+}
+
+type BackingServiceUsageList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []BackingServiceUsage `json:"items" description:"list of BackingServiceUsages"`
+}
+
+type UsageCost struct {
+	Amount map[string]float64 `json:"amount" description:"the cost by currency"`
+	Unit   string             `json:"unit" description:"the unit the cost is for"`
+}
+
+type BackingServiceUsageReport struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec   BackingServiceUsageReportSpec   `json:"spec" description:"spec is the period to report"`
+	Status BackingServiceUsageReportStatus `json:"status,omitempty" description:"status is the usage of the period; read-only"`
+}
+
+type BackingServiceUsageReportSpec struct {
+	Start unversioned.Time  `json:"start" description:"beginning of the period reported"`
+	End   *unversioned.Time `json:"end,omitempty" description:"end of the period reported, now if unset"`
+}
+
+type BackingServiceUsageReportStatus struct {
+	Plans []PlanUsage        `json:"plans,omitempty" description:"usage of the plans used during the period"`
+	Total map[string]float64 `json:"total,omitempty" description:"cost of all plans by currency"`
+}
+
+type PlanUsage struct {
+	BackingServiceName     string             `json:"backingServiceName" description:"the service of the plan"`
+	BackingServicePlanGuid string             `json:"backingServicePlanGuid" description:"the plan"`
+	BackingServicePlanName string             `json:"backingServicePlanName,omitempty" description:"the name of the plan"`
+	Free                   bool               `json:"free,omitempty" description:"whether the plan is free"`
+	Instances              int                `json:"instances" description:"how many instances were provisioned with the plan"`
+	Hours                  float64            `json:"hours" description:"how long the instances were provisioned with the plan, summed up"`
+	Cost                   map[string]float64 `json:"cost,omitempty" description:"cost of the plan by currency, from its costs per unit of time"`
+	UnmeteredUnits         []string           `json:"unmeteredUnits,omitempty" description:"units of the costs of the plan which aren't per unit of time, those aren't accounted"`
 }
@@ -1,8 +1,14 @@
 package validation
 
 import (
+	"fmt"
+	"path"
+
 	oapi "github.com/openshift/origin/pkg/api"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	"github.com/openshift/origin/pkg/backingservice/schema"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 )
@@ -34,6 +40,10 @@ func ValidateBackingServiceInstanceUpdate(bsi *backingserviceinstanceapi.Backing
 
 	allErrs = append(allErrs, ValidateBackingServiceInstance(bsi)...)
 
+	if bsi.Spec.BackingServiceNamespace != older.Spec.BackingServiceNamespace {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.backingservice_namespace", bsi.Spec.BackingServiceNamespace, "field is immutable"))
+	}
+
 	// todo:
 	//if older.Status != bsi.Status {
 	//	allErrs = append(allErrs, fielderrors.NewFieldInvalid("Status", bsi.Status.Phase, "status cannot be updated from a terminal state"))
@@ -41,6 +51,115 @@ func ValidateBackingServiceInstanceUpdate(bsi *backingserviceinstanceapi.Backing
 	return allErrs
 }
 
+// ValidateBackingServiceInstancePlanUpdate validates a plan change of a BackingServiceInstance
+// against the BackingService it is provisioned from.
+func ValidateBackingServiceInstancePlanUpdate(bsi *backingserviceinstanceapi.BackingServiceInstance, older *backingserviceinstanceapi.BackingServiceInstance, bs *backingserviceapi.BackingService) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	planGuid := bsi.Spec.BackingServicePlanGuid
+	if planGuid == older.Spec.BackingServicePlanGuid || planGuid == bsi.Status.AppliedPlanGuid {
+		return allErrs
+	}
+
+	if older.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning && older.Spec.InstanceID != "" {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.backingservice_plan_guid", planGuid, "plan cannot be changed while the instance is provisioning"))
+		return allErrs
+	}
+
+	if !bs.Spec.PlanUpdateable {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.backingservice_plan_guid", planGuid, fmt.Sprintf("backingservice %s doesn't support plan changes", bs.Name)))
+		return allErrs
+	}
+
+	return append(allErrs, validatePlan(planGuid, bs)...)
+}
+
+// ValidateBackingServiceInstancePlan validates the plan and parameters of a new
+// BackingServiceInstance against the BackingService it is provisioned from, as
+// visible to its namespace.
+func ValidateBackingServiceInstancePlan(bsi *backingserviceinstanceapi.BackingServiceInstance, bs *backingserviceapi.BackingService) fielderrors.ValidationErrorList {
+	if len(bsi.Spec.BackingServicePlanGuid) == 0 {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldRequired("spec.backingservice_plan_guid")}
+	}
+	allErrs := validatePlan(bsi.Spec.BackingServicePlanGuid, bs)
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+	plan := findPlan(bs, bsi.Spec.BackingServicePlanGuid)
+	return validateParameters("spec.parameters", backingserviceinstanceapi.ParametersOf(bsi), plan.Schemas.InstanceCreate)
+}
+
+// ValidateBackingServiceInstanceParametersUpdate validates changed parameters of a
+// BackingServiceInstance against the update schema of its plan, or the create one if
+// it isn't provisioned yet.
+func ValidateBackingServiceInstanceParametersUpdate(bsi *backingserviceinstanceapi.BackingServiceInstance, older *backingserviceinstanceapi.BackingServiceInstance, bs *backingserviceapi.BackingService) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	params := backingserviceinstanceapi.ParametersOf(bsi)
+	if backingserviceinstanceapi.ParametersEqual(params, backingserviceinstanceapi.ParametersOf(older)) ||
+		backingserviceinstanceapi.ParametersEqual(params, bsi.Status.AppliedParameters) {
+		return allErrs
+	}
+
+	if older.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning && older.Spec.InstanceID != "" {
+		return append(allErrs, fielderrors.NewFieldInvalid("spec.parameters", "[omitted]", "parameters cannot be changed while the instance is provisioning"))
+	}
+
+	plan := findPlan(bs, bsi.Spec.BackingServicePlanGuid)
+	if plan == nil {
+		return append(allErrs, fielderrors.NewFieldNotFound("spec.backingservice_plan_guid", bsi.Spec.BackingServicePlanGuid))
+	}
+	if older.Spec.InstanceID == "" {
+		return validateParameters("spec.parameters", params, plan.Schemas.InstanceCreate)
+	}
+	return validateParameters("spec.parameters", params, plan.Schemas.InstanceUpdate)
+}
+
+// ValidateBackingServiceBindingParameters validates the parameters of a binding
+// against the binding schema of the plan of its instance.
+func ValidateBackingServiceBindingParameters(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, bs *backingserviceapi.BackingService) fielderrors.ValidationErrorList {
+	plan := findPlan(bs, bsi.Spec.BackingServicePlanGuid)
+	if plan == nil {
+		return fielderrors.ValidationErrorList{}
+	}
+	return validateParameters("spec.parameters", binding.Spec.Parameters, plan.Schemas.BindingCreate)
+}
+
+func findPlan(bs *backingserviceapi.BackingService, planGuid string) *backingserviceapi.ServicePlan {
+	for i := range bs.Spec.Plans {
+		if bs.Spec.Plans[i].Id == planGuid {
+			return &bs.Spec.Plans[i]
+		}
+	}
+	return nil
+}
+
+func validatePlan(planGuid string, bs *backingserviceapi.BackingService) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	plan := findPlan(bs, planGuid)
+	if plan == nil {
+		return append(allErrs, fielderrors.NewFieldNotFound("spec.backingservice_plan_guid", planGuid))
+	}
+	if plan.Deprecated {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.backingservice_plan_guid", planGuid, fmt.Sprintf("plan %s is deprecated", plan.Name)))
+	}
+	return allErrs
+}
+
+// validateParameters checks params against a json encoded JSON schema.
+func validateParameters(field string, params map[string]string, schemaData string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	s, err := schema.Parse(schemaData)
+	if err != nil {
+		return append(allErrs, fielderrors.NewFieldInvalid(field, "[omitted]", err.Error()))
+	}
+	_, errs := s.Convert(params)
+	for _, err := range errs {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid(fmt.Sprintf("%s[%s]", field, err.Name), err.Value, err.Reason))
+	}
+	return allErrs
+}
+
 //==========================================
 
 //func ValidateBackingServiceInstanceBindingRequest(bi *BindingRequest) fielderrors.ValidationErrorList {
@@ -52,7 +171,160 @@ func ValidateBackingServiceInstanceUpdate(bsi *backingserviceinstanceapi.Backing
 func ValidateBackingServiceInstanceBindingRequestOptions(o *backingserviceinstanceapi.BindingRequestOptions) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
 	allErrs = append(allErrs, validation.ValidateObjectMeta(&o.ObjectMeta, true, oapi.MinimalNameRequirements).Prefix("metadata")...)
+	// the binding made of the options gets the default injection.
+	injection := o.Injection
+	if len(injection) == 0 {
+		injection = backingserviceinstanceapi.DefaultBindingInjection(o.BindKind)
+	}
+	allErrs = append(allErrs, validateBindKind(o.BindKind, injection)...)
+	if len(o.ResourceName) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("resourceName"))
+	}
+	allErrs = append(allErrs, validateBindingInjection(injection, o.MountPath)...)
 	return allErrs
 }
 
+func validateBindKind(kind string, injection backingserviceinstanceapi.BindingInjection) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	supported := false
+	for _, k := range backingserviceinstanceapi.BindKinds {
+		if kind == k {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return append(allErrs, fielderrors.NewFieldValueNotSupported("bindKind", kind, backingserviceinstanceapi.BindKinds))
+	}
+
+	// builds have no pod template to mount the credentials in.
+	if kind == backingserviceinstanceapi.BindKind_BuildConfig && injection == backingserviceinstanceapi.BindingInjectionVolume {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("injection", injection, "BuildConfigs only support the Env injection"))
+	}
+	// nor have routes, which get no credentials.
+	if kind == backingserviceinstanceapi.BindKind_Route && injection == backingserviceinstanceapi.BindingInjectionVolume {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("injection", injection, "Routes get no credentials to mount"))
+	}
 
+	return allErrs
+}
+
+func validateBindingInjection(injection backingserviceinstanceapi.BindingInjection, mountPath string) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	switch injection {
+	case "", backingserviceinstanceapi.BindingInjectionEnv:
+		if len(mountPath) > 0 {
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid("mountPath", mountPath, "may only be set with the Volume injection"))
+		}
+	case backingserviceinstanceapi.BindingInjectionVolume:
+		if len(mountPath) > 0 && !path.IsAbs(mountPath) {
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid("mountPath", mountPath, "must be an absolute path"))
+		}
+	default:
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("injection", injection,
+			[]string{string(backingserviceinstanceapi.BindingInjectionEnv), string(backingserviceinstanceapi.BindingInjectionVolume)}))
+	}
+
+	return allErrs
+}
+
+
+
+// ValidateBackingServiceBinding tests required fields for a BackingServiceBinding.
+func ValidateBackingServiceBinding(binding *backingserviceinstanceapi.BackingServiceBinding) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMeta(&binding.ObjectMeta, true, oapi.MinimalNameRequirements).Prefix("metadata")...)
+	allErrs = append(allErrs, validateBackingServiceBindingSpec(&binding.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateBackingServiceBindingUpdate tests an update of a BackingServiceBinding, whose spec can't change
+// but for its rotation period.
+func ValidateBackingServiceBindingUpdate(binding *backingserviceinstanceapi.BackingServiceBinding, older *backingserviceinstanceapi.BackingServiceBinding) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&binding.ObjectMeta, &older.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateBackingServiceBinding(binding)...)
+
+	// only the rotation period may change.
+	spec := binding.Spec
+	spec.RotationPeriod = older.Spec.RotationPeriod
+	if !kapi.Semantic.DeepEqual(spec, older.Spec) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec", "[omitted]", "field is immutable"))
+	}
+	return allErrs
+}
+
+func validateBackingServiceBindingSpec(spec *backingserviceinstanceapi.BackingServiceBindingSpec) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	if len(spec.BackingServiceInstanceName) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("backingServiceInstanceName"))
+	}
+	allErrs = append(allErrs, validateBindKind(spec.BindKind, spec.Injection)...)
+	if len(spec.ResourceName) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("resourceName"))
+	}
+	allErrs = append(allErrs, validateBindingInjection(spec.Injection, spec.MountPath)...)
+	if spec.RotationPeriod != nil && spec.RotationPeriod.Duration <= 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("rotationPeriod", spec.RotationPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+// ValidateBackingServiceUsage tests required fields for a BackingServiceUsage.
+func ValidateBackingServiceUsage(usage *backingserviceinstanceapi.BackingServiceUsage) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMeta(&usage.ObjectMeta, true, oapi.MinimalNameRequirements).Prefix("metadata")...)
+
+	if len(usage.BackingServiceInstanceName) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("backingServiceInstanceName"))
+	}
+	if len(usage.BackingServiceInstanceUID) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("backingServiceInstanceUID"))
+	}
+	if len(usage.BackingServicePlanGuid) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("backingServicePlanGuid"))
+	}
+	if usage.Start.IsZero() {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("start"))
+	}
+	if usage.End != nil && usage.End.Time.Before(usage.Start.Time) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("end", usage.End, "must not be before start"))
+	}
+	return allErrs
+}
+
+// ValidateBackingServiceUsageUpdate tests an update of a BackingServiceUsage, which may
+// only end its period.
+func ValidateBackingServiceUsageUpdate(usage *backingserviceinstanceapi.BackingServiceUsage, older *backingserviceinstanceapi.BackingServiceUsage) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&usage.ObjectMeta, &older.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateBackingServiceUsage(usage)...)
+
+	if older.End != nil && (usage.End == nil || !usage.End.Time.Equal(older.End.Time)) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("end", usage.End, "field is immutable once set"))
+	}
+	ended := *usage
+	ended.ObjectMeta = older.ObjectMeta
+	ended.End = older.End
+	if !kapi.Semantic.DeepEqual(&ended, older) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("usage", "[omitted]", "only end may be changed"))
+	}
+	return allErrs
+}
+
+// ValidateBackingServiceUsageReport tests the period of a BackingServiceUsageReport.
+func ValidateBackingServiceUsageReport(report *backingserviceinstanceapi.BackingServiceUsageReport) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	allErrs = append(allErrs, validation.ValidateObjectMeta(&report.ObjectMeta, true, oapi.MinimalNameRequirements).Prefix("metadata")...)
+	if report.Spec.Start.IsZero() {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("spec.start"))
+	}
+	if report.Spec.End != nil && !report.Spec.End.Time.After(report.Spec.Start.Time) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.end", report.Spec.End, "must be after start"))
+	}
+	return allErrs
+}
@@ -3,24 +3,22 @@ package controller
 import (
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 
-	"bytes"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"github.com/golang/glog"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
 	osclient "github.com/openshift/origin/pkg/client"
-	"io/ioutil"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	"golang.org/x/net/context"
 	kapi "k8s.io/kubernetes/pkg/api"
-	"k8s.io/kubernetes/pkg/api/unversioned"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
-	"k8s.io/kubernetes/pkg/fields"
-	"k8s.io/kubernetes/pkg/labels"
-	"k8s.io/kubernetes/pkg/util"
-	"net/http"
+	kutil "k8s.io/kubernetes/pkg/util"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // NamespaceController is responsible for participating in Kubernetes Namespace termination
@@ -30,7 +28,18 @@ type BackingServiceInstanceController struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
-	recorder   record.EventRecorder
+	// ServiceBrokerClientFunc returns a client of a ServiceBroker.
+	ServiceBrokerClientFunc servicebrokerclient.ClientFunc
+	// Binders inject credentials into the resources instances are bound to, keyed by BindKind.
+	Binders  map[string]Binder
+	recorder record.EventRecorder
+
+	// nextPoll records when the in progress broker operation of an instance should be polled next.
+	nextPoll map[string]time.Time
+	// deprovisionBackoff delays the deprovisioning retries of instances the broker failed to deprovision.
+	deprovisionBackoff *kutil.Backoff
+	// enqueueAfter requeues an instance to be handled again after the given delay.
+	enqueueAfter func(bsi *backingserviceinstanceapi.BackingServiceInstance, after time.Duration)
 }
 
 type fatalError string
@@ -46,11 +55,18 @@ func (c *BackingServiceInstanceController) Handle(bsi *backingserviceinstanceapi
 
 	changed := false
 
-	bs, err := c.Client.BackingServices("openshift").Get(bsi.Spec.BackingServiceName)
+	bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
 	if err != nil {
 		return err
 	}
 
+	if hasLegacyBindings(bsi) {
+		if err := c.migrateBindings(bsi); err != nil {
+			return err
+		}
+		changed = true
+	}
+
 	switch bsi.Status.Phase {
 	default:
 
@@ -60,7 +76,13 @@ func (c *BackingServiceInstanceController) Handle(bsi *backingserviceinstanceapi
 
 		glog.Infoln("bsi delete etcd ", bsi.Name)
 
-		result = c.Client.BackingServiceInstances(bsi.Namespace).Delete(bsi.Name)
+		if result = c.deleteCredentialsSecrets(bsi); result != nil {
+			break
+		}
+
+		if result = c.Client.BackingServiceInstances(bsi.Namespace).Delete(bsi.Name); result == nil {
+			c.forget(bsi)
+		}
 
 	case "":
 
@@ -71,18 +93,46 @@ func (c *BackingServiceInstanceController) Handle(bsi *backingserviceinstanceapi
 		fallthrough
 
 	case backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning:
+		if lastOperationInProgress(bsi) && bsi.Status.LastOperation.Mitigation {
+			changed, result = c.pollMitigation(bs, bsi)
+			break
+		}
+		if lastOperationInProgress(bsi) {
+			polled := false
+			if polled, result = c.pollProvisioning(bs, bsi); polled {
+				changed = true
+			}
+			break
+		}
+
 		if bsi.Status.Action == backingserviceinstanceapi.BackingServiceInstanceActionToDelete {
-			return c.Client.BackingServiceInstances(bsi.Namespace).Delete(bsi.Name)
+			if bsi.Spec.InstanceID == "" {
+				return c.Client.BackingServiceInstances(bsi.Namespace).Delete(bsi.Name)
+			}
+
+			// the broker may still hold a half built instance, deprovision it first.
+			if result = c.deleteInstance(bs, bsi); result == nil {
+				changed = true
+			}
+			c.recorder.Eventf(bsi, "Deleting", "instance:%s [%v]", bsi.Name, changed)
+			break
+		}
+
+		if bsi.Status.LastOperation != nil && bsi.Status.LastOperation.State == backingserviceinstanceapi.LastOperationStateFailed {
+			// provisioning failed at the broker, wait for the instance to be deleted.
+			break
 		}
 
 		glog.Infoln("bsi provisioning ", bsi.Name)
 		//c.recorder.Eventf(bsi, "Provisioning", "bsi:%s, service:%s", bsi.Name, bsi.Spec.BackingServiceName)
 
 		plan_found := false
+		plan_deprecated := false
 		for _, plan := range bs.Spec.Plans {
 			if bsi.Spec.BackingServicePlanGuid == plan.Id {
 				bsi.Spec.BackingServicePlanName = plan.Name
 				plan_found = true
+				plan_deprecated = plan.Deprecated
 				break
 			}
 		}
@@ -95,86 +145,94 @@ func (c *BackingServiceInstanceController) Handle(bsi *backingserviceinstanceapi
 			break
 		}
 
-		// ...
-
-		glog.Infoln("bsi provisioning servicebroker_load, ", bsi.Name)
-		//c.recorder.Eventf(bsi, "Provisioning", "bsi %s provisioning servicebroker_load", bsi.Name)
-		bsInstanceID := string(util.NewUUID())
+		if plan_deprecated || bs.Status.Phase == backingserviceapi.BackingServicePhaseDeprecated {
+			result = fatalError(fmt.Sprintf("plan (%s) in bs(%s) for bsi (%s) is deprecated",
+				bsi.Spec.BackingServicePlanGuid, bsi.Spec.BackingServiceName, bsi.Name))
+			break
+		}
 
-		servicebroker, err := servicebroker_load(c.Client, bs.GenerateName)
+		parameters, err := brokerParameters(findServicePlan(bs, bsi.Spec.BackingServicePlanGuid).Schemas.InstanceCreate, backingserviceinstanceapi.ParametersOf(bsi))
 		if err != nil {
-			result = err
+			result = fatalError(fmt.Sprintf("parameters of bsi (%s) are invalid: %v", bsi.Name, err))
 			break
 		}
 
-		serviceinstance := &ServiceInstance{}
-		serviceinstance.ServiceId = bs.Spec.Id
-		serviceinstance.PlanId = bsi.Spec.BackingServicePlanGuid
-		serviceinstance.OrganizationGuid = bsi.Namespace
-
-		glog.Infoln("bsi provisioning servicebroker_create_instance, ", bsi.Name)
+		glog.Infoln("bsi provisioning servicebroker_client, ", bsi.Name)
 
-		svcinstance, err := servicebroker_create_instance(serviceinstance, bsInstanceID, servicebroker)
+		sbclient, err := c.servicebroker_client(bs)
 		if err != nil {
 			result = err
-			c.recorder.Eventf(bsi, "Provisioning", err.Error())
 			break
-		} else {
-			c.recorder.Eventf(bsi, "Provisioning", "bsi provisioning done, instanceid: %s", bsInstanceID)
-			glog.Infoln("bsi provisioning servicebroker_create_instance done, ", bsi.Name)
 		}
 
-		bsi.Spec.DashboardUrl = svcinstance.DashboardUrl
-		bsi.Spec.InstanceID = bsInstanceID
-		bsi.Spec.BackingServiceSpecID = bs.Spec.Id
-		if bsi.Spec.Parameters == nil {
-			bsi.Spec.Parameters = make(map[string]string)
+		if result = c.reserveInstanceID(bs, bsi); result != nil {
+			break
+		}
+		bsInstanceID := bsi.Spec.InstanceID
+
+		glog.Infoln("bsi provisioning servicebroker provision, ", bsi.Name)
+
+		ctx, cancel := brokerContext()
+		svcinstance, err := sbclient.Provision(ctx, bsInstanceID, &servicebrokerclient.ProvisionRequest{
+			ServiceID:         bs.Spec.Id,
+			PlanID:            bsi.Spec.BackingServicePlanGuid,
+			OrganizationGUID:  bsi.Namespace,
+			Parameters:        parameters,
+			AcceptsIncomplete: true,
+		})
+		cancel()
+		if err != nil {
+			result = err
+			c.recorder.Event(bsi, "Provisioning", err.Error())
+			if servicebrokerclient.IsAmbiguous(err) {
+				changed = c.mitigateInstance(sbclient, bs, bsi)
+			}
+			break
 		}
-		bsi.Spec.Parameters["instance_id"] = bsInstanceID
 
-		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
+		bsi.Spec.DashboardUrl = svcinstance.DashboardURL
+		bsi.Status.AppliedParameters = copyParameters(backingserviceinstanceapi.ParametersOf(bsi))
 
 		changed = true
 
-		glog.Infoln("bsi inited. ", bsi.Name)
+		if svcinstance.Async {
+			bsi.Status.LastOperation = newLastOperation(svcinstance.Operation, svcinstance.LastOperation)
+			c.pollAfter(bsi)
+			c.recorder.Eventf(bsi, "Provisioning", "bsi provisioning accepted by broker, instanceid: %s", bsInstanceID)
+			glog.Infoln("bsi provisioning in progress, ", bsi.Name)
+			break
+		}
 
-	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound:
-		switch bsi.Status.Action {
-		case backingserviceinstanceapi.BackingServiceInstanceActionToDelete:
+		c.recorder.Eventf(bsi, "Provisioning", "bsi provisioning done, instanceid: %s", bsInstanceID)
+		glog.Infoln("bsi provisioning servicebroker provision done, ", bsi.Name)
 
-			if result = c.deleteInstance(bs, bsi); result == nil {
-				changed = true
-			}
-			c.recorder.Eventf(bsi, "Deleting", "instance:%s [%v]", bsi.Name, changed)
-		case backingserviceinstanceapi.BackingServiceInstanceActionToBind:
+		bsi.Status.LastOperation = nil
+		bsi.Status.AppliedPlanGuid = bsi.Spec.BackingServicePlanGuid
+		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
 
-			dcname := c.get_deploymentconfig_name(bsi, backingserviceinstanceapi.BindDeploymentConfigBinding)
-			if result = c.bindInstance(dcname, bs, bsi); result == nil {
-				changed = true
-			}
-			c.recorder.Eventf(bsi, "Binding", "instance: %s, dc: %s [%v]", bsi.Name, dcname, changed)
+		glog.Infoln("bsi inited. ", bsi.Name)
 
+	case backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning:
+		if lastOperationInProgress(bsi) {
+			changed, result = c.pollDeprovisioning(bs, bsi)
+			break
 		}
-	case backingserviceinstanceapi.BackingServiceInstancePhaseBound:
-		switch bsi.Status.Action {
-		case backingserviceinstanceapi.BackingServiceInstanceActionToUnbind:
-			dcname := c.get_deploymentconfig_name(bsi, backingserviceinstanceapi.BindDeploymentConfigUnbinding)
-			if result = c.unbindInstance(dcname, bs, bsi); result == nil {
-				changed = true
-			}
-			c.recorder.Eventf(bsi, "Unbinding", "instance: %s, dc: %s [%v]", bsi.Name, dcname, changed)
-		case backingserviceinstanceapi.BackingServiceInstanceActionToBind:
-			dcname := c.get_deploymentconfig_name(bsi, backingserviceinstanceapi.BindDeploymentConfigBinding)
-			if result = c.bindInstance(dcname, bs, bsi); result == nil {
-				changed = true
-			}
-			c.recorder.Eventf(bsi, "Binding", "instance: %s, dc: %s [%v]", bsi.Name, dcname, changed)
-			/*
-				default:
-					return fmt.Errorf("action '%s' should never happen under status '%s'", bsi.Status.Action, bsi.Status.Phase)
-			*/
+
+		// the broker failed to deprovision the instance, ask it again.
+		changed, result = c.retryDeprovisioning(bs, bsi)
+
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+		// bindings are handled by the BackingServiceBinding controller.
+		if bsi.Status.Action == backingserviceinstanceapi.BackingServiceInstanceActionToDelete {
+			var deleted bool
+			deleted, result = c.deleteWithBindings(bs, bsi)
+			changed = changed || deleted
+			break
 		}
 
+		if updatePending(bsi) {
+			changed, result = c.updateInstance(bs, bsi)
+		}
 	}
 
 	if result != nil {
@@ -189,7 +247,7 @@ func (c *BackingServiceInstanceController) Handle(bsi *backingserviceinstanceapi
 		*/
 
 		glog.Infoln("bsi controller error. ", err_msg)
-		c.recorder.Eventf(bsi, "Error", err_msg)
+		c.recorder.Event(bsi, "Error", err_msg)
 	}
 
 	if changed {
@@ -218,273 +276,29 @@ func remove_action_word(text, word backingserviceinstanceapi.BackingServiceInsta
 	return text
 }
 
-func servicebroker_load(c osclient.Interface, name string) (*ServiceBroker, error) {
-	servicebroker := &ServiceBroker{}
-	if sb, err := c.ServiceBrokers().Get(name); err != nil {
-		return nil, err
-	} else {
-		servicebroker.Url = sb.Spec.Url
-		servicebroker.UserName = sb.Spec.UserName
-		servicebroker.Password = sb.Spec.Password
-		return servicebroker, nil
-	}
-}
-
-func checkIfPlanidExist(client osclient.Interface, planId string) (bool, *backingserviceapi.BackingService, error) {
-
-	items, err := client.BackingServices("openshift").List(labels.Everything(), fields.Everything())
-
-	if err != nil {
-		return false, nil, err
-	}
-
-	for _, bs := range items.Items {
-		for _, plans := range bs.Spec.Plans {
-			if planId == plans.Id {
-				glog.Info("we found plan id at plan", bs.Spec.Name)
-
-				return true, &bs, nil
-			}
-		}
-	}
-	return false, nil, fatalError(fmt.Sprintf("Can't find plan id %s", planId))
-
-}
-
-func commToServiceBroker(method, path string, jsonData []byte, header map[string]string) (resp *http.Response, err error) {
-
-	fmt.Println(method, path, string(jsonData))
-
-	req, err := http.NewRequest(strings.ToUpper(method) /*SERVICE_BROKER_API_SERVER+*/, path, bytes.NewBuffer(jsonData))
-
-	if len(header) > 0 {
-		for key, value := range header {
-			req.Header.Set(key, value)
+// servicebroker_client returns a client of the broker offering bs, a project broker
+// for the BackingServices of a project.
+func (c *BackingServiceInstanceController) servicebroker_client(bs *backingserviceapi.BackingService) (servicebrokerclient.Interface, error) {
+	if bs.Namespace != backingserviceapi.BackingServiceSharedNamespace {
+		psb, err := c.Client.ProjectServiceBrokers(bs.Namespace).Get(bs.GenerateName)
+		if err != nil {
+			return nil, err
 		}
+		return c.ServiceBrokerClientFunc(servicebrokerapi.ServiceBrokerOf(psb))
 	}
-	return http.DefaultClient.Do(req)
-}
-
-type ServiceBroker struct {
-	Url      string
-	UserName string
-	Password string
-}
-
-type ServiceInstance struct {
-	ServiceId        string `json:"service_id"`
-	PlanId           string `json:"plan_id"`
-	OrganizationGuid string `json:"organization_guid"`
-	SpaceGuid        string `json:"space_guid"`
-	//Incomplete       bool        `json:"accepts_incomplete, omitempty"`
-	Parameters interface{} `json:"parameters, omitempty"`
-}
-type LastOperation struct {
-	State                    string `json:"state"`
-	Description              string `json:"description"`
-	AsyncPollIntervalSeconds int    `json:"async_poll_interval_seconds, omitempty"`
-}
-type CreateServiceInstanceResponse struct {
-	DashboardUrl  string         `json:"dashboard_url"`
-	LastOperation *LastOperation `json:"last_operation, omitempty"`
-}
-
-type ServiceBinding struct {
-	ServiceId       string                 `json:"service_id"`
-	PlanId          string                 `json:"plan_id"`
-	AppGuid         string                 `json:"app_guid,omitempty"`
-	BindResource    map[string]string      `json:"bind_resource,omitempty"`
-	Parameters      map[string]interface{} `json:"parameters,omitempty"`
-	svc_instance_id string
-}
-
-type ServiceBindingResponse struct {
-	Credentials     Credential `json:"credentials"`
-	SyslogDrainUrl  string     `json:"syslog_drain_url"`
-	RouteServiceUrl string     `json:"route_service_url"`
-}
-
-type Credential struct {
-	Uri      string `json:"uri"`
-	Name     string `json:"name"`
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Host     string `json:"host"`
-	Port     string `json:"port"`
-	Vhost    string `json:"vhost"`
-	//Database string `json:"database"`
-}
-
-func servicebroker_create_instance(param *ServiceInstance, instance_guid string, sb *ServiceBroker) (*CreateServiceInstanceResponse, error) {
-	jsonData, err := json.Marshal(param)
-	if err != nil {
-		return nil, err
-	}
-
-	header := make(map[string]string)
-	header["Content-Type"] = "application/json"
-	header["Authorization"] = basicAuthStr(sb.UserName, sb.Password)
-
-	resp, err := commToServiceBroker("PUT", "http://"+sb.Url+"/v2/service_instances/"+instance_guid, jsonData, header)
-	if err != nil {
-		glog.Error(err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	glog.Infof("respcode from /v2/service_instances/%s: %v", instance_guid, resp.StatusCode)
 
-	body, err := ioutil.ReadAll(resp.Body)
+	sb, err := c.Client.ServiceBrokers().Get(bs.GenerateName)
 	if err != nil {
-		glog.Error(err)
 		return nil, err
 	}
-	svcinstance := &CreateServiceInstanceResponse{}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated ||
-		resp.StatusCode == http.StatusAccepted {
-
-		if len(body) > 0 {
-			err = json.Unmarshal(body, svcinstance)
-
-			if err != nil {
-				glog.Error(err)
-				return nil, err
-			}
-		}
-	} else {
-		return nil, fmt.Errorf("%d returned from broker %s", resp.StatusCode, sb.Url)
-	}
-	glog.Infof("%v,%+v\n", string(body), svcinstance)
-	return svcinstance, nil
+	return c.ServiceBrokerClientFunc(sb)
 }
 
-func servicebroker_binding(param *ServiceBinding, binding_guid string, sb *ServiceBroker) (*ServiceBindingResponse, error) {
-	jsonData, err := json.Marshal(param)
-	if err != nil {
-		return nil, err
-	}
-
-	header := make(map[string]string)
-	header["Content-Type"] = "application/json"
-	header["Authorization"] = basicAuthStr(sb.UserName, sb.Password)
-
-	resp, err := commToServiceBroker("PUT", "http://"+sb.Url+"/v2/service_instances/"+param.svc_instance_id+"/service_bindings/"+binding_guid, jsonData, header)
-	if err != nil {
-
-		glog.Error(err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	glog.Infof("respcode from PUT /v2/service_instances/%s/service_bindings/%s: %v", param.svc_instance_id, binding_guid, resp.StatusCode)
+// brokerRequestTimeout bounds every request to a broker.
+const brokerRequestTimeout = 60 * time.Second
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		glog.Error(err)
-		return nil, err
-	}
-	svcBinding := &ServiceBindingResponse{}
-
-	glog.Infof("%v,%+v\n", string(body), svcBinding)
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
-		if len(body) > 0 {
-			err = json.Unmarshal(body, svcBinding)
-
-			if err != nil {
-				glog.Error(err)
-				return nil, err
-			}
-		}
-	}
-
-	return svcBinding, nil
-}
-
-func servicebroker_unbinding(bindId string, svc *ServiceBinding, sb *ServiceBroker) (interface{}, error) {
-
-	header := make(map[string]string)
-	header["Content-Type"] = "application/json"
-	header["Authorization"] = basicAuthStr(sb.UserName, sb.Password)
-
-	resp, err := commToServiceBroker("DELETE", "http://"+sb.Url+"/v2/service_instances/"+svc.svc_instance_id+"/service_bindings/"+bindId+"?service_id="+svc.ServiceId+"&plan_id="+svc.PlanId, nil, header)
-	if err != nil {
-
-		glog.Error(err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	glog.Infof("respcode from DELETE /v2/service_instances/%s/service_bindings/%s: %v", svc.svc_instance_id, bindId, resp.StatusCode)
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		glog.Error(err)
-		return nil, err
-	}
-	type UnBindindResp struct {
-		Response interface{}
-	}
-	svcUnbinding := &UnBindindResp{}
-
-	if resp.StatusCode == http.StatusOK {
-		if len(body) > 0 {
-			err = json.Unmarshal(body, svcUnbinding)
-
-			if err != nil {
-				glog.Error(err)
-				return nil, err
-			}
-		}
-	}
-	glog.Infof("%v,%+v\n", string(body), svcUnbinding)
-	return svcUnbinding, nil
-}
-
-func servicebroker_deprovisioning(bsi *backingserviceinstanceapi.BackingServiceInstance, sb *ServiceBroker) (interface{}, error) {
-
-	header := make(map[string]string)
-	header["Content-Type"] = "application/json"
-	header["Authorization"] = basicAuthStr(sb.UserName, sb.Password)
-
-	resp, err := commToServiceBroker("DELETE", "http://"+sb.Url+"/v2/service_instances/"+bsi.Spec.InstanceID+"?service_id="+bsi.Spec.BackingServiceSpecID+"&plan_id="+bsi.Spec.BackingServicePlanGuid, nil, header)
-	if err != nil {
-
-		glog.Error(err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	glog.Infof("respcode from DELETE /v2/service_instances/%s: %v", bsi.Spec.InstanceID, resp.StatusCode)
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		glog.Error(err)
-		return nil, err
-	}
-	type DeprovisioningResp struct {
-		Response interface{}
-	}
-	svcDeprovisioning := &DeprovisioningResp{}
-
-	if resp.StatusCode == http.StatusOK {
-		if len(body) > 0 {
-			err = json.Unmarshal(body, svcDeprovisioning)
-
-			if err != nil {
-				glog.Error(err)
-				return nil, err
-			}
-		}
-	}
-	glog.Infof("%v,%+v\n", string(body), svcDeprovisioning)
-	return svcDeprovisioning, nil
-}
-
-func basicAuthStr(username, password string) string {
-	auth := username + ":" + password
-	authstr := base64.StdEncoding.EncodeToString([]byte(auth))
-	return "Basic " + authstr
+func brokerContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), brokerRequestTimeout)
 }
 
 var InvalidCharFinder = regexp.MustCompile("[^a-zA-Z0-9]")
@@ -497,12 +311,25 @@ func deploymentconfig_env_name(prefix string, envName string) string {
 	return strings.ToUpper(fmt.Sprintf("%s%s", prefix, InvalidCharFinder.ReplaceAllLiteralString(envName, "_")))
 }
 
-func (c *BackingServiceInstanceController) deploymentconfig_inject_envs(dc string, bsi *backingserviceinstanceapi.BackingServiceInstance, b *backingserviceinstanceapi.InstanceBinding) error {
-	return c.deploymentconfig_modify_envs(dc, bsi, b, true)
+func (c *BackingServiceInstanceController) inject_envs(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, credentials map[string]interface{}) error {
+	return c.modify_envs(binding, bsi, nil, nonNilCredentials(credentials))
 }
 
-func (c *BackingServiceInstanceController) deploymentconfig_clear_envs(dc string, bsi *backingserviceinstanceapi.BackingServiceInstance, b *backingserviceinstanceapi.InstanceBinding) error {
-	return c.deploymentconfig_modify_envs(dc, bsi, b, false)
+func (c *BackingServiceInstanceController) clear_envs(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, credentials map[string]interface{}) error {
+	return c.modify_envs(binding, bsi, credentials, nil)
+}
+
+// swap_envs replaces the credentials old by new in a single update of the bound resource.
+func (c *BackingServiceInstanceController) swap_envs(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, old, new map[string]interface{}) error {
+	return c.modify_envs(binding, bsi, old, nonNilCredentials(new))
+}
+
+// nonNilCredentials returns credentials, empty ones if a broker returned none.
+func nonNilCredentials(credentials map[string]interface{}) map[string]interface{} {
+	if credentials == nil {
+		return map[string]interface{}{}
+	}
+	return credentials
 }
 
 // return exists or not
@@ -553,17 +380,10 @@ func env_unset(envs []kapi.EnvVar, envName string) (bool, []kapi.EnvVar) {
 	return index < n, envs[:index]
 }
 
-func (c *BackingServiceInstanceController) deploymentconfig_modify_envs(dcname string, bsi *backingserviceinstanceapi.BackingServiceInstance, binding *backingserviceinstanceapi.InstanceBinding, toInject bool) error {
-	dc, err := c.Client.DeploymentConfigs(bsi.Namespace).Get(dcname)
-	if err != nil {
-		return err
-	}
-
-	if dc.Spec.Template == nil {
-		return nil
-	}
-
-	bs, err := c.Client.BackingServices("openshift").Get(bsi.Spec.BackingServiceName)
+// modify_envs removes the credentials cleared from the environments of the bound
+// resource, then sets the credentials injected unless they are nil.
+func (c *BackingServiceInstanceController) modify_envs(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, cleared, injected map[string]interface{}) error {
+	bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
 	if err != nil {
 		return err
 	}
@@ -576,45 +396,45 @@ func (c *BackingServiceInstanceController) deploymentconfig_modify_envs(dcname s
 	}
 
 	env_prefix := deploymentconfig_env_prefix(bsi.Name)
-	containers := dc.Spec.Template.Spec.Containers
 
-	if toInject {
+	err = c.modifyTarget(binding, bsi, func(target *BindTarget) error {
+		for _, env := range target.Envs {
+			for k := range cleared {
+				_, *env = env_unset(*env, deploymentconfig_env_name(env_prefix, k))
+			}
+			if injected == nil {
+				_, *env = modifyVcapServicesEnvNameEnv(*env, bs.Name, nil, bsi.Name)
+			}
+		}
+		if injected == nil {
+			return nil
+		}
+
 		var vsp *VcapServiceParameters = nil
 		if plan != nil {
 			vsp = &VcapServiceParameters{
 				Name:        bsi.Name,
 				Label:       "",
 				Plan:        plan.Name,
-				Credentials: binding.Credentials,
+				Credentials: injected,
 			}
 		}
 
-		for i := range containers {
-			for k, v := range binding.Credentials {
-				_, containers[i].Env = env_set(containers[i].Env, deploymentconfig_env_name(env_prefix, k), v)
+		for _, env := range target.Envs {
+			for k, v := range injected {
+				_, *env = env_set(*env, deploymentconfig_env_name(env_prefix, k), credentialValue(v))
 			}
 
 			if vsp != nil {
-				_, containers[i].Env = modifyVcapServicesEnvNameEnv(containers[i].Env, bs.Name, vsp, "")
+				_, *env = modifyVcapServicesEnvNameEnv(*env, bs.Name, vsp, "")
 			}
 		}
-	} else {
-		for i := range containers {
-			for k := range binding.Credentials {
-				_, containers[i].Env = env_unset(containers[i].Env, deploymentconfig_env_name(env_prefix, k))
-			}
-
-			_, containers[i].Env = modifyVcapServicesEnvNameEnv(containers[i].Env, bs.Name, nil, bsi.Name)
-		}
-	}
-
-	if _, err := c.Client.DeploymentConfigs(bsi.Namespace).Update(dc); err != nil {
-		return err
+		return nil
+	})
+	if injected == nil && kerrors.IsNotFound(err) {
+		return nil
 	}
-
-	c.deploymentconfig_print_envs(bsi.Namespace, binding)
-
-	return nil
+	return err
 }
 
 func modifyVcapServicesEnvNameEnv(env []kapi.EnvVar, bsName string, vsp *VcapServiceParameters, bsiName string) (bool, []kapi.EnvVar) {
@@ -646,7 +466,7 @@ func modifyVcapServicesEnvNameEnv(env []kapi.EnvVar, bsName string, vsp *VcapSer
 
 	json_env = string(json_data)
 
-	glog.Infof("new ", VcapServicesEnvName, " = ", json_env)
+	glog.Infoln("new ", VcapServicesEnvName, " = ", json_env)
 
 	return env_set(env, VcapServicesEnvName, json_env)
 }
@@ -656,10 +476,10 @@ const VcapServicesEnvName = "VCAP_SERVICES"
 type VcapServices map[string][]*VcapServiceParameters
 
 type VcapServiceParameters struct {
-	Name        string            `json:"name, omitempty"`
-	Label       string            `json:"label, omitempty"`
-	Plan        string            `json:"plan, omitempty"`
-	Credentials map[string]string `json:"credentials, omitempty"`
+	Name        string                 `json:"name, omitempty"`
+	Label       string                 `json:"label, omitempty"`
+	Plan        string                 `json:"plan, omitempty"`
+	Credentials map[string]interface{} `json:"credentials, omitempty"`
 }
 
 func addVcapServiceParameters(vs VcapServices, serviceName string, vsParameters *VcapServiceParameters) VcapServices {
@@ -709,184 +529,40 @@ func removeVcapServiceParameters(vs VcapServices, serviceName, instanceName stri
 	return vs
 }
 
-func (c *BackingServiceInstanceController) deploymentconfig_print_envs(ns string, binding *backingserviceinstanceapi.InstanceBinding) {
-	dc, err := c.Client.DeploymentConfigs(ns).Get(binding.BindDeploymentConfig)
-	if err != nil {
-		fmt.Println("dc not found: ", binding.BindDeploymentConfig)
-		return
-	}
-
-	if dc.Spec.Template == nil {
-		fmt.Println("dc.Spec.Template is nil")
-		return
-	}
-
-	containers := dc.Spec.Template.Spec.Containers
-
-	for _, c := range containers {
-		fmt.Println("**********  envs in container")
-
-		for _, env := range c.Env {
-			fmt.Println("     env[", env.Name, ",] = ", env.Value)
-		}
-	}
-}
-
 func (c *BackingServiceInstanceController) deleteInstance(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (result error) {
 	glog.Infoln("bsi to delete ", bsi.Name)
 
-	servicebroker, err := servicebroker_load(c.Client, bs.GenerateName)
+	sbclient, err := c.servicebroker_client(bs)
 	if err != nil {
 		return err
-
 	}
 
 	glog.Infoln("deleting ", bsi.Name)
-	if _, err = servicebroker_deprovisioning(bsi, servicebroker); err != nil {
-		return err
-
-	}
-
-	glog.Infoln("bsi deleted ", bsi.Name)
-
-	bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseDeleted
-
-	bsi.Status.Action = remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToDelete)
-	return
-
-}
-
-func (c *BackingServiceInstanceController) bindInstance(dc string, bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (result error) {
-	glog.Infoln("bsi to bind ", bsi.Name, " and ", dc)
-
-	servicebroker, err := servicebroker_load(c.Client, bs.GenerateName)
+	ctx, cancel := brokerContext()
+	defer cancel()
+	resp, err := sbclient.Deprovision(ctx, bsi.Spec.InstanceID, &servicebrokerclient.DeprovisionRequest{
+		ServiceID:         bsi.Spec.BackingServiceSpecID,
+		PlanID:            bsi.Spec.BackingServicePlanGuid,
+		AcceptsIncomplete: true,
+	})
 	if err != nil {
 		return err
 	}
 
-	bind_uuid := string(util.NewUUID())
-
-	servicebinding := &ServiceBinding{
-		ServiceId: bs.Spec.Id,
-		PlanId:    bsi.Spec.BackingServicePlanGuid,
-		AppGuid:   bsi.Namespace,
-		//BindResource: ,
-		//Parameters: ,
-		svc_instance_id: bsi.Spec.InstanceID,
-	}
-
-	glog.Infoln("bsi to bind", bsi.Name)
-
-	bindingresponse, err := servicebroker_binding(servicebinding, bind_uuid, servicebroker)
-	if err != nil {
-		return err
-	}
-
-	instanceBinding := backingserviceinstanceapi.InstanceBinding{}
-	now := unversioned.Now()
-	instanceBinding.BoundTime = &now //&unversioned.Now()
-	instanceBinding.BindUuid = bind_uuid
-	instanceBinding.BindDeploymentConfig = dc
-	instanceBinding.Credentials = make(map[string]string)
-	instanceBinding.Credentials["Uri"] = bindingresponse.Credentials.Uri
-	instanceBinding.Credentials["Name"] = bindingresponse.Credentials.Name
-	instanceBinding.Credentials["Username"] = bindingresponse.Credentials.Username
-	instanceBinding.Credentials["Password"] = bindingresponse.Credentials.Password
-	instanceBinding.Credentials["Host"] = bindingresponse.Credentials.Host
-	instanceBinding.Credentials["Port"] = bindingresponse.Credentials.Port
-	instanceBinding.Credentials["Vhost"] = bindingresponse.Credentials.Vhost
-	// = bindingresponse.SyslogDrainUrl
-	// = bindingresponse.RouteServiceUrl
-
-	glog.Infoln("deploymentconfig_inject_envs")
-
-	err = c.deploymentconfig_inject_envs(dc, bsi, &instanceBinding)
-	if err != nil {
-		return err
-	} else {
-		bsi.Spec.Binding = append(bsi.Spec.Binding, instanceBinding)
-	}
+	if resp.Async {
+		glog.Infoln("bsi deprovisioning in progress ", bsi.Name)
 
-	glog.Infoln("bsi bound. ", bsi.Name)
-
-	bsi.Spec.Bound += 1
-
-	bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseBound
-	bsi.Annotations[dc] = backingserviceinstanceapi.BindDeploymentConfigBound
-
-	bsi.Status.Action = "" //remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToBind)
-	return
-
-}
-
-func (c *BackingServiceInstanceController) unbindInstance(dc string, bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (result error) {
-
-	glog.Infoln("bsi to unbind ", bsi.Name)
-
-	servicebroker, err := servicebroker_load(c.Client, bs.GenerateName)
-	if err != nil {
-		return err
+		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning
+		bsi.Status.LastOperation = newLastOperation(resp.Operation, nil)
+		c.pollAfter(bsi)
+		return
 	}
 
-	glog.Infoln("servicebroker_unbinding")
-
-	svc := &ServiceBinding{}
-
-	svc.PlanId = bsi.Spec.BackingServicePlanGuid
-	svc.ServiceId = bsi.Spec.BackingServiceSpecID
-	svc.svc_instance_id = bsi.Spec.InstanceID
-
-	var bindId string
-
-	for idx, b := range bsi.Spec.Binding {
-		if b.BindDeploymentConfig == dc {
-			bindId = b.BindUuid
-			_, err = servicebroker_unbinding(bindId, svc, servicebroker)
-			if err != nil {
-				return err
-			}
-			glog.Infoln("deploymentconfig_clear_envs")
-			err = c.deploymentconfig_clear_envs(dc, bsi, &b)
-			if err != nil {
-				return err
-			} else {
-				bsi.Spec.Binding = append(bsi.Spec.Binding[:idx], bsi.Spec.Binding[idx+1:]...)
-				delete(bsi.Annotations, dc)
-			}
-			break
-		}
-	}
-	/*
-		err = c.deploymentconfig_clear_envs(bsi.Namespace, dc)
-		if err != nil {
-			return err
-		}
-	*/
-	glog.Infoln("bsi is unbound ", bsi.Name)
-	/*
-		delete(bsi.Annotations, dc)
-		bsi.Spec.BindDeploymentConfig = ""
-		bsi.Spec.Credentials = nil
-		bsi.Spec.BoundTime = nil
-		bsi.Spec.BindUuid = ""
-		bsi.Spec.Bound = false
-	*/
-	bsi.Spec.Bound -= 1
-	if bsi.Spec.Bound == 0 {
-		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
-	}
+	glog.Infoln("bsi deleted ", bsi.Name)
 
-	bsi.Status.Action = "" //remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToUnbind)
+	bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseDeleted
 
+	bsi.Status.Action = remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToDelete)
 	return
 
 }
-
-func (c *BackingServiceInstanceController) get_deploymentconfig_name(bsi *backingserviceinstanceapi.BackingServiceInstance, stat string) string {
-	for dc, bound := range bsi.Annotations {
-		if bound == stat {
-			return dc
-		}
-	}
-	return ""
-}
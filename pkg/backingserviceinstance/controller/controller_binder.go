@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"fmt"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// BindTarget is the part of a bound resource the credentials of a binding are injected into.
+type BindTarget struct {
+	// PodSpec is where the credentials are mounted, nil for resources without a pod template.
+	PodSpec *kapi.PodSpec
+	// Envs are the environments the credentials are set in.
+	Envs []*[]kapi.EnvVar
+	// Annotations point a Route to the route service of the binding, nil for other kinds.
+	Annotations *map[string]string
+}
+
+// Binder reads and writes the resources of one BindKind.
+type Binder interface {
+	// Modify gets the named resource, passes its BindTarget to fn and saves the
+	// resource if fn succeeds.
+	Modify(namespace, name string, fn func(*BindTarget) error) error
+}
+
+// BinderFunc lets a function be used as a Binder.
+type BinderFunc func(namespace, name string, fn func(*BindTarget) error) error
+
+func (f BinderFunc) Modify(namespace, name string, fn func(*BindTarget) error) error {
+	return f(namespace, name, fn)
+}
+
+// NewBinders returns the Binders of all the BindKinds, keyed by BindKind.
+func NewBinders(client osclient.Interface, kubeClient kclient.Interface) map[string]Binder {
+	return map[string]Binder{
+		backingserviceinstanceapi.BindKind_DeploymentConfig: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			dc, err := client.DeploymentConfigs(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if dc.Spec.Template == nil {
+				return nil
+			}
+			if err := fn(podTemplateTarget(dc.Spec.Template)); err != nil {
+				return err
+			}
+			_, err = client.DeploymentConfigs(namespace).Update(dc)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_ReplicationController: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			rc, err := kubeClient.ReplicationControllers(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if rc.Spec.Template == nil {
+				return nil
+			}
+			if err := fn(podTemplateTarget(rc.Spec.Template)); err != nil {
+				return err
+			}
+			_, err = kubeClient.ReplicationControllers(namespace).Update(rc)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_PodTemplate: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			template, err := kubeClient.PodTemplates(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if err := fn(podTemplateTarget(&template.Template)); err != nil {
+				return err
+			}
+			_, err = kubeClient.PodTemplates(namespace).Update(template)
+			return err
+		}),
+		// apiservers that keep the template of a Job immutable reject the update,
+		// the binding is then taken back and marked Failed.
+		backingserviceinstanceapi.BindKind_Job: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			job, err := kubeClient.Extensions().Jobs(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if err := fn(podTemplateTarget(&job.Spec.Template)); err != nil {
+				return err
+			}
+			_, err = kubeClient.Extensions().Jobs(namespace).Update(job)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_DaemonSet: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			ds, err := kubeClient.Extensions().DaemonSets(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if ds.Spec.Template == nil {
+				return nil
+			}
+			if err := fn(podTemplateTarget(ds.Spec.Template)); err != nil {
+				return err
+			}
+			_, err = kubeClient.Extensions().DaemonSets(namespace).Update(ds)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_Deployment: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			d, err := kubeClient.Extensions().Deployments(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if d.Spec.Template == nil {
+				return nil
+			}
+			if err := fn(podTemplateTarget(d.Spec.Template)); err != nil {
+				return err
+			}
+			_, err = kubeClient.Extensions().Deployments(namespace).Update(d)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_BuildConfig: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			bc, err := client.BuildConfigs(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			target := &BindTarget{}
+			strategy := &bc.Spec.Strategy
+			switch {
+			case strategy.SourceStrategy != nil:
+				target.Envs = append(target.Envs, &strategy.SourceStrategy.Env)
+			case strategy.DockerStrategy != nil:
+				target.Envs = append(target.Envs, &strategy.DockerStrategy.Env)
+			case strategy.CustomStrategy != nil:
+				target.Envs = append(target.Envs, &strategy.CustomStrategy.Env)
+			}
+			if err := fn(target); err != nil {
+				return err
+			}
+			_, err = client.BuildConfigs(namespace).Update(bc)
+			return err
+		}),
+		backingserviceinstanceapi.BindKind_Route: BinderFunc(func(namespace, name string, fn func(*BindTarget) error) error {
+			route, err := client.Routes(namespace).Get(name)
+			if err != nil {
+				return err
+			}
+			if err := fn(&BindTarget{Annotations: &route.Annotations}); err != nil {
+				return err
+			}
+			_, err = client.Routes(namespace).Update(route)
+			return err
+		}),
+	}
+}
+
+// podTemplateTarget returns the BindTarget of a pod template, the environments of all
+// its containers.
+func podTemplateTarget(template *kapi.PodTemplateSpec) *BindTarget {
+	spec := &template.Spec
+	target := &BindTarget{PodSpec: spec}
+	for i := range spec.Containers {
+		target.Envs = append(target.Envs, &spec.Containers[i].Env)
+	}
+	return target
+}
+
+// modifyTarget modifies the resource bound by binding with fn, and points a Route to
+// the route service of binding in the same update, or away from it once binding is
+// deleted.
+func (c *BackingServiceInstanceController) modifyTarget(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, fn func(*BindTarget) error) error {
+	binder, err := c.binder(binding.Spec.BindKind)
+	if err != nil {
+		return err
+	}
+	return binder.Modify(bsi.Namespace, binding.Spec.ResourceName, func(target *BindTarget) error {
+		if err := fn(target); err != nil {
+			return err
+		}
+		if target.Annotations == nil {
+			return nil
+		}
+
+		key, value := routeapi.RouteServiceURLAnnotation, binding.Status.RouteServiceURL
+		annotations := *target.Annotations
+		if len(value) == 0 || !binding.DeletionTimestamp.IsZero() {
+			delete(annotations, key)
+			return nil
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+		*target.Annotations = annotations
+		return nil
+	})
+}
+
+// binder returns the Binder of the resources of kind, DeploymentConfigs if kind is empty.
+func (c *BackingServiceInstanceController) binder(kind string) (Binder, error) {
+	if len(kind) == 0 {
+		kind = backingserviceinstanceapi.BindKind_DeploymentConfig
+	}
+	binder, ok := c.Binders[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported bind kind: %s", kind)
+	}
+	return binder, nil
+}
@@ -0,0 +1,531 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// HandleBinding binds a new BackingServiceBinding, rotates the credentials of a bound
+// one and unbinds a deleted one.
+func (c *BackingServiceInstanceController) HandleBinding(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	if !binding.DeletionTimestamp.IsZero() {
+		return c.unbind(binding)
+	}
+
+	switch binding.Status.Phase {
+	case "", backingserviceinstanceapi.BackingServiceBindingPhasePending:
+		return c.bind(binding)
+	case backingserviceinstanceapi.BackingServiceBindingPhaseBound:
+		return c.rotate(binding)
+	}
+	return nil
+}
+
+// bind asks the broker for the credentials of a binding and injects them into the bound resource.
+func (c *BackingServiceInstanceController) bind(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	bsiName := binding.Spec.BackingServiceInstanceName
+
+	bsi, err := c.Client.BackingServiceInstances(binding.Namespace).Get(bsiName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return c.bindingFailed(binding, "InstanceNotFound", fmt.Sprintf("instance %s not found", bsiName))
+		}
+		return err
+	}
+
+	switch bsi.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+	case backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning, backingserviceinstanceapi.BackingServiceInstancePhaseDeleted:
+		return c.bindingFailed(binding, "InstanceDeleted", fmt.Sprintf("instance %s is being deleted", bsiName))
+	default:
+		// the binding is handled again when it is resynced.
+		return c.bindingPending(binding, "InstanceNotReady", fmt.Sprintf("instance %s is %s", bsiName, bsi.Status.Phase))
+	}
+	if !bsi.DeletionTimestamp.IsZero() {
+		return c.bindingFailed(binding, "InstanceDeleted", fmt.Sprintf("instance %s is being deleted", bsiName))
+	}
+
+	bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		return err
+	}
+
+	var bindingSchema string
+	if plan := findServicePlan(bs, bsi.Spec.BackingServicePlanGuid); plan != nil {
+		bindingSchema = plan.Schemas.BindingCreate
+	}
+	parameters, err := brokerParameters(bindingSchema, binding.Spec.Parameters)
+	if err != nil {
+		return c.bindingFailed(binding, "InvalidParameters", err.Error())
+	}
+	// bindings of kinds that are no longer supported were admitted by older masters.
+	if _, err := c.binder(binding.Spec.BindKind); err != nil {
+		return c.bindingFailed(binding, "UnsupportedBindKind", err.Error())
+	}
+	if binding.Spec.BindKind == backingserviceinstanceapi.BindKind_Route && !offersRouteServices(bs) {
+		return c.bindingFailed(binding, "RouteServicesUnsupported", fmt.Sprintf("backingservice %s offers no route services", bs.Name))
+	}
+	bindResource, err := c.bindResource(binding)
+	if err != nil {
+		return err
+	}
+
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return err
+	}
+
+	glog.Infoln("bsb to bind ", binding.Name)
+
+	if err := c.reserveBindingID(binding); err != nil {
+		return err
+	}
+	bindUuid := binding.Status.BindUuid
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+	resp, err := sbclient.Bind(ctx, bsi.Spec.InstanceID, bindUuid, &servicebrokerclient.BindRequest{
+		ServiceID:    bs.Spec.Id,
+		PlanID:       bsi.Spec.BackingServicePlanGuid,
+		AppGUID:      bsi.Namespace,
+		BindResource: bindResource,
+		Parameters:   parameters,
+	})
+	if err != nil {
+		c.recorder.Eventf(binding, "Binding", "broker failed to bind: %v", err)
+		if servicebrokerclient.IsAmbiguous(err) {
+			c.mitigateBinding(sbclient, bs, bsi, binding)
+		}
+		if err := c.bindingPending(binding, "BindFailed", err.Error()); err != nil {
+			glog.Errorf("failed to update binding %s: %v", binding.Name, err)
+		}
+		return err
+	}
+
+	secret, err := c.createCredentialsSecret(bsi, bindUuid, resp.Credentials)
+	if err != nil {
+		return err
+	}
+	binding.Status.CredentialsSecret = secret.Name
+	c.setBindingServices(binding, resp)
+
+	if binding.Spec.Injection == backingserviceinstanceapi.BindingInjectionVolume {
+		err = c.mount_credentials(binding, bsi)
+	} else {
+		err = c.inject_envs(binding, bsi, resp.Credentials)
+	}
+	if err != nil {
+		c.recorder.Eventf(binding, "Binding", "failed to inject credentials into %s %s: %v", binding.Spec.BindKind, binding.Spec.ResourceName, err)
+		return c.abandonBinding(sbclient, bs, bsi, binding, err)
+	}
+
+	now := unversioned.Now()
+	binding.Status.Phase = backingserviceinstanceapi.BackingServiceBindingPhaseBound
+	binding.Status.BoundTime = &now
+	setBindingCondition(binding, kapi.ConditionTrue, "Bound", "")
+
+	if _, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding); err != nil {
+		return err
+	}
+	c.recorder.Eventf(binding, "Bound", "instance %s bound to %s %s", bsiName, binding.Spec.BindKind, binding.Spec.ResourceName)
+
+	return c.updateBound(bsi.Namespace, bsiName)
+}
+
+// abandonBinding takes back a binding the broker made but whose credentials could
+// not be injected: the broker unbinds it, its secret is deleted and it is marked
+// Failed. Binding is retried with the same id if the broker fails to unbind.
+func (c *BackingServiceInstanceController) abandonBinding(sbclient servicebrokerclient.Interface, bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance, binding *backingserviceinstanceapi.BackingServiceBinding, injectErr error) error {
+	if err := c.mitigateBinding(sbclient, bs, bsi, binding); err != nil {
+		return err
+	}
+	if err := c.deleteCredentialsSecret(bsi.Namespace, binding.Status.CredentialsSecret); err != nil {
+		return err
+	}
+	binding.Status.BindUuid = ""
+	binding.Status.CredentialsSecret = ""
+	return c.bindingFailed(binding, "InjectionFailed", injectErr.Error())
+}
+
+// unbind removes the credentials of a deleted binding from the bound resource,
+// asks the broker to unbind and deletes the binding for good.
+func (c *BackingServiceInstanceController) unbind(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	bsiName := binding.Spec.BackingServiceInstanceName
+
+	bsi, err := c.Client.BackingServiceInstances(binding.Namespace).Get(bsiName)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	if err == nil && len(binding.Status.BindUuid) > 0 {
+		glog.Infoln("bsb to unbind ", binding.Name)
+
+		bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+		if err != nil {
+			return err
+		}
+
+		sbclient, err := c.servicebroker_client(bs)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := brokerContext()
+		err = sbclient.Unbind(ctx, bsi.Spec.InstanceID, binding.Status.BindUuid, &servicebrokerclient.UnbindRequest{
+			ServiceID: bsi.Spec.BackingServiceSpecID,
+			PlanID:    bsi.Spec.BackingServicePlanGuid,
+		})
+		cancel()
+		if err != nil {
+			c.recorder.Eventf(binding, "Unbinding", "broker failed to unbind: %v", err)
+			return c.unbindFailed(binding, err)
+		}
+
+		// a binding whose bind never completed has no credentials to take back.
+		if len(binding.Status.CredentialsSecret) == 0 {
+			glog.Infoln("bsb unbound before being bound ", binding.Name)
+		} else if binding.Spec.Injection == backingserviceinstanceapi.BindingInjectionVolume {
+			err = c.unmount_credentials(binding, bsi)
+		} else {
+			var credentials map[string]interface{}
+			if credentials, err = c.bindingCredentials(binding); err != nil {
+				return err
+			}
+			err = c.clear_envs(binding, bsi, credentials)
+		}
+		if err != nil {
+			return c.unbindFailed(binding, err)
+		}
+	} else if err != nil {
+		glog.Warningf("instance %s of binding %s is gone, credentials are left in %s %s", bsiName, binding.Name, binding.Spec.BindKind, binding.Spec.ResourceName)
+	}
+
+	if err := c.abandonRotation(binding); err != nil {
+		return c.unbindFailed(binding, err)
+	}
+	if err := c.deleteCredentialsSecret(binding.Namespace, binding.Status.CredentialsSecret); err != nil {
+		return err
+	}
+
+	if err := c.Client.BackingServiceBindings(binding.Namespace).Delete(binding.Name); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	c.recorder.Eventf(binding, "Unbound", "instance %s unbound from %s %s", bsiName, binding.Spec.BindKind, binding.Spec.ResourceName)
+
+	return c.updateBound(binding.Namespace, bsiName)
+}
+
+// offersRouteServices returns true if the broker of bs may return route services.
+func offersRouteServices(bs *backingserviceapi.BackingService) bool {
+	for _, requirement := range bs.Spec.Requires {
+		if requirement == backingserviceinstanceapi.RouteForwardingRequirement {
+			return true
+		}
+	}
+	return false
+}
+
+// bindResource returns the resource bound by binding as the broker knows it, the host
+// of a Route. Other kinds are only known by the namespace sent as the app guid.
+func (c *BackingServiceInstanceController) bindResource(binding *backingserviceinstanceapi.BackingServiceBinding) (map[string]string, error) {
+	if binding.Spec.BindKind != backingserviceinstanceapi.BindKind_Route {
+		return nil, nil
+	}
+	route, err := c.Client.Routes(binding.Namespace).Get(binding.Spec.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"route": route.Spec.Host}, nil
+}
+
+// setBindingServices records the route service and the syslog drain the broker
+// returned for binding, they are set on the bound resource along with the credentials.
+func (c *BackingServiceInstanceController) setBindingServices(binding *backingserviceinstanceapi.BackingServiceBinding, resp *servicebrokerclient.BindResponse) {
+	binding.Status.RouteServiceURL = ""
+	if len(resp.RouteServiceURL) > 0 {
+		if binding.Spec.BindKind == backingserviceinstanceapi.BindKind_Route {
+			binding.Status.RouteServiceURL = resp.RouteServiceURL
+		} else {
+			c.recorder.Eventf(binding, "Binding", "route service %s ignored, only Routes are forwarded through route services", resp.RouteServiceURL)
+		}
+	}
+	if len(resp.SyslogDrainURL) > 0 {
+		c.recorder.Eventf(binding, "Binding", "syslog drain %s ignored, logs are not forwarded to syslog drains", resp.SyslogDrainURL)
+	}
+}
+
+// unbindFailed records why a binding failed to unbind, and returns err for the
+// unbind to be retried.
+func (c *BackingServiceInstanceController) unbindFailed(binding *backingserviceinstanceapi.BackingServiceBinding, err error) error {
+	if updateErr := c.updateBindingStatus(binding, backingserviceinstanceapi.BackingServiceBindingPhaseUnbinding, unbindFailedReason, err.Error()); updateErr != nil {
+		glog.Errorf("failed to record the unbind failure of bsb %s: %v", binding.Name, updateErr)
+	}
+	return err
+}
+
+// bindingPending records why a binding can't be bound yet.
+func (c *BackingServiceInstanceController) bindingPending(binding *backingserviceinstanceapi.BackingServiceBinding, reason, message string) error {
+	return c.updateBindingStatus(binding, backingserviceinstanceapi.BackingServiceBindingPhasePending, reason, message)
+}
+
+// bindingFailed records why a binding will never be bound.
+func (c *BackingServiceInstanceController) bindingFailed(binding *backingserviceinstanceapi.BackingServiceBinding, reason, message string) error {
+	c.recorder.Event(binding, "Failed", message)
+	return c.updateBindingStatus(binding, backingserviceinstanceapi.BackingServiceBindingPhaseFailed, reason, message)
+}
+
+func (c *BackingServiceInstanceController) updateBindingStatus(binding *backingserviceinstanceapi.BackingServiceBinding, phase backingserviceinstanceapi.BackingServiceBindingPhase, reason, message string) error {
+	if binding.Status.Phase == phase && readyCondition(binding, kapi.ConditionFalse, reason, message) {
+		return nil
+	}
+	binding.Status.Phase = phase
+	setBindingCondition(binding, kapi.ConditionFalse, reason, message)
+	_, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding)
+	return err
+}
+
+// readyCondition returns true if the Ready condition of binding is already as given.
+func readyCondition(binding *backingserviceinstanceapi.BackingServiceBinding, status kapi.ConditionStatus, reason, message string) bool {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == backingserviceinstanceapi.BackingServiceBindingReady {
+			return condition.Status == status && condition.Reason == reason && condition.Message == message
+		}
+	}
+	return false
+}
+
+// setBindingCondition sets the Ready condition of binding.
+func setBindingCondition(binding *backingserviceinstanceapi.BackingServiceBinding, status kapi.ConditionStatus, reason, message string) {
+	now := unversioned.Now()
+	for i := range binding.Status.Conditions {
+		condition := &binding.Status.Conditions[i]
+		if condition.Type != backingserviceinstanceapi.BackingServiceBindingReady {
+			continue
+		}
+		if condition.Status != status {
+			condition.LastTransitionTime = now
+		}
+		condition.Status = status
+		condition.Reason = reason
+		condition.Message = message
+		return
+	}
+	binding.Status.Conditions = append(binding.Status.Conditions, backingserviceinstanceapi.BackingServiceBindingCondition{
+		Type:               backingserviceinstanceapi.BackingServiceBindingReady,
+		Status:             status,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// instanceBindings lists the bindings of the instance name.
+func (c *BackingServiceInstanceController) instanceBindings(namespace, name string) (*backingserviceinstanceapi.BackingServiceBindingList, error) {
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: name})
+	return c.Client.BackingServiceBindings(namespace).List(selector, fields.Everything())
+}
+
+// updateBound records on an instance how many resources are bound to it.
+func (c *BackingServiceInstanceController) updateBound(namespace, name string) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		bsi, err := c.Client.BackingServiceInstances(namespace).Get(name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		bindings, err := c.instanceBindings(namespace, name)
+		if err != nil {
+			return err
+		}
+		// the bindings made through the service broker facade have no BackingServiceBinding.
+		bound := len(backingserviceinstanceapi.ExternalBindings(bsi))
+		for i := range bindings.Items {
+			if bindings.Items[i].Status.Phase == backingserviceinstanceapi.BackingServiceBindingPhaseBound && bindings.Items[i].DeletionTimestamp.IsZero() {
+				bound++
+			}
+		}
+
+		phase := bsi.Status.Phase
+		switch phase {
+		case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+			phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
+			if bound > 0 {
+				phase = backingserviceinstanceapi.BackingServiceInstancePhaseBound
+			}
+		}
+		if bsi.Spec.Bound == bound && bsi.Status.Phase == phase {
+			return nil
+		}
+
+		bsi.Spec.Bound = bound
+		bsi.Status.Phase = phase
+		_, err = c.Client.BackingServiceInstances(namespace).Update(bsi)
+		return err
+	})
+}
+
+// hasLegacyBindings returns true if bsi still tracks its bindings in its spec and
+// annotations, as it did before BackingServiceBindings.
+func hasLegacyBindings(bsi *backingserviceinstanceapi.BackingServiceInstance) bool {
+	if len(bsi.Spec.Binding) > 0 {
+		return true
+	}
+	for _, state := range bsi.Annotations {
+		if isLegacyBindingState(state) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLegacyBindingState(state string) bool {
+	switch state {
+	case backingserviceinstanceapi.BindDeploymentConfigBinding,
+		backingserviceinstanceapi.BindDeploymentConfigUnbinding,
+		backingserviceinstanceapi.BindDeploymentConfigBound:
+		return true
+	}
+	return false
+}
+
+// migrateBindings turns the bindings tracked by bsi into BackingServiceBindings.
+// Bound ones are created Bound, the pending ones are left for the binding controller
+// and the ones being unbound are deleted right after being created.
+func (c *BackingServiceInstanceController) migrateBindings(bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	glog.Infoln("bsi migrating bindings ", bsi.Name)
+
+	migrated := map[string]bool{}
+	for i := range bsi.Spec.Binding {
+		b := &bsi.Spec.Binding[i]
+		kind := b.BindKind
+		if len(kind) == 0 {
+			kind = backingserviceinstanceapi.BindKind_DeploymentConfig
+		}
+		key := backingserviceinstanceapi.BindingAnnotationKey(kind, b.BindDeploymentConfig)
+		migrated[key] = true
+
+		binding := newBinding(bsi, kind, b.BindDeploymentConfig)
+		binding.Spec.Injection = b.Injection
+		if len(binding.Spec.Injection) == 0 {
+			// legacy bindings were all injected in the environment.
+			binding.Spec.Injection = backingserviceinstanceapi.BindingInjectionEnv
+		}
+		binding.Spec.MountPath = b.MountPath
+
+		if len(b.BindUuid) > 0 {
+			binding.Status.Phase = backingserviceinstanceapi.BackingServiceBindingPhaseBound
+			binding.Status.BindUuid = b.BindUuid
+			binding.Status.BoundTime = b.BoundTime
+			binding.Status.CredentialsSecret = b.CredentialsSecret
+			if len(b.CredentialsSecret) == 0 {
+				credentials := make(map[string]interface{}, len(b.Credentials))
+				for k, v := range b.Credentials {
+					credentials[k] = v
+				}
+				secret, err := c.createCredentialsSecret(bsi, b.BindUuid, credentials)
+				if err != nil && !kerrors.IsAlreadyExists(err) {
+					return err
+				}
+				binding.Status.CredentialsSecret = credentialsSecretName(bsi, b.BindUuid)
+				if secret != nil {
+					binding.Status.CredentialsSecret = secret.Name
+				}
+			}
+			setBindingCondition(binding, kapi.ConditionTrue, "Migrated", "")
+		}
+
+		if err := c.createMigratedBinding(binding, bsi.Annotations[key] == backingserviceinstanceapi.BindDeploymentConfigUnbinding); err != nil {
+			return err
+		}
+	}
+
+	// resources annotated as binding before the pending bindings were recorded in the spec.
+	for key, state := range bsi.Annotations {
+		if state != backingserviceinstanceapi.BindDeploymentConfigBinding || migrated[key] {
+			continue
+		}
+		kind, name := backingserviceinstanceapi.ParseBindingAnnotationKey(key)
+		if err := c.createMigratedBinding(newBinding(bsi, kind, name), false); err != nil {
+			return err
+		}
+	}
+
+	for key, state := range bsi.Annotations {
+		if isLegacyBindingState(state) {
+			delete(bsi.Annotations, key)
+		}
+	}
+	bsi.Spec.Binding = nil
+	bsi.Status.Action = remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToBind)
+	bsi.Status.Action = remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToUnbind)
+
+	c.recorder.Eventf(bsi, "Migrated", "bindings of instance %s migrated to backingservicebindings", bsi.Name)
+	return nil
+}
+
+func (c *BackingServiceInstanceController) createMigratedBinding(binding *backingserviceinstanceapi.BackingServiceBinding, unbind bool) error {
+	client := c.Client.BackingServiceBindings(binding.Namespace)
+
+	// migrating again after a failure finds the bindings made by the first pass
+	// under the same names.
+	if _, err := client.Create(binding); err != nil {
+		if !kerrors.IsAlreadyExists(err) {
+			return err
+		}
+		existing, err := client.Get(binding.Name)
+		if err != nil {
+			return err
+		}
+		if existing.Spec.BackingServiceInstanceName != binding.Spec.BackingServiceInstanceName ||
+			existing.Spec.BindKind != binding.Spec.BindKind || existing.Spec.ResourceName != binding.Spec.ResourceName {
+			return fmt.Errorf("binding %s already exists and binds %s %s", binding.Name, existing.Spec.BindKind, existing.Spec.ResourceName)
+		}
+		if !existing.DeletionTimestamp.IsZero() {
+			return nil
+		}
+	}
+	if unbind {
+		if err := client.Delete(binding.Name); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+var invalidBindingNameChars = regexp.MustCompile("[^a-z0-9.-]+")
+
+// migratedBindingName returns the name of the binding migrated from the legacy
+// binding of bsi to the resource name of kind, <bsi>-<kind>-<name>.
+func migratedBindingName(bsi *backingserviceinstanceapi.BackingServiceInstance, kind, name string) string {
+	return invalidBindingNameChars.ReplaceAllString(strings.ToLower(bsi.Name+"-"+kind+"-"+name), "-")
+}
+
+// newBinding returns the binding migrated from the legacy binding of bsi to the resource name of kind.
+func newBinding(bsi *backingserviceinstanceapi.BackingServiceInstance, kind, name string) *backingserviceinstanceapi.BackingServiceBinding {
+	return &backingserviceinstanceapi.BackingServiceBinding{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      migratedBindingName(bsi, kind, name),
+			Namespace: bsi.Namespace,
+		},
+		Spec: backingserviceinstanceapi.BackingServiceBindingSpec{
+			BackingServiceInstanceName: bsi.Name,
+			BindKind:                   kind,
+			ResourceName:               name,
+		},
+	}
+}
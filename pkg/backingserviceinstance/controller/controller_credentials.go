@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+var (
+	invalidSecretKeyChars  = regexp.MustCompile("[^-._a-zA-Z0-9]")
+	invalidVolumeNameChars = regexp.MustCompile("[^a-z0-9-]+")
+)
+
+const (
+	maxVolumeNameLength     = 63
+	credentialsVolumePrefix = "bsi-"
+)
+
+// credentialsSecretName returns the name of the Secret holding the credentials of a binding.
+func credentialsSecretName(bsi *backingserviceinstanceapi.BackingServiceInstance, bindUuid string) string {
+	return bsi.Name + "-" + bindUuid
+}
+
+// credentialsVolumeName returns the name of the volume the credentials of bsi are mounted with.
+func credentialsVolumeName(bsi *backingserviceinstanceapi.BackingServiceInstance) string {
+	name := credentialsVolumePrefix + strings.Trim(invalidVolumeNameChars.ReplaceAllString(strings.ToLower(bsi.Name), "-"), "-")
+	if len(name) > maxVolumeNameLength {
+		name = strings.TrimRight(name[:maxVolumeNameLength], "-")
+	}
+	return name
+}
+
+func credentialsMountPath(bsi *backingserviceinstanceapi.BackingServiceInstance, binding *backingserviceinstanceapi.BackingServiceBinding) string {
+	if len(binding.Spec.MountPath) > 0 {
+		return binding.Spec.MountPath
+	}
+	return path.Join(backingserviceinstanceapi.DefaultBindingMountPath, bsi.Name)
+}
+
+// credentialValue returns string credentials as they are, anything else json encoded.
+func credentialValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// credentialsSecretData turns the free-form credentials a broker returned into Secret data.
+func credentialsSecretData(credentials map[string]interface{}) map[string][]byte {
+	data := make(map[string][]byte, len(credentials))
+	for k, v := range credentials {
+		data[invalidSecretKeyChars.ReplaceAllLiteralString(k, "_")] = []byte(credentialValue(v))
+	}
+	return data
+}
+
+// createCredentialsSecret writes the credentials of a binding into a Secret labeled with bsi.
+func (c *BackingServiceInstanceController) createCredentialsSecret(bsi *backingserviceinstanceapi.BackingServiceInstance, bindUuid string, credentials map[string]interface{}) (*kapi.Secret, error) {
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      credentialsSecretName(bsi, bindUuid),
+			Namespace: bsi.Namespace,
+			Labels: map[string]string{
+				backingserviceinstanceapi.BackingServiceInstanceLabel: bsi.Name,
+			},
+		},
+		Type: kapi.SecretTypeOpaque,
+		Data: credentialsSecretData(credentials),
+	}
+
+	created, err := c.KubeClient.Secrets(bsi.Namespace).Create(secret)
+	if !kerrors.IsAlreadyExists(err) {
+		return created, err
+	}
+
+	// a retried bind gets the credentials of the same binding again.
+	existing, err := c.KubeClient.Secrets(bsi.Namespace).Get(secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	existing.Data = secret.Data
+	return c.KubeClient.Secrets(bsi.Namespace).Update(existing)
+}
+
+func (c *BackingServiceInstanceController) deleteCredentialsSecret(namespace, name string) error {
+	if len(name) == 0 {
+		return nil
+	}
+	if err := c.KubeClient.Secrets(namespace).Delete(name); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteCredentialsSecrets deletes the Secrets left by the bindings of bsi.
+func (c *BackingServiceInstanceController) deleteCredentialsSecrets(bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: bsi.Name})
+	secrets, err := c.KubeClient.Secrets(bsi.Namespace).List(selector, fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		if err := c.deleteCredentialsSecret(bsi.Namespace, secrets.Items[i].Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindingCredentials returns the credentials kept in the Secret of a binding.
+func (c *BackingServiceInstanceController) bindingCredentials(binding *backingserviceinstanceapi.BackingServiceBinding) (map[string]interface{}, error) {
+	credentials := map[string]interface{}{}
+
+	if len(binding.Status.CredentialsSecret) == 0 {
+		return credentials, nil
+	}
+
+	secret, err := c.KubeClient.Secrets(binding.Namespace).Get(binding.Status.CredentialsSecret)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			glog.Warningf("credentials secret %s of binding %s is gone", binding.Status.CredentialsSecret, binding.Name)
+			return credentials, nil
+		}
+		return nil, err
+	}
+	for k, v := range secret.Data {
+		credentials[k] = string(v)
+	}
+	return credentials, nil
+}
+
+// mount_credentials mounts the Secret of a binding into every container of the bound resource.
+func (c *BackingServiceInstanceController) mount_credentials(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	return c.modify_volumes(binding, bsi, true)
+}
+
+// unmount_credentials removes what mount_credentials added.
+func (c *BackingServiceInstanceController) unmount_credentials(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	return c.modify_volumes(binding, bsi, false)
+}
+
+func (c *BackingServiceInstanceController) modify_volumes(binding *backingserviceinstanceapi.BackingServiceBinding, bsi *backingserviceinstanceapi.BackingServiceInstance, toMount bool) error {
+	volumeName := credentialsVolumeName(bsi)
+
+	err := c.modifyTarget(binding, bsi, func(target *BindTarget) error {
+		podSpec := target.PodSpec
+		if podSpec == nil {
+			return fmt.Errorf("credentials can't be mounted in %s %s", binding.Spec.BindKind, binding.Spec.ResourceName)
+		}
+
+		volumes := make([]kapi.Volume, 0, len(podSpec.Volumes)+1)
+		for _, v := range podSpec.Volumes {
+			if v.Name != volumeName {
+				volumes = append(volumes, v)
+			}
+		}
+		if toMount {
+			volumes = append(volumes, kapi.Volume{
+				Name: volumeName,
+				VolumeSource: kapi.VolumeSource{
+					Secret: &kapi.SecretVolumeSource{SecretName: binding.Status.CredentialsSecret},
+				},
+			})
+		}
+		podSpec.Volumes = volumes
+
+		for i := range podSpec.Containers {
+			container := &podSpec.Containers[i]
+			mounts := make([]kapi.VolumeMount, 0, len(container.VolumeMounts)+1)
+			for _, m := range container.VolumeMounts {
+				if m.Name != volumeName {
+					mounts = append(mounts, m)
+				}
+			}
+			if toMount {
+				mounts = append(mounts, kapi.VolumeMount{
+					Name:      volumeName,
+					ReadOnly:  true,
+					MountPath: credentialsMountPath(bsi, binding),
+				})
+			}
+			container.VolumeMounts = mounts
+		}
+		return nil
+	})
+	if !toMount && kerrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+// unbindingPollInterval is how often a deleted instance checks whether its bindings
+// are unbound.
+const unbindingPollInterval = 5 * time.Second
+
+// unbindFailedReason is the reason of the Ready condition of a binding which failed
+// to unbind.
+const unbindFailedReason = "UnbindFailed"
+
+// deleteWithBindings deprovisions a deleted instance once none of its bindings is
+// left. The bindings of an instance deleted with cascade are deleted first: the
+// binding controller unbinds each at the broker, then takes the credentials back
+// from the bound resource. The bindings made through the service broker facade are
+// unbound here. The instance waits for bindings failing to unbind, they are retried.
+// It returns whether bsi changed.
+func (c *BackingServiceInstanceController) deleteWithBindings(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	old, oldExternal := bsi.Status.Deletion, len(backingserviceinstanceapi.ExternalBindings(bsi))
+	external, externalErr := c.unbindExternal(bs, bsi)
+	remaining, err := c.unbindAll(bsi)
+	remaining += external
+	changed := external != oldExternal || !kapi.Semantic.DeepEqual(old, bsi.Status.Deletion)
+	if err == nil {
+		err = externalErr
+	}
+	if err != nil {
+		return changed, err
+	}
+	if remaining > 0 {
+		if c.enqueueAfter != nil {
+			c.enqueueAfter(bsi, unbindingPollInterval)
+		}
+		return changed, nil
+	}
+
+	if err := c.deleteInstance(bs, bsi); err != nil {
+		return changed, err
+	}
+	c.recorder.Eventf(bsi, "Deleting", "instance:%s [%v]", bsi.Name, true)
+	return true, nil
+}
+
+// unbindAll deletes the bindings of bsi if it is deleted with cascade, and records
+// how many bindings are left in its status.
+func (c *BackingServiceInstanceController) unbindAll(bsi *backingserviceinstanceapi.BackingServiceInstance) (int, error) {
+	bindings, err := c.instanceBindings(bsi.Namespace, bsi.Name)
+	if err != nil {
+		return 0, err
+	}
+	cascade := bsi.Annotations[backingserviceinstanceapi.CascadeDeletionAnnotation] == "true"
+
+	errs := []error{}
+	status := &backingserviceinstanceapi.InstanceDeletionStatus{}
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.DeletionTimestamp.IsZero() {
+			// bindings left alone by a deletion without cascade aren't bound, they
+			// fail once the instance is deprovisioned.
+			if !cascade {
+				continue
+			}
+			if err := c.Client.BackingServiceBindings(binding.Namespace).Delete(binding.Name); err != nil && !kerrors.IsNotFound(err) {
+				errs = append(errs, err)
+			} else {
+				c.recorder.Eventf(bsi, "Unbinding", "unbinding %s %s before deleting the instance", binding.Spec.BindKind, binding.Spec.ResourceName)
+			}
+		}
+
+		status.RemainingBindings++
+		if readyConditionReason(binding) == unbindFailedReason {
+			status.FailedBindings = append(status.FailedBindings, binding.Name)
+		}
+	}
+
+	switch {
+	case status.RemainingBindings == 0:
+		status.Message = "deprovisioning"
+	case len(status.FailedBindings) > 0:
+		status.Message = fmt.Sprintf("unbinding %d bindings, %d failed to unbind and are retried", status.RemainingBindings, len(status.FailedBindings))
+	default:
+		status.Message = fmt.Sprintf("unbinding %d bindings", status.RemainingBindings)
+	}
+	bsi.Status.Deletion = status
+
+	return status.RemainingBindings, utilerrors.NewAggregate(errs)
+}
+
+// unbindExternal unbinds at the broker the bindings of bsi made through the service
+// broker facade if it is deleted with cascade, and removes them from bsi. It returns
+// how many are left.
+func (c *BackingServiceInstanceController) unbindExternal(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (int, error) {
+	bindings := backingserviceinstanceapi.ExternalBindings(bsi)
+	if len(bindings) == 0 || bsi.Annotations[backingserviceinstanceapi.CascadeDeletionAnnotation] != "true" {
+		return len(bindings), nil
+	}
+
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return len(bindings), err
+	}
+	errs := []error{}
+	for id := range bindings {
+		ctx, cancel := brokerContext()
+		err := sbclient.Unbind(ctx, bsi.Spec.InstanceID, id, &servicebrokerclient.UnbindRequest{
+			ServiceID: bsi.Spec.BackingServiceSpecID,
+			PlanID:    bsi.Spec.BackingServicePlanGuid,
+		})
+		cancel()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(bsi, "Unbinding", "unbound external binding %s before deleting the instance", id)
+		delete(bindings, id)
+	}
+	backingserviceinstanceapi.SetExternalBindings(bsi, bindings)
+	return len(bindings), utilerrors.NewAggregate(errs)
+}
+
+// readyConditionReason returns the reason of the Ready condition of binding.
+func readyConditionReason(binding *backingserviceinstanceapi.BackingServiceBinding) string {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == backingserviceinstanceapi.BackingServiceBindingReady {
+			return condition.Reason
+		}
+	}
+	return ""
+}
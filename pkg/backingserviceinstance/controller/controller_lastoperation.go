@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+const (
+	// defaultPollInterval is used to poll last_operation when the broker doesn't suggest an interval.
+	defaultPollInterval = 10 * time.Second
+	// maxDeprovisionBackoff bounds the delay between the deprovisioning retries of an instance.
+	maxDeprovisionBackoff = 5 * time.Minute
+)
+
+func instanceKey(bsi *backingserviceinstanceapi.BackingServiceInstance) string {
+	return bsi.Namespace + "/" + bsi.Name
+}
+
+func lastOperationInProgress(bsi *backingserviceinstanceapi.BackingServiceInstance) bool {
+	return bsi.Status.LastOperation != nil &&
+		bsi.Status.LastOperation.State == backingserviceinstanceapi.LastOperationStateInProgress
+}
+
+func newLastOperation(operation string, lastOperation *servicebrokerclient.LastOperationResponse) *backingserviceinstanceapi.LastOperation {
+	op := &backingserviceinstanceapi.LastOperation{
+		State:     backingserviceinstanceapi.LastOperationStateInProgress,
+		Operation: operation,
+	}
+	if lastOperation != nil {
+		if lastOperation.State != "" {
+			op.State = lastOperation.State
+		}
+		op.Description = lastOperation.Description
+		op.AsyncPollIntervalSeconds = lastOperation.AsyncPollIntervalSeconds
+	}
+	return op
+}
+
+// pollLastOperation asks the broker for the state of the last operation on bsi.
+// Nothing is returned if it is not time to poll yet.
+func (c *BackingServiceInstanceController) pollLastOperation(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (*servicebrokerclient.LastOperationResponse, bool, error) {
+	key := instanceKey(bsi)
+	if next, ok := c.nextPoll[key]; ok && time.Now().Before(next) {
+		return nil, false, nil
+	}
+	delete(c.nextPoll, key)
+
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req := &servicebrokerclient.LastOperationRequest{
+		ServiceID: bsi.Spec.BackingServiceSpecID,
+		PlanID:    bsi.Spec.BackingServicePlanGuid,
+	}
+	if bsi.Status.LastOperation != nil {
+		req.Operation = bsi.Status.LastOperation.Operation
+	}
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+
+	// 410 means the instance doesn't exist (any more).
+	lastOperation, err := sbclient.LastOperation(ctx, bsi.Spec.InstanceID, req)
+	if servicebrokerclient.IsGone(err) {
+		return nil, true, nil
+	}
+	return lastOperation, false, err
+}
+
+// pollAfter schedules the next last_operation poll of bsi, honouring the interval suggested by the broker.
+func (c *BackingServiceInstanceController) pollAfter(bsi *backingserviceinstanceapi.BackingServiceInstance) {
+	interval := defaultPollInterval
+	if seconds := bsi.Status.LastOperation.AsyncPollIntervalSeconds; seconds > 0 {
+		interval = time.Duration(seconds) * time.Second
+	}
+
+	c.nextPoll[instanceKey(bsi)] = time.Now().Add(interval)
+	if c.enqueueAfter != nil {
+		c.enqueueAfter(bsi, interval)
+	}
+}
+
+// updateLastOperation records the polled state into bsi, and returns whether anything changed.
+func updateLastOperation(bsi *backingserviceinstanceapi.BackingServiceInstance, lastOperation *servicebrokerclient.LastOperationResponse) bool {
+	current := bsi.Status.LastOperation
+	if current.State == lastOperation.State && current.Description == lastOperation.Description {
+		if lastOperation.AsyncPollIntervalSeconds > 0 {
+			current.AsyncPollIntervalSeconds = lastOperation.AsyncPollIntervalSeconds
+		}
+		return false
+	}
+
+	current.State = lastOperation.State
+	current.Description = lastOperation.Description
+	if lastOperation.AsyncPollIntervalSeconds > 0 {
+		current.AsyncPollIntervalSeconds = lastOperation.AsyncPollIntervalSeconds
+	}
+	return true
+}
+
+// pollProvisioning polls an asynchronous provisioning, the instance is kept in
+// the Provisioning phase until the broker reports the operation as succeeded.
+func (c *BackingServiceInstanceController) pollProvisioning(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	lastOperation, gone, err := c.pollLastOperation(bs, bsi)
+	if err != nil || (lastOperation == nil && !gone) {
+		return false, err
+	}
+
+	if gone {
+		lastOperation = &servicebrokerclient.LastOperationResponse{
+			State:       backingserviceinstanceapi.LastOperationStateFailed,
+			Description: "instance is gone at the broker",
+		}
+	}
+
+	changed := updateLastOperation(bsi, lastOperation)
+
+	switch lastOperation.State {
+	case backingserviceinstanceapi.LastOperationStateSucceeded:
+		glog.Infoln("bsi provisioning done ", bsi.Name)
+		c.recorder.Eventf(bsi, "Provisioning", "bsi provisioning done, instanceid: %s", bsi.Spec.InstanceID)
+
+		bsi.Status.AppliedPlanGuid = bsi.Spec.BackingServicePlanGuid
+		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
+		return true, nil
+	case backingserviceinstanceapi.LastOperationStateFailed:
+		glog.Infoln("bsi provisioning failed ", bsi.Name)
+		c.recorder.Eventf(bsi, "Provisioning", "bsi provisioning failed: %s", lastOperation.Description)
+		return true, nil
+	}
+
+	c.pollAfter(bsi)
+	return changed, nil
+}
+
+// pollDeprovisioning polls an asynchronous deprovisioning, the instance is marked
+// as Deleted once the broker reports the operation as succeeded or the instance as gone.
+// A failed deprovisioning is retried by retryDeprovisioning after a backoff.
+func (c *BackingServiceInstanceController) pollDeprovisioning(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	lastOperation, gone, err := c.pollLastOperation(bs, bsi)
+	if err != nil || (lastOperation == nil && !gone) {
+		return false, err
+	}
+
+	if gone {
+		lastOperation = &servicebrokerclient.LastOperationResponse{
+			State:       backingserviceinstanceapi.LastOperationStateSucceeded,
+			Description: bsi.Status.LastOperation.Description,
+		}
+	}
+
+	changed := updateLastOperation(bsi, lastOperation)
+
+	switch lastOperation.State {
+	case backingserviceinstanceapi.LastOperationStateSucceeded:
+		glog.Infoln("bsi deleted ", bsi.Name)
+		c.recorder.Eventf(bsi, "Deleting", "bsi deprovisioning done, instanceid: %s", bsi.Spec.InstanceID)
+
+		bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseDeleted
+		bsi.Status.Action = remove_action_word(bsi.Status.Action, backingserviceinstanceapi.BackingServiceInstanceActionToDelete)
+		return true, nil
+	case backingserviceinstanceapi.LastOperationStateFailed:
+		glog.Infoln("bsi deprovisioning failed ", bsi.Name)
+		c.recorder.Eventf(bsi, "Deleting", "bsi deprovisioning failed, retrying: %s", lastOperation.Description)
+		c.backOffDeprovisioning(bsi)
+		return true, nil
+	}
+
+	c.pollAfter(bsi)
+	return changed, nil
+}
+
+// retryDeprovisioning asks the broker again to deprovision an instance it failed to
+// deprovision, backing off exponentially between the attempts.
+func (c *BackingServiceInstanceController) retryDeprovisioning(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	if c.deprovisionBackoff.IsInBackOffSinceUpdate(instanceKey(bsi), c.deprovisionBackoff.Clock.Now()) {
+		return false, nil
+	}
+
+	glog.Infoln("bsi deprovisioning retried ", bsi.Name)
+	c.recorder.Eventf(bsi, "Deleting", "retrying deprovisioning, instanceid: %s", bsi.Spec.InstanceID)
+
+	if err := c.deleteInstance(bs, bsi); err != nil {
+		c.backOffDeprovisioning(bsi)
+		return false, err
+	}
+	return true, nil
+}
+
+// backOffDeprovisioning delays the next deprovisioning attempt of bsi.
+func (c *BackingServiceInstanceController) backOffDeprovisioning(bsi *backingserviceinstanceapi.BackingServiceInstance) {
+	key := instanceKey(bsi)
+	c.deprovisionBackoff.Next(key, c.deprovisionBackoff.Clock.Now())
+	if c.enqueueAfter != nil {
+		c.enqueueAfter(bsi, c.deprovisionBackoff.Get(key))
+	}
+}
+
+// forget drops the polls scheduled for a deleted instance, and the deprovisioning
+// backoffs which expired.
+func (c *BackingServiceInstanceController) forget(bsi *backingserviceinstanceapi.BackingServiceInstance) {
+	delete(c.nextPoll, instanceKey(bsi))
+	c.deprovisionBackoff.GC()
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"github.com/golang/glog"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// reserveInstanceID stores the id bsi is provisioned with before the broker is asked
+// to, so that a retried provisioning reuses it rather than leaking the instance a
+// failed request may have created.
+func (c *BackingServiceInstanceController) reserveInstanceID(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) error {
+	if len(bsi.Spec.InstanceID) > 0 {
+		c.recorder.Eventf(bsi, "Retrying", "retrying provisioning with instanceid: %s", bsi.Spec.InstanceID)
+		return nil
+	}
+
+	bsi.Spec.InstanceID = string(util.NewUUID())
+	bsi.Spec.BackingServiceSpecID = bs.Spec.Id
+	updated, err := c.Client.BackingServiceInstances(bsi.Namespace).Update(bsi)
+	if err != nil {
+		return err
+	}
+	*bsi = *updated
+
+	c.recorder.Eventf(bsi, "Provisioning", "reserved instanceid: %s", bsi.Spec.InstanceID)
+	return nil
+}
+
+// mitigateInstance deprovisions an instance whose provisioning failed ambiguously, the
+// broker may have created it anyway. Provisioning is retried with the same id, once
+// the broker is done deprovisioning if it does so asynchronously. It returns true if
+// bsi records a deprovisioning in progress.
+func (c *BackingServiceInstanceController) mitigateInstance(sbclient servicebrokerclient.Interface, bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) bool {
+	glog.Infoln("bsi orphan mitigation ", bsi.Name)
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+	resp, err := sbclient.Deprovision(ctx, bsi.Spec.InstanceID, &servicebrokerclient.DeprovisionRequest{
+		ServiceID:         bs.Spec.Id,
+		PlanID:            bsi.Spec.BackingServicePlanGuid,
+		AcceptsIncomplete: true,
+	})
+	switch {
+	case err != nil:
+		c.recorder.Eventf(bsi, "OrphanMitigation", "failed to deprovision instanceid %s after provisioning failed: %v", bsi.Spec.InstanceID, err)
+	case resp.Async:
+		c.recorder.Eventf(bsi, "OrphanMitigation", "deprovisioning of instanceid %s after provisioning failed accepted by broker", bsi.Spec.InstanceID)
+		bsi.Status.LastOperation = newLastOperation(resp.Operation, nil)
+		bsi.Status.LastOperation.Mitigation = true
+		c.pollAfter(bsi)
+		return true
+	default:
+		c.recorder.Eventf(bsi, "OrphanMitigation", "deprovisioned instanceid %s after provisioning failed", bsi.Spec.InstanceID)
+	}
+	return false
+}
+
+// pollMitigation polls the asynchronous deprovisioning started by mitigateInstance.
+// Once it is over, whatever its outcome, provisioning is retried with the same id.
+func (c *BackingServiceInstanceController) pollMitigation(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	lastOperation, gone, err := c.pollLastOperation(bs, bsi)
+	if err != nil || (lastOperation == nil && !gone) {
+		return false, err
+	}
+
+	switch {
+	case gone || lastOperation.State == backingserviceinstanceapi.LastOperationStateSucceeded:
+		c.recorder.Eventf(bsi, "OrphanMitigation", "deprovisioned instanceid %s after provisioning failed", bsi.Spec.InstanceID)
+	case lastOperation.State == backingserviceinstanceapi.LastOperationStateFailed:
+		c.recorder.Eventf(bsi, "OrphanMitigation", "failed to deprovision instanceid %s after provisioning failed: %s", bsi.Spec.InstanceID, lastOperation.Description)
+	default:
+		changed := updateLastOperation(bsi, lastOperation)
+		c.pollAfter(bsi)
+		return changed, nil
+	}
+
+	bsi.Status.LastOperation = nil
+	if c.enqueueAfter != nil {
+		c.enqueueAfter(bsi, 0)
+	}
+	return true, nil
+}
+
+// reserveBindingID stores the id binding is bound with before the broker is asked to,
+// so that a retried bind reuses it. A deleted binding with a reserved id is unbound
+// at the broker even if it never got bound.
+func (c *BackingServiceInstanceController) reserveBindingID(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	if len(binding.Status.BindUuid) > 0 {
+		c.recorder.Eventf(binding, "Retrying", "retrying bind with binding id: %s", binding.Status.BindUuid)
+		return nil
+	}
+
+	binding.Status.BindUuid = string(util.NewUUID())
+	binding.Status.Phase = backingserviceinstanceapi.BackingServiceBindingPhasePending
+	updated, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding)
+	if err != nil {
+		return err
+	}
+	*binding = *updated
+
+	c.recorder.Eventf(binding, "Binding", "reserved binding id: %s", binding.Status.BindUuid)
+	return nil
+}
+
+// mitigateBinding unbinds a binding whose bind failed ambiguously, the broker may have
+// created it anyway. Binding is retried with the same id.
+func (c *BackingServiceInstanceController) mitigateBinding(sbclient servicebrokerclient.Interface, bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance, binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	glog.Infoln("bsb orphan mitigation ", binding.Name)
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+	err := sbclient.Unbind(ctx, bsi.Spec.InstanceID, binding.Status.BindUuid, &servicebrokerclient.UnbindRequest{
+		ServiceID: bs.Spec.Id,
+		PlanID:    bsi.Spec.BackingServicePlanGuid,
+	})
+	if err != nil {
+		c.recorder.Eventf(binding, "OrphanMitigation", "failed to unbind binding id %s after bind failed: %v", binding.Status.BindUuid, err)
+		return err
+	}
+	c.recorder.Eventf(binding, "OrphanMitigation", "unbound binding id %s after bind failed", binding.Status.BindUuid)
+	return nil
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"github.com/openshift/origin/pkg/backingservice/schema"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// brokerParameters returns params typed as the json encoded schema declares, to be
+// sent to the broker. Parameters are validated when they are set, but the schema of
+// the plan may have changed since.
+func brokerParameters(schemaData string, params map[string]string) (map[string]interface{}, error) {
+	s, err := schema.Parse(schemaData)
+	if err != nil {
+		return nil, err
+	}
+
+	values, errs := s.Convert(params)
+	if len(errs) > 0 {
+		aggregate := make([]error, 0, len(errs))
+		for _, err := range errs {
+			aggregate = append(aggregate, err)
+		}
+		return nil, utilerrors.NewAggregate(aggregate)
+	}
+	return values, nil
+}
+
+// copyParameters returns a copy of params, nil if there are none.
+func copyParameters(params map[string]string) map[string]string {
+	if len(params) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(params))
+	for k, v := range params {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,279 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// rotate rotates the credentials of a bound binding when asked to with the
+// RotateCredentialsAnnotation or when its rotation period is over. A rotation
+// binds again at the broker, swaps the credentials in the bound resource and
+// unbinds the old binding once the resource is deployed with the new ones.
+func (c *BackingServiceInstanceController) rotate(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	rotation := binding.Status.Rotation
+	switch {
+	case rotation == nil:
+		if !rotationDue(binding, time.Now()) {
+			return nil
+		}
+		return c.startRotation(binding)
+	case len(rotation.RetiredBindUuid) == 0:
+		// the new binding failed to be bound or injected, it is retried.
+		return c.swapCredentials(binding)
+	default:
+		return c.finishRotation(binding)
+	}
+}
+
+// rotationDue returns true if the credentials of binding should be rotated at now.
+func rotationDue(binding *backingserviceinstanceapi.BackingServiceBinding, now time.Time) bool {
+	if request := binding.Annotations[backingserviceinstanceapi.RotateCredentialsAnnotation]; len(request) > 0 && request != binding.Status.RotationRequest {
+		return true
+	}
+	if binding.Spec.RotationPeriod == nil {
+		return false
+	}
+	last := binding.Status.RotatedTime
+	if last == nil {
+		last = binding.Status.BoundTime
+	}
+	return last != nil && now.Sub(last.Time) >= binding.Spec.RotationPeriod.Duration
+}
+
+// startRotation reserves the id of the new binding before asking the broker for it,
+// the bind is retried with the same id if it fails.
+func (c *BackingServiceInstanceController) startRotation(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	binding.Status.RotationRequest = binding.Annotations[backingserviceinstanceapi.RotateCredentialsAnnotation]
+	binding.Status.Rotation = &backingserviceinstanceapi.BackingServiceBindingRotation{
+		StartTime: unversioned.Now(),
+		BindUuid:  string(util.NewUUID()),
+	}
+	updated, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding)
+	if err != nil {
+		return err
+	}
+	*binding = *updated
+	c.recorder.Eventf(binding, "Rotating", "rotating credentials with binding id: %s", binding.Status.Rotation.BindUuid)
+
+	return c.swapCredentials(binding)
+}
+
+// swapCredentials binds again at the broker and replaces the credentials of the old
+// binding by the new ones in a single update of the bound resource.
+func (c *BackingServiceInstanceController) swapCredentials(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	rotation := binding.Status.Rotation
+	bsiName := binding.Spec.BackingServiceInstanceName
+
+	bsi, err := c.Client.BackingServiceInstances(binding.Namespace).Get(bsiName)
+	if err != nil {
+		return err
+	}
+	switch bsi.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+	default:
+		glog.Infof("bsb %s rotation waits for instance %s, which is %s", binding.Name, bsiName, bsi.Status.Phase)
+		return nil
+	}
+
+	bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		return err
+	}
+	var bindingSchema string
+	if plan := findServicePlan(bs, bsi.Spec.BackingServicePlanGuid); plan != nil {
+		bindingSchema = plan.Schemas.BindingCreate
+	}
+	parameters, err := brokerParameters(bindingSchema, binding.Spec.Parameters)
+	if err != nil {
+		return err
+	}
+	bindResource, err := c.bindResource(binding)
+	if err != nil {
+		return err
+	}
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := brokerContext()
+	resp, err := sbclient.Bind(ctx, bsi.Spec.InstanceID, rotation.BindUuid, &servicebrokerclient.BindRequest{
+		ServiceID:    bs.Spec.Id,
+		PlanID:       bsi.Spec.BackingServicePlanGuid,
+		AppGUID:      bsi.Namespace,
+		BindResource: bindResource,
+		Parameters:   parameters,
+	})
+	cancel()
+	if err != nil {
+		c.recorder.Eventf(binding, "Rotating", "broker failed to bind: %v", err)
+		return err
+	}
+
+	secret, err := c.createCredentialsSecret(bsi, rotation.BindUuid, resp.Credentials)
+	if err != nil {
+		return err
+	}
+
+	// deployments from now on may hold the new credentials.
+	if kind := binding.Spec.BindKind; len(kind) == 0 || kind == backingserviceinstanceapi.BindKind_DeploymentConfig {
+		dc, err := c.Client.DeploymentConfigs(binding.Namespace).Get(binding.Spec.ResourceName)
+		if err != nil {
+			return err
+		}
+		rotation.LatestVersion = dc.Status.LatestVersion
+	}
+
+	retired := binding.Status.CredentialsSecret
+	c.setBindingServices(binding, resp)
+	if binding.Spec.Injection == backingserviceinstanceapi.BindingInjectionVolume {
+		binding.Status.CredentialsSecret = secret.Name
+		err = c.mount_credentials(binding, bsi)
+	} else {
+		var old map[string]interface{}
+		if old, err = c.bindingCredentials(binding); err != nil {
+			return err
+		}
+		err = c.swap_envs(binding, bsi, old, resp.Credentials)
+	}
+	if err != nil {
+		c.recorder.Eventf(binding, "Rotating", "failed to swap credentials in %s %s: %v", binding.Spec.BindKind, binding.Spec.ResourceName, err)
+		return err
+	}
+
+	rotation.RetiredBindUuid, binding.Status.BindUuid = binding.Status.BindUuid, rotation.BindUuid
+	rotation.RetiredCredentialsSecret = retired
+	rotation.BindUuid = ""
+	binding.Status.CredentialsSecret = secret.Name
+	if _, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding); err != nil {
+		return err
+	}
+	c.recorder.Eventf(binding, "Rotating", "credentials swapped in %s %s", binding.Spec.BindKind, binding.Spec.ResourceName)
+	return nil
+}
+
+// finishRotation unbinds the old binding once the bound resource is deployed with
+// the new credentials.
+func (c *BackingServiceInstanceController) finishRotation(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	rotation := binding.Status.Rotation
+
+	deployed, err := c.deployedSince(binding, rotation.LatestVersion)
+	if err != nil || !deployed {
+		return err
+	}
+
+	if err := c.unbindRetired(binding, rotation.RetiredBindUuid); err != nil {
+		c.recorder.Eventf(binding, "Rotating", "broker failed to unbind the old binding: %v", err)
+		return err
+	}
+	if err := c.deleteCredentialsSecret(binding.Namespace, rotation.RetiredCredentialsSecret); err != nil {
+		return err
+	}
+
+	now := unversioned.Now()
+	binding.Status.Rotation = nil
+	binding.Status.RotatedTime = &now
+	if _, err := c.Client.BackingServiceBindings(binding.Namespace).Update(binding); err != nil {
+		return err
+	}
+	c.recorder.Eventf(binding, "Rotated", "credentials of %s %s rotated", binding.Spec.BindKind, binding.Spec.ResourceName)
+	return nil
+}
+
+// deployedSince returns true once the bound resource runs with the credentials
+// swapped in: a DeploymentConfig needs a deployment later than version to complete.
+func (c *BackingServiceInstanceController) deployedSince(binding *backingserviceinstanceapi.BackingServiceBinding, version int) (bool, error) {
+	if kind := binding.Spec.BindKind; len(kind) > 0 && kind != backingserviceinstanceapi.BindKind_DeploymentConfig {
+		return true, nil
+	}
+
+	dc, err := c.Client.DeploymentConfigs(binding.Namespace).Get(binding.Spec.ResourceName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if dc.Status.LatestVersion <= version {
+		glog.Infof("bsb %s rotation waits for dc %s to be deployed", binding.Name, dc.Name)
+		return false, nil
+	}
+
+	rc, err := c.KubeClient.ReplicationControllers(dc.Namespace).Get(deployutil.LatestDeploymentNameForConfig(dc))
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	switch deployutil.DeploymentStatusFor(rc) {
+	case deployapi.DeploymentStatusComplete:
+		return true, nil
+	case deployapi.DeploymentStatusFailed:
+		// the pods left run with the old credentials, a later deployment may still succeed.
+		c.recorder.Eventf(binding, "Rotating", "deployment %s failed, the old binding is kept until a deployment succeeds", rc.Name)
+	}
+	return false, nil
+}
+
+// unbindRetired unbinds bindUuid at the broker of the instance of binding.
+func (c *BackingServiceInstanceController) unbindRetired(binding *backingserviceinstanceapi.BackingServiceBinding, bindUuid string) error {
+	if len(bindUuid) == 0 {
+		return nil
+	}
+	bsi, err := c.Client.BackingServiceInstances(binding.Namespace).Get(binding.Spec.BackingServiceInstanceName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	bs, err := c.Client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		return err
+	}
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+	err = sbclient.Unbind(ctx, bsi.Spec.InstanceID, bindUuid, &servicebrokerclient.UnbindRequest{
+		ServiceID: bsi.Spec.BackingServiceSpecID,
+		PlanID:    bsi.Spec.BackingServicePlanGuid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unbind binding id %s: %v", bindUuid, err)
+	}
+	return nil
+}
+
+// abandonRotation unbinds and deletes what the rotation of a binding being unbound
+// left besides the binding itself.
+func (c *BackingServiceInstanceController) abandonRotation(binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	rotation := binding.Status.Rotation
+	if rotation == nil {
+		return nil
+	}
+
+	bindUuid, secret := rotation.RetiredBindUuid, rotation.RetiredCredentialsSecret
+	if len(bindUuid) == 0 {
+		bindUuid = rotation.BindUuid
+		// named as credentialsSecretName names it, the instance may be gone.
+		secret = binding.Spec.BackingServiceInstanceName + "-" + rotation.BindUuid
+	}
+	if err := c.unbindRetired(binding, bindUuid); err != nil {
+		return err
+	}
+	return c.deleteCredentialsSecret(binding.Namespace, secret)
+}
@@ -0,0 +1,754 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/record"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	"github.com/openshift/origin/pkg/servicebroker/client/fakebroker"
+)
+
+// fakeStore keeps the objects served by the fake clients, keyed by resource, namespace and name.
+type fakeStore struct {
+	objects map[string]runtime.Object
+	version int
+}
+
+func storeKey(resource, namespace, name string) string {
+	return resource + "/" + namespace + "/" + name
+}
+
+func (s *fakeStore) add(resource string, obj runtime.Object) {
+	meta, err := kapi.ObjectMetaFor(obj)
+	if err != nil {
+		panic(err)
+	}
+	s.objects[storeKey(resource, meta.Namespace, meta.Name)] = obj
+}
+
+func (s *fakeStore) get(resource, namespace, name string) (runtime.Object, error) {
+	obj, ok := s.objects[storeKey(resource, namespace, name)]
+	if !ok {
+		return nil, kerrors.NewNotFound(resource, name)
+	}
+	return kapi.Scheme.Copy(obj)
+}
+
+func (s *fakeStore) list(resource, namespace string, selector labels.Selector) []runtime.Object {
+	objects := []runtime.Object{}
+	for _, obj := range s.objects {
+		meta, _ := kapi.ObjectMetaFor(obj)
+		if _, ok := s.objects[storeKey(resource, meta.Namespace, meta.Name)]; !ok || meta.Namespace != namespace {
+			continue
+		}
+		if selector != nil && !selector.Matches(labels.Set(meta.Labels)) {
+			continue
+		}
+		copied, _ := kapi.Scheme.Copy(obj)
+		objects = append(objects, copied)
+	}
+	return objects
+}
+
+func (s *fakeStore) create(resource, namespace string, obj runtime.Object) (runtime.Object, error) {
+	obj, _ = kapi.Scheme.Copy(obj)
+	meta, _ := kapi.ObjectMetaFor(obj)
+	meta.Namespace = namespace
+	if len(meta.Name) == 0 && len(meta.GenerateName) > 0 {
+		s.version++
+		meta.Name = meta.GenerateName + strconv.Itoa(s.version)
+	}
+	if _, exists := s.objects[storeKey(resource, namespace, meta.Name)]; exists {
+		return nil, kerrors.NewAlreadyExists(resource, meta.Name)
+	}
+	return s.save(resource, obj)
+}
+
+func (s *fakeStore) update(resource, namespace string, obj runtime.Object) (runtime.Object, error) {
+	meta, _ := kapi.ObjectMetaFor(obj)
+	if _, exists := s.objects[storeKey(resource, namespace, meta.Name)]; !exists {
+		return nil, kerrors.NewNotFound(resource, meta.Name)
+	}
+	obj, _ = kapi.Scheme.Copy(obj)
+	return s.save(resource, obj)
+}
+
+func (s *fakeStore) save(resource string, obj runtime.Object) (runtime.Object, error) {
+	meta, _ := kapi.ObjectMetaFor(obj)
+	s.version++
+	meta.ResourceVersion = strconv.Itoa(s.version)
+	s.add(resource, obj)
+	return kapi.Scheme.Copy(obj)
+}
+
+func (s *fakeStore) delete(resource, namespace, name string) error {
+	key := storeKey(resource, namespace, name)
+	if _, exists := s.objects[key]; !exists {
+		return kerrors.NewNotFound(resource, name)
+	}
+	delete(s.objects, key)
+	return nil
+}
+
+// react serves the requests of a fake Kubernetes client from the store.
+func (s *fakeStore) react(action ktestclient.Action) (bool, runtime.Object, error) {
+	// the action interfaces overlap, they are told apart by their verb.
+	resource, namespace := action.GetResource(), action.GetNamespace()
+	switch action.GetVerb() {
+	case "get":
+		obj, err := s.get(resource, namespace, action.(ktestclient.GetAction).GetName())
+		return true, obj, err
+	case "list":
+		if resource != "secrets" {
+			return true, nil, fmt.Errorf("listing %s is not supported", resource)
+		}
+		list := &kapi.SecretList{}
+		for _, obj := range s.list(resource, namespace, action.(ktestclient.ListAction).GetListRestrictions().Labels) {
+			list.Items = append(list.Items, *obj.(*kapi.Secret))
+		}
+		return true, list, nil
+	case "create":
+		obj, err := s.create(resource, namespace, action.(ktestclient.CreateAction).GetObject())
+		return true, obj, err
+	case "update":
+		obj, err := s.update(resource, namespace, action.(ktestclient.UpdateAction).GetObject())
+		return true, obj, err
+	case "delete":
+		return true, nil, s.delete(resource, namespace, action.(ktestclient.DeleteAction).GetName())
+	}
+	return false, nil, nil
+}
+
+// fakeClient serves the resources the controller uses from the store.
+type fakeClient struct {
+	osclient.Interface
+	store *fakeStore
+}
+
+func (c *fakeClient) BackingServices(namespace string) osclient.BackingServiceInterface {
+	return &fakeBackingServices{store: c.store, namespace: namespace}
+}
+
+func (c *fakeClient) BackingServiceInstances(namespace string) osclient.BackingServiceInstanceInterface {
+	return &fakeBackingServiceInstances{store: c.store, namespace: namespace}
+}
+
+func (c *fakeClient) BackingServiceBindings(namespace string) osclient.BackingServiceBindingInterface {
+	return &fakeBackingServiceBindings{store: c.store, namespace: namespace}
+}
+
+func (c *fakeClient) ServiceBrokers() osclient.ServiceBrokerInterface {
+	return &fakeServiceBrokers{store: c.store}
+}
+
+func (c *fakeClient) DeploymentConfigs(namespace string) osclient.DeploymentConfigInterface {
+	return &fakeDeploymentConfigs{store: c.store, namespace: namespace}
+}
+
+type fakeBackingServices struct {
+	osclient.BackingServiceInterface
+	store     *fakeStore
+	namespace string
+}
+
+func (c *fakeBackingServices) Get(name string) (*backingserviceapi.BackingService, error) {
+	obj, err := c.store.get("backingservices", c.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceapi.BackingService), nil
+}
+
+type fakeBackingServiceInstances struct {
+	osclient.BackingServiceInstanceInterface
+	store     *fakeStore
+	namespace string
+}
+
+func (c *fakeBackingServiceInstances) Get(name string) (*backingserviceinstanceapi.BackingServiceInstance, error) {
+	obj, err := c.store.get("backingserviceinstances", c.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceInstance), nil
+}
+
+func (c *fakeBackingServiceInstances) Update(bsi *backingserviceinstanceapi.BackingServiceInstance) (*backingserviceinstanceapi.BackingServiceInstance, error) {
+	obj, err := c.store.update("backingserviceinstances", c.namespace, bsi)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceInstance), nil
+}
+
+func (c *fakeBackingServiceInstances) Delete(name string) error {
+	return c.store.delete("backingserviceinstances", c.namespace, name)
+}
+
+type fakeBackingServiceBindings struct {
+	osclient.BackingServiceBindingInterface
+	store     *fakeStore
+	namespace string
+}
+
+func (c *fakeBackingServiceBindings) Get(name string) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.store.get("backingservicebindings", c.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), nil
+}
+
+func (c *fakeBackingServiceBindings) List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceBindingList, error) {
+	list := &backingserviceinstanceapi.BackingServiceBindingList{}
+	for _, obj := range c.store.list("backingservicebindings", c.namespace, label) {
+		list.Items = append(list.Items, *obj.(*backingserviceinstanceapi.BackingServiceBinding))
+	}
+	return list, nil
+}
+
+func (c *fakeBackingServiceBindings) Create(binding *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.store.create("backingservicebindings", c.namespace, binding)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), nil
+}
+
+func (c *fakeBackingServiceBindings) Update(binding *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.store.update("backingservicebindings", c.namespace, binding)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), nil
+}
+
+func (c *fakeBackingServiceBindings) Delete(name string) error {
+	return c.store.delete("backingservicebindings", c.namespace, name)
+}
+
+type fakeServiceBrokers struct {
+	osclient.ServiceBrokerInterface
+	store *fakeStore
+}
+
+func (c *fakeServiceBrokers) Get(name string) (*servicebrokerapi.ServiceBroker, error) {
+	obj, err := c.store.get("servicebrokers", "", name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*servicebrokerapi.ServiceBroker), nil
+}
+
+type fakeDeploymentConfigs struct {
+	osclient.DeploymentConfigInterface
+	store     *fakeStore
+	namespace string
+}
+
+func (c *fakeDeploymentConfigs) Get(name string) (*deployapi.DeploymentConfig, error) {
+	obj, err := c.store.get("deploymentconfigs", c.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*deployapi.DeploymentConfig), nil
+}
+
+func (c *fakeDeploymentConfigs) Update(dc *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	obj, err := c.store.update("deploymentconfigs", c.namespace, dc)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*deployapi.DeploymentConfig), nil
+}
+
+const (
+	testNamespace  = "project"
+	testInstanceID = "instance-id"
+)
+
+// newTestController returns a controller talking to broker, and the store its clients serve.
+// The store holds the BackingService mysql, offered by broker with the plans small and large.
+func newTestController(broker *fakebroker.Broker) (*BackingServiceInstanceController, *fakeStore) {
+	store := &fakeStore{objects: map[string]runtime.Object{}}
+	store.add("servicebrokers", &servicebrokerapi.ServiceBroker{ObjectMeta: kapi.ObjectMeta{Name: "broker"}})
+	store.add("backingservices", &backingserviceapi.BackingService{
+		ObjectMeta: kapi.ObjectMeta{Name: "mysql", GenerateName: "broker", Namespace: backingserviceapi.BackingServiceSharedNamespace},
+		Spec: backingserviceapi.BackingServiceSpec{
+			Name:           "mysql",
+			Id:             "mysql-id",
+			Bindable:       true,
+			PlanUpdateable: true,
+			Plans:          []backingserviceapi.ServicePlan{{Id: "small", Name: "small"}, {Id: "large", Name: "large"}},
+		},
+	})
+
+	kubeClient := &ktestclient.Fake{}
+	kubeClient.AddReactor("*", "*", store.react)
+
+	backoff := kutil.NewBackOff(defaultPollInterval, maxDeprovisionBackoff)
+	backoff.Clock = &kutil.FakeClock{Time: time.Now()}
+
+	c := &BackingServiceInstanceController{
+		Client:     &fakeClient{store: store},
+		KubeClient: kubeClient,
+		ServiceBrokerClientFunc: func(sb *servicebrokerapi.ServiceBroker) (servicebrokerclient.Interface, error) {
+			return servicebrokerclient.NewClient(&servicebrokerclient.Config{URL: broker.URL()})
+		},
+		Binders:            NewBinders(&fakeClient{store: store}, kubeClient),
+		recorder:           &record.FakeRecorder{},
+		nextPoll:           map[string]time.Time{},
+		deprovisionBackoff: backoff,
+	}
+	return c, store
+}
+
+// newTestInstance returns an instance of mysql provisioned with the plan small.
+func newTestInstance(phase backingserviceinstanceapi.BackingServiceInstancePhase) *backingserviceinstanceapi.BackingServiceInstance {
+	bsi := &backingserviceinstanceapi.BackingServiceInstance{
+		ObjectMeta: kapi.ObjectMeta{Name: "db", Namespace: testNamespace},
+	}
+	bsi.Spec.BackingServiceName = "mysql"
+	bsi.Spec.BackingServiceSpecID = "mysql-id"
+	bsi.Spec.BackingServicePlanGuid = "small"
+	bsi.Spec.BackingServicePlanName = "small"
+	bsi.Spec.InstanceID = testInstanceID
+	bsi.Status.Phase = phase
+	bsi.Status.AppliedPlanGuid = "small"
+	return bsi
+}
+
+// handle lets c handle the instance name as it is stored, and returns it as it is stored afterwards.
+func handle(t *testing.T, c *BackingServiceInstanceController, store *fakeStore, name string) (*backingserviceinstanceapi.BackingServiceInstance, error) {
+	bsi, err := c.Client.BackingServiceInstances(testNamespace).Get(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Handle(bsi)
+
+	obj, getErr := store.get("backingserviceinstances", testNamespace, name)
+	if getErr != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceInstance), err
+}
+
+func countRequests(broker *fakebroker.Broker, request string) int {
+	count := 0
+	for _, r := range broker.Requests {
+		if r == request {
+			count++
+		}
+	}
+	return count
+}
+
+func TestDeprovisioning(t *testing.T) {
+	deprovision := "DELETE /v2/service_instances/" + testInstanceID
+
+	tests := []struct {
+		name string
+		// state is how the broker reports the deprovisioning in progress.
+		state string
+		// retryStatus is the status code the broker answers the retried deprovisioning with, if any.
+		retryStatus int
+
+		expectedPhase backingserviceinstanceapi.BackingServiceInstancePhase
+		// expectedRetryState is the state of the last operation once the deprovisioning is retried.
+		expectedRetryState string
+	}{
+		{
+			name:          "succeeded",
+			state:         servicebrokerclient.StateSucceeded,
+			expectedPhase: backingserviceinstanceapi.BackingServiceInstancePhaseDeleted,
+		},
+		{
+			name:               "failed and retried",
+			state:              servicebrokerclient.StateFailed,
+			expectedPhase:      backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning,
+			expectedRetryState: backingserviceinstanceapi.LastOperationStateInProgress,
+		},
+		{
+			name:               "failed and retry refused",
+			state:              servicebrokerclient.StateFailed,
+			retryStatus:        500,
+			expectedPhase:      backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning,
+			expectedRetryState: backingserviceinstanceapi.LastOperationStateFailed,
+		},
+	}
+
+	for _, test := range tests {
+		broker := fakebroker.New(servicebrokerclient.CatalogResponse{})
+		broker.Async = true
+		broker.Start()
+		defer broker.Close()
+		broker.Instances[testInstanceID] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small", Deprovisioning: true}
+		broker.Complete(testInstanceID, test.state)
+
+		c, store := newTestController(broker)
+		bsi := newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning)
+		bsi.Status.Action = backingserviceinstanceapi.BackingServiceInstanceActionToDelete
+		bsi.Status.LastOperation = newLastOperation(fakebroker.Operation, nil)
+		store.add("backingserviceinstances", bsi)
+
+		bsi, _ = handle(t, c, store, "db")
+		if bsi.Status.Phase != test.expectedPhase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.expectedPhase, bsi.Status.Phase)
+			continue
+		}
+
+		if bsi.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseDeleted {
+			if _, err := handle(t, c, store, "db"); err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			if _, err := store.get("backingserviceinstances", testNamespace, "db"); !kerrors.IsNotFound(err) {
+				t.Errorf("%s: expected the instance to be deleted, got %v", test.name, err)
+			}
+			if len(c.nextPoll) > 0 {
+				t.Errorf("%s: expected the polls of the deleted instance to be forgotten, got %v", test.name, c.nextPoll)
+			}
+			continue
+		}
+
+		// the deprovisioning isn't retried before the backoff expires.
+		if bsi, _ = handle(t, c, store, "db"); countRequests(broker, deprovision) > 0 {
+			t.Errorf("%s: expected the deprovisioning to be retried after a backoff", test.name)
+		}
+
+		if test.retryStatus != 0 {
+			broker.Errors[deprovision] = fakebroker.Error{StatusCode: test.retryStatus}
+		}
+		c.deprovisionBackoff.Clock.(*kutil.FakeClock).Step(defaultPollInterval)
+		bsi, err := handle(t, c, store, "db")
+		if countRequests(broker, deprovision) != 1 {
+			t.Errorf("%s: expected the deprovisioning to be retried once, got %v", test.name, broker.Requests)
+		}
+		if bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning || bsi.Status.LastOperation.State != test.expectedRetryState {
+			t.Errorf("%s: expected the instance to be deprovisioning with the last operation %s, got %s %#v", test.name, test.expectedRetryState, bsi.Status.Phase, bsi.Status.LastOperation)
+		}
+		if test.retryStatus != 0 {
+			if err == nil {
+				t.Errorf("%s: expected the refused retry to be reported", test.name)
+			}
+			if backoff := c.deprovisionBackoff.Get(instanceKey(bsi)); backoff != 2*defaultPollInterval {
+				t.Errorf("%s: expected the backoff to double, got %v", test.name, backoff)
+			}
+		}
+	}
+}
+
+func TestUpdateErrors(t *testing.T) {
+	update := "PATCH /v2/service_instances/" + testInstanceID
+
+	tests := []struct {
+		name  string
+		error fakebroker.Error
+
+		expectedPlan string
+		expectedErr  bool
+	}{
+		{
+			name:         "rejected",
+			error:        fakebroker.Error{StatusCode: 400},
+			expectedPlan: "small",
+		},
+		{
+			name:         "server error",
+			error:        fakebroker.Error{StatusCode: 503},
+			expectedPlan: "large",
+			expectedErr:  true,
+		},
+		{
+			name:         "concurrent operation",
+			error:        fakebroker.Error{StatusCode: 422, ErrorResponse: servicebrokerclient.ErrorResponse{Error: "ConcurrencyError"}},
+			expectedPlan: "large",
+			expectedErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		broker := fakebroker.New(servicebrokerclient.CatalogResponse{})
+		broker.Start()
+		defer broker.Close()
+		broker.Instances[testInstanceID] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small"}
+		broker.Errors[update] = test.error
+
+		c, store := newTestController(broker)
+		bsi := newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseUnbound)
+		bsi.Spec.BackingServicePlanGuid = "large"
+		store.add("backingserviceinstances", bsi)
+
+		bsi, err := handle(t, c, store, "db")
+		if (err != nil) != test.expectedErr {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if bsi.Spec.BackingServicePlanGuid != test.expectedPlan || bsi.Status.AppliedPlanGuid != "small" {
+			t.Errorf("%s: expected the plan %s with small applied, got %s with %s applied", test.name, test.expectedPlan, bsi.Spec.BackingServicePlanGuid, bsi.Status.AppliedPlanGuid)
+		}
+		if test.expectedPlan == "small" {
+			continue
+		}
+
+		// the update is sent again once the broker recovers.
+		delete(broker.Errors, update)
+		if bsi, err = handle(t, c, store, "db"); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if bsi.Status.AppliedPlanGuid != "large" || broker.Instances[testInstanceID].PlanID != "large" {
+			t.Errorf("%s: expected the plan large to be applied, got %s at the broker and %s applied", test.name, broker.Instances[testInstanceID].PlanID, bsi.Status.AppliedPlanGuid)
+		}
+	}
+}
+
+func TestCascadeExternalBindings(t *testing.T) {
+	unbind := "DELETE /v2/service_instances/" + testInstanceID + "/service_bindings/app-1"
+
+	broker := fakebroker.New(servicebrokerclient.CatalogResponse{})
+	broker.Start()
+	defer broker.Close()
+	broker.Instances[testInstanceID] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small"}
+	broker.Bindings["app-1"] = &servicebrokerclient.BindRequest{}
+	broker.Errors[unbind] = fakebroker.Error{StatusCode: 500}
+
+	c, store := newTestController(broker)
+	bsi := newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseBound)
+	bsi.Status.Action = backingserviceinstanceapi.BackingServiceInstanceActionToDelete
+	bsi.Spec.Bound = 1
+	bsi.Annotations = map[string]string{backingserviceinstanceapi.CascadeDeletionAnnotation: "true"}
+	backingserviceinstanceapi.SetExternalBindings(bsi, map[string]string{"app-1": "digest"})
+	store.add("backingserviceinstances", bsi)
+
+	// the instance waits for the external binding to be unbound.
+	bsi, _ = handle(t, c, store, "db")
+	if len(backingserviceinstanceapi.ExternalBindings(bsi)) != 1 || bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseBound {
+		t.Fatalf("expected the instance to wait for its external binding, got phase %s and %v", bsi.Status.Phase, bsi.Annotations)
+	}
+
+	delete(broker.Errors, unbind)
+	bsi, err := handle(t, c, store, "db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backingserviceinstanceapi.ExternalBindings(bsi)) != 0 || len(broker.Bindings) != 0 {
+		t.Errorf("expected the external binding to be unbound, got %v and %d bindings at the broker", bsi.Annotations, len(broker.Bindings))
+	}
+	if bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning && bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseDeleted {
+		t.Errorf("expected the instance to be deprovisioned, got phase %s", bsi.Status.Phase)
+	}
+}
+
+// handleBinding lets c handle the binding name as it is stored, and returns it as it is stored afterwards.
+func handleBinding(t *testing.T, c *BackingServiceInstanceController, store *fakeStore, name string) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	binding, err := c.Client.BackingServiceBindings(testNamespace).Get(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.HandleBinding(binding)
+
+	obj, getErr := store.get("backingservicebindings", testNamespace, name)
+	if getErr != nil {
+		return nil, err
+	}
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), err
+}
+
+func TestBind(t *testing.T) {
+	bindings := "/v2/service_instances/" + testInstanceID + "/service_bindings/"
+
+	tests := []struct {
+		name     string
+		kind     string
+		resource string
+
+		expectedPhase  backingserviceinstanceapi.BackingServiceBindingPhase
+		expectedReason string
+		// expectedBinds and expectedUnbinds count the requests the broker gets.
+		expectedBinds   int
+		expectedUnbinds int
+	}{
+		{
+			name:          "bound",
+			kind:          backingserviceinstanceapi.BindKind_PodTemplate,
+			resource:      "web",
+			expectedPhase: backingserviceinstanceapi.BackingServiceBindingPhaseBound,
+			expectedBinds: 1,
+		},
+		{
+			name:          "job",
+			kind:          backingserviceinstanceapi.BindKind_Job,
+			resource:      "web",
+			expectedPhase: backingserviceinstanceapi.BackingServiceBindingPhaseBound,
+			expectedBinds: 1,
+		},
+		{
+			name:           "unsupported kind",
+			kind:           "Pod",
+			resource:       "web",
+			expectedPhase:  backingserviceinstanceapi.BackingServiceBindingPhaseFailed,
+			expectedReason: "UnsupportedBindKind",
+		},
+		{
+			name:            "injection failed",
+			kind:            backingserviceinstanceapi.BindKind_PodTemplate,
+			resource:        "missing",
+			expectedPhase:   backingserviceinstanceapi.BackingServiceBindingPhaseFailed,
+			expectedReason:  "InjectionFailed",
+			expectedBinds:   1,
+			expectedUnbinds: 1,
+		},
+	}
+
+	for _, test := range tests {
+		broker := fakebroker.New(servicebrokerclient.CatalogResponse{})
+		broker.Start()
+		defer broker.Close()
+		broker.Instances[testInstanceID] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small"}
+
+		c, store := newTestController(broker)
+		store.add("backingserviceinstances", newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseUnbound))
+		store.add("podtemplates", &kapi.PodTemplate{
+			ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: testNamespace},
+			Template: kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{Containers: []kapi.Container{{Name: "web"}}},
+			},
+		})
+		store.add("jobs", &extensions.Job{
+			ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: testNamespace},
+			Spec: extensions.JobSpec{
+				Template: kapi.PodTemplateSpec{
+					Spec: kapi.PodSpec{Containers: []kapi.Container{{Name: "web"}}},
+				},
+			},
+		})
+		store.add("backingservicebindings", &backingserviceinstanceapi.BackingServiceBinding{
+			ObjectMeta: kapi.ObjectMeta{Name: "db-web", Namespace: testNamespace},
+			Spec: backingserviceinstanceapi.BackingServiceBindingSpec{
+				BackingServiceInstanceName: "db",
+				BindKind:                   test.kind,
+				ResourceName:               test.resource,
+				Injection:                  backingserviceinstanceapi.BindingInjectionVolume,
+			},
+		})
+
+		binding, _ := handleBinding(t, c, store, "db-web")
+		if binding.Status.Phase != test.expectedPhase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.expectedPhase, binding.Status.Phase)
+		}
+		if len(test.expectedReason) > 0 && (len(binding.Status.Conditions) == 0 || binding.Status.Conditions[0].Reason != test.expectedReason) {
+			t.Errorf("%s: expected reason %s, got %#v", test.name, test.expectedReason, binding.Status.Conditions)
+		}
+
+		binds, unbinds := 0, 0
+		for _, r := range broker.Requests {
+			switch {
+			case strings.HasPrefix(r, "PUT "+bindings):
+				binds++
+			case strings.HasPrefix(r, "DELETE "+bindings):
+				unbinds++
+			}
+		}
+		if binds != test.expectedBinds || unbinds != test.expectedUnbinds {
+			t.Errorf("%s: expected %d binds and %d unbinds, got %v", test.name, test.expectedBinds, test.expectedUnbinds, broker.Requests)
+		}
+
+		secrets := store.list("secrets", testNamespace, nil)
+		if test.expectedPhase == backingserviceinstanceapi.BackingServiceBindingPhaseBound {
+			if len(secrets) != 1 || len(broker.Bindings) != 1 {
+				t.Errorf("%s: expected a binding and its secret, got %d bindings and %d secrets", test.name, len(broker.Bindings), len(secrets))
+			}
+			continue
+		}
+		if len(secrets) != 0 || len(broker.Bindings) != 0 || len(binding.Status.BindUuid) > 0 {
+			t.Errorf("%s: expected nothing to be left of the failed binding, got %d bindings, %d secrets and binding id %q", test.name, len(broker.Bindings), len(secrets), binding.Status.BindUuid)
+		}
+	}
+}
+
+func TestRetriedMigration(t *testing.T) {
+	c, store := newTestController(fakebroker.New(servicebrokerclient.CatalogResponse{}))
+	bsi := newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseBound)
+	bsi.Spec.Binding = []backingserviceinstanceapi.InstanceBinding{
+		{BindDeploymentConfig: "web", BindUuid: "bind-id", CredentialsSecret: "db-bind-id"},
+		{BindKind: backingserviceinstanceapi.BindKind_ReplicationController, BindDeploymentConfig: "Worker"},
+	}
+	store.add("backingserviceinstances", bsi)
+
+	// the instance is migrated again when saving it failed after its bindings were created.
+	for i := 0; i < 2; i++ {
+		bsi, _ := c.Client.BackingServiceInstances(testNamespace).Get("db")
+		if err := c.migrateBindings(bsi); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	bindings := store.list("backingservicebindings", testNamespace, nil)
+	if len(bindings) != 2 {
+		t.Fatalf("expected 2 bindings, got %d", len(bindings))
+	}
+	for _, name := range []string{"db-deploymentconfig-web", "db-replicationcontroller-worker"} {
+		if _, err := store.get("backingservicebindings", testNamespace, name); err != nil {
+			t.Errorf("expected the binding %s: %v", name, err)
+		}
+	}
+}
+
+func TestProvisioningMitigation(t *testing.T) {
+	provision := "PUT /v2/service_instances/" + testInstanceID
+
+	broker := fakebroker.New(servicebrokerclient.CatalogResponse{})
+	broker.Async = true
+	broker.Start()
+	defer broker.Close()
+	// the broker created the instance but failed to tell.
+	broker.Instances[testInstanceID] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small"}
+	broker.Errors[provision] = fakebroker.Error{StatusCode: 500}
+
+	c, store := newTestController(broker)
+	bsi := newTestInstance(backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning)
+	bsi.Status.AppliedPlanGuid = ""
+	store.add("backingserviceinstances", bsi)
+
+	bsi, _ = handle(t, c, store, "db")
+	if op := bsi.Status.LastOperation; op == nil || !op.Mitigation || op.State != backingserviceinstanceapi.LastOperationStateInProgress {
+		t.Fatalf("expected the mitigation to be in progress, got %#v", op)
+	}
+	delete(broker.Errors, provision)
+
+	// provisioning waits for the deprovisioning to be over.
+	c.nextPoll = map[string]time.Time{}
+	if bsi, _ = handle(t, c, store, "db"); bsi.Status.LastOperation == nil || countRequests(broker, provision) != 1 {
+		t.Fatalf("expected provisioning to wait for the mitigation, got %v", broker.Requests)
+	}
+
+	broker.Complete(testInstanceID, servicebrokerclient.StateSucceeded)
+	c.nextPoll = map[string]time.Time{}
+	if bsi, _ = handle(t, c, store, "db"); bsi.Status.LastOperation != nil || countRequests(broker, provision) != 1 {
+		t.Fatalf("expected the mitigation to be over, got %#v and %v", bsi.Status.LastOperation, broker.Requests)
+	}
+
+	bsi, err := handle(t, c, store, "db")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if countRequests(broker, provision) != 2 || !lastOperationInProgress(bsi) || bsi.Status.LastOperation.Mitigation {
+		t.Errorf("expected provisioning to be retried, got %#v and %v", bsi.Status.LastOperation, broker.Requests)
+	}
+}
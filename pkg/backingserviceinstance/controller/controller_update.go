@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+// updatePending returns true if the plan or the parameters of a provisioned bsi have
+// been changed and are not applied at the broker yet.
+func updatePending(bsi *backingserviceinstanceapi.BackingServiceInstance) bool {
+	return lastOperationInProgress(bsi) ||
+		bsi.Status.AppliedPlanGuid != bsi.Spec.BackingServicePlanGuid ||
+		!backingserviceinstanceapi.ParametersEqual(backingserviceinstanceapi.ParametersOf(bsi), bsi.Status.AppliedParameters)
+}
+
+func findServicePlan(bs *backingserviceapi.BackingService, planId string) *backingserviceapi.ServicePlan {
+	for i := range bs.Spec.Plans {
+		if bs.Spec.Plans[i].Id == planId {
+			return &bs.Spec.Plans[i]
+		}
+	}
+	return nil
+}
+
+// updateInstance asks the broker to change the plan and parameters of bsi to the ones in its spec.
+func (c *BackingServiceInstanceController) updateInstance(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	if lastOperationInProgress(bsi) {
+		return c.pollUpdating(bs, bsi)
+	}
+
+	// instances provisioned before plan updates were supported.
+	if bsi.Status.AppliedPlanGuid == "" {
+		bsi.Status.AppliedPlanGuid = bsi.Spec.BackingServicePlanGuid
+		bsi.Status.AppliedParameters = copyParameters(backingserviceinstanceapi.ParametersOf(bsi))
+		return true, nil
+	}
+
+	if bsi.Status.AppliedPlanGuid != bsi.Spec.BackingServicePlanGuid && !bs.Spec.PlanUpdateable {
+		c.rollbackPlan(bsi, fmt.Sprintf("service %s doesn't support plan changes", bs.Name))
+		return true, nil
+	}
+
+	plan := findServicePlan(bs, bsi.Spec.BackingServicePlanGuid)
+	if plan == nil {
+		c.rollbackPlan(bsi, fmt.Sprintf("plan (%s) in bs(%s) not found", bsi.Spec.BackingServicePlanGuid, bs.Name))
+		return true, nil
+	}
+
+	parameters, err := brokerParameters(plan.Schemas.InstanceUpdate, backingserviceinstanceapi.ParametersOf(bsi))
+	if err != nil {
+		if servicebrokerclient.IsRejected(err) {
+			c.rollbackPlan(bsi, err.Error())
+			return true, nil
+		}
+		// the broker may have applied the update, it is kept pending and sent again.
+		c.recorder.Eventf(bsi, "Updating", "bsi plan update to %s failed, retrying: %v", plan.Name, err)
+		return false, err
+	}
+
+	sbclient, err := c.servicebroker_client(bs)
+	if err != nil {
+		return false, err
+	}
+
+	glog.Infoln("bsi updating servicebroker update, ", bsi.Name)
+
+	ctx, cancel := brokerContext()
+	defer cancel()
+	resp, err := sbclient.Update(ctx, bsi.Spec.InstanceID, &servicebrokerclient.UpdateRequest{
+		ServiceID:  bsi.Spec.BackingServiceSpecID,
+		PlanID:     plan.Id,
+		Parameters: parameters,
+		PreviousValues: &servicebrokerclient.PreviousValues{
+			ServiceID:      bsi.Spec.BackingServiceSpecID,
+			PlanID:         bsi.Status.AppliedPlanGuid,
+			OrganizationID: bsi.Namespace,
+		},
+		AcceptsIncomplete: true,
+	})
+	if err != nil {
+		if servicebrokerclient.IsRejected(err) {
+			c.rollbackPlan(bsi, err.Error())
+			return true, nil
+		}
+		// the broker may have applied the update, it is kept pending and sent again.
+		c.recorder.Eventf(bsi, "Updating", "bsi plan update to %s failed, retrying: %v", plan.Name, err)
+		return false, err
+	}
+
+	if resp.Async {
+		bsi.Status.LastOperation = newLastOperation(resp.Operation, nil)
+		c.pollAfter(bsi)
+		c.recorder.Eventf(bsi, "Updating", "bsi plan update to %s accepted by broker", plan.Name)
+		return true, nil
+	}
+
+	c.applyPlan(bsi, plan)
+	return true, nil
+}
+
+// pollUpdating polls an asynchronous plan update, the plan is rolled back if the broker reports a failure.
+func (c *BackingServiceInstanceController) pollUpdating(bs *backingserviceapi.BackingService, bsi *backingserviceinstanceapi.BackingServiceInstance) (bool, error) {
+	lastOperation, gone, err := c.pollLastOperation(bs, bsi)
+	if err != nil || (lastOperation == nil && !gone) {
+		return false, err
+	}
+
+	if gone {
+		lastOperation = &servicebrokerclient.LastOperationResponse{
+			State:       backingserviceinstanceapi.LastOperationStateFailed,
+			Description: "instance is gone at the broker",
+		}
+	}
+
+	changed := updateLastOperation(bsi, lastOperation)
+
+	switch lastOperation.State {
+	case backingserviceinstanceapi.LastOperationStateSucceeded:
+		plan := findServicePlan(bs, bsi.Spec.BackingServicePlanGuid)
+		if plan == nil {
+			plan = &backingserviceapi.ServicePlan{Id: bsi.Spec.BackingServicePlanGuid, Name: bsi.Spec.BackingServicePlanName}
+		}
+		c.applyPlan(bsi, plan)
+		return true, nil
+	case backingserviceinstanceapi.LastOperationStateFailed:
+		c.rollbackPlan(bsi, lastOperation.Description)
+		return true, nil
+	}
+
+	c.pollAfter(bsi)
+	return changed, nil
+}
+
+func (c *BackingServiceInstanceController) applyPlan(bsi *backingserviceinstanceapi.BackingServiceInstance, plan *backingserviceapi.ServicePlan) {
+	glog.Infoln("bsi plan updated ", bsi.Name)
+	c.recorder.Eventf(bsi, "Updating", "bsi plan updated to %s", plan.Name)
+
+	bsi.Spec.BackingServicePlanName = plan.Name
+	bsi.Status.AppliedPlanGuid = plan.Id
+	bsi.Status.AppliedParameters = copyParameters(backingserviceinstanceapi.ParametersOf(bsi))
+	bsi.Status.LastOperation = nil
+}
+
+// rollbackPlan restores the plan and parameters the instance is actually running with.
+func (c *BackingServiceInstanceController) rollbackPlan(bsi *backingserviceinstanceapi.BackingServiceInstance, reason string) {
+	glog.Infoln("bsi plan update failed ", bsi.Name, reason)
+	c.recorder.Eventf(bsi, "Updating", "bsi plan update to %s failed, rolled back to %s: %s",
+		bsi.Spec.BackingServicePlanGuid, bsi.Status.AppliedPlanGuid, reason)
+
+	bsi.Spec.BackingServicePlanGuid = bsi.Status.AppliedPlanGuid
+	bsi.Spec.Parameters = copyParameters(bsi.Status.AppliedParameters)
+	if bsi.Status.LastOperation != nil {
+		bsi.Status.LastOperation.State = backingserviceinstanceapi.LastOperationStateFailed
+		bsi.Status.LastOperation.Description = reason
+	} else {
+		bsi.Status.LastOperation = &backingserviceinstanceapi.LastOperation{
+			State:       backingserviceinstanceapi.LastOperationStateFailed,
+			Description: reason,
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
@@ -21,6 +22,9 @@ type BackingServiceInstanceControllerFactory struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
+	// ServiceBrokerClientFunc returns the clients of the brokers, clients reading the
+	// secrets of the brokers with KubeClient are used if it is nil.
+	ServiceBrokerClientFunc servicebrokerclient.ClientFunc
 }
 
 // Create creates a BackingServiceInstanceControllerFactory.
@@ -44,9 +48,21 @@ func (factory *BackingServiceInstanceControllerFactory) Create() controller.Runn
 	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
 
 	backingserviceInstanceController := &BackingServiceInstanceController{
-		Client:     factory.Client,
-		KubeClient: factory.KubeClient,
-		recorder:   eventBroadcaster.NewRecorder(kapi.EventSource{Component: "bsi"}),
+		Client:                  factory.Client,
+		KubeClient:              factory.KubeClient,
+		ServiceBrokerClientFunc: serviceBrokerClientFunc(factory.ServiceBrokerClientFunc, factory.KubeClient),
+		Binders:                 NewBinders(factory.Client, factory.KubeClient),
+		recorder:                eventBroadcaster.NewRecorder(kapi.EventSource{Component: "bsi"}),
+
+		nextPoll:           map[string]time.Time{},
+		deprovisionBackoff: kutil.NewBackOff(defaultPollInterval, maxDeprovisionBackoff),
+		enqueueAfter: func(bsi *backingserviceinstanceapi.BackingServiceInstance, after time.Duration) {
+			time.AfterFunc(after, func() {
+				if latest, err := factory.Client.BackingServiceInstances(bsi.Namespace).Get(bsi.Name); err == nil {
+					queue.AddIfNotPresent(latest)
+				}
+			})
+		},
 	}
 
 	return &controller.RetryController{
@@ -74,6 +90,65 @@ func (factory *BackingServiceInstanceControllerFactory) Create() controller.Runn
 	}
 }
 
+type BackingServiceBindingControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+	// ServiceBrokerClientFunc returns the clients of the brokers, clients reading the
+	// secrets of the brokers with KubeClient are used if it is nil.
+	ServiceBrokerClientFunc servicebrokerclient.ClientFunc
+}
+
+// Create creates a controller handling BackingServiceBindings.
+func (factory *BackingServiceBindingControllerFactory) Create() controller.RunnableController {
+	backingservicebindingLW := &cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return factory.Client.BackingServiceBindings(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return factory.Client.BackingServiceBindings(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	// pending bindings are handled again on every resync.
+	cache.NewReflector(backingservicebindingLW, &backingserviceinstanceapi.BackingServiceBinding{}, queue, 1*time.Minute).Run()
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	bindingController := &BackingServiceInstanceController{
+		Client:                  factory.Client,
+		KubeClient:              factory.KubeClient,
+		ServiceBrokerClientFunc: serviceBrokerClientFunc(factory.ServiceBrokerClientFunc, factory.KubeClient),
+		Binders:                 NewBinders(factory.Client, factory.KubeClient),
+		recorder:                eventBroadcaster.NewRecorder(kapi.EventSource{Component: "bsb"}),
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				if _, isFatal := err.(fatalError); isFatal {
+					return false
+				}
+				if retries.Count > 0 {
+					return false
+				}
+				return true
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			binding := obj.(*backingserviceinstanceapi.BackingServiceBinding)
+			return bindingController.HandleBinding(binding)
+		},
+	}
+}
+
 /*
 // buildConfigLW is a ListWatcher implementation for BuildConfigs.
 type backingServiceLW struct {
@@ -91,3 +166,10 @@ func (lw *backingServiceLW) Watch(resourceVersion string) (watch.Interface, erro
 }
 
 */
+
+func serviceBrokerClientFunc(clientFunc servicebrokerclient.ClientFunc, kubeClient kclient.Interface) servicebrokerclient.ClientFunc {
+	if clientFunc != nil {
+		return clientFunc
+	}
+	return servicebrokerclient.NewClientFunc(kubeClient)
+}
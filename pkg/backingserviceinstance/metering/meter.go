@@ -0,0 +1,178 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	osclient "github.com/openshift/origin/pkg/client"
+)
+
+// DefaultPeriod is how often instances are metered by default, usages start and end
+// within it.
+const DefaultPeriod = 1 * time.Minute
+
+// Meter records the periods BackingServiceInstances are provisioned with a plan as
+// BackingServiceUsages: a usage starts once an instance is provisioned, and ends once
+// its plan is changed or it is deprovisioned.
+type Meter struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+}
+
+// Run meters the instances every period.
+func (m *Meter) Run(period time.Duration) {
+	go util.Until(func() {
+		if err := m.Sync(); err != nil {
+			util.HandleError(err)
+		}
+	}, period, util.NeverStop)
+}
+
+// Sync starts the usages of the instances provisioned with a plan since the last sync,
+// and ends those of the instances which aren't anymore.
+func (m *Meter) Sync() error {
+	instances, err := m.Client.BackingServiceInstances(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	open, err := m.Client.BackingServiceUsages(kapi.NamespaceAll).List(labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.UsageOpenLabel: "true"}), fields.Everything())
+	if err != nil {
+		return err
+	}
+
+	now := unversioned.Now()
+	errs := []error{}
+	usages := map[string]*backingserviceinstanceapi.BackingServiceUsage{}
+	for i := range open.Items {
+		usage := &open.Items[i]
+		if _, ok := usages[usage.BackingServiceInstanceUID]; ok {
+			// a duplicate left by a failed sync.
+			if err := m.end(usage, now); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		usages[usage.BackingServiceInstanceUID] = usage
+	}
+
+	services := map[string]*backingserviceapi.BackingService{}
+	for i := range instances.Items {
+		bsi := &instances.Items[i]
+		plan, ok := meteredPlan(bsi)
+		if !ok {
+			continue
+		}
+		if usage, ok := usages[string(bsi.UID)]; ok {
+			delete(usages, string(bsi.UID))
+			if usage.BackingServicePlanGuid == plan {
+				continue
+			}
+			if err := m.end(usage, now); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		if err := m.start(bsi, plan, now, services); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, usage := range usages {
+		if err := m.end(usage, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// start starts the usage of plan by bsi, with the costs plan has in its BackingService.
+func (m *Meter) start(bsi *backingserviceinstanceapi.BackingServiceInstance, plan string, now unversioned.Time, services map[string]*backingserviceapi.BackingService) error {
+	usage := &backingserviceinstanceapi.BackingServiceUsage{
+		ObjectMeta: kapi.ObjectMeta{
+			GenerateName: bsi.Name + "-",
+			Namespace:    bsi.Namespace,
+		},
+		BackingServiceInstanceName: bsi.Name,
+		BackingServiceInstanceUID:  string(bsi.UID),
+		BackingServiceName:         bsi.Spec.BackingServiceName,
+		BackingServicePlanGuid:     plan,
+		Start:                      now,
+	}
+	if plan == bsi.Spec.BackingServicePlanGuid {
+		usage.BackingServicePlanName = bsi.Spec.BackingServicePlanName
+	}
+
+	bs, err := m.backingService(bsi, services)
+	if err != nil {
+		return err
+	}
+	if bs != nil {
+		for _, p := range bs.Spec.Plans {
+			if p.Id != plan {
+				continue
+			}
+			usage.BackingServicePlanName = p.Name
+			usage.Free = p.Free
+			for _, cost := range p.Metadata.Costs {
+				usage.Costs = append(usage.Costs, backingserviceinstanceapi.UsageCost{Amount: cost.Amount, Unit: cost.Unit})
+			}
+		}
+	} else {
+		glog.V(2).Infof("backingservice %s of instance %s/%s is gone, its usage has no costs", bsi.Spec.BackingServiceName, bsi.Namespace, bsi.Name)
+	}
+
+	_, err = m.Client.BackingServiceUsages(bsi.Namespace).Create(usage)
+	return err
+}
+
+// end ends usage at now.
+func (m *Meter) end(usage *backingserviceinstanceapi.BackingServiceUsage, now unversioned.Time) error {
+	usage.End = &now
+	_, err := m.Client.BackingServiceUsages(usage.Namespace).Update(usage)
+	return err
+}
+
+// backingService returns the BackingService of bsi, nil if it is gone. services caches
+// the BackingServices of a sync.
+func (m *Meter) backingService(bsi *backingserviceinstanceapi.BackingServiceInstance, services map[string]*backingserviceapi.BackingService) (*backingserviceapi.BackingService, error) {
+	namespace := backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)
+	key := namespace + "/" + bsi.Spec.BackingServiceName
+	if bs, ok := services[key]; ok {
+		return bs, nil
+	}
+
+	bs, err := m.Client.BackingServices(namespace).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		bs = nil
+	}
+	services[key] = bs
+	return bs, nil
+}
+
+// meteredPlan returns the plan bsi is provisioned with, false if it isn't provisioned.
+func meteredPlan(bsi *backingserviceinstanceapi.BackingServiceInstance) (string, bool) {
+	switch bsi.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound,
+		backingserviceinstanceapi.BackingServiceInstancePhaseBound,
+		backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning:
+	default:
+		return "", false
+	}
+	if len(bsi.Status.AppliedPlanGuid) > 0 {
+		return bsi.Status.AppliedPlanGuid, true
+	}
+	return bsi.Spec.BackingServicePlanGuid, true
+}
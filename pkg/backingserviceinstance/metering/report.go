@@ -0,0 +1,104 @@
+package metering
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// unitHours are the hours in the units of time plan costs are given in. Catalogs
+// mostly use MONTHLY, a month is accounted as a twelfth of a year.
+var unitHours = map[string]float64{
+	"HOUR":     1,
+	"HOURLY":   1,
+	"DAY":      24,
+	"DAILY":    24,
+	"WEEK":     24 * 7,
+	"WEEKLY":   24 * 7,
+	"MONTH":    24 * 365 / 12,
+	"MONTHLY":  24 * 365 / 12,
+	"YEAR":     24 * 365,
+	"YEARLY":   24 * 365,
+	"ANNUALLY": 24 * 365,
+}
+
+// hoursPerUnit returns the hours in unit, false if unit isn't a unit of time.
+func hoursPerUnit(unit string) (float64, bool) {
+	unit = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(unit)), "PER ")
+	hours, ok := unitHours[unit]
+	return hours, ok
+}
+
+// Report sums up usages over the period from start to end, usages not ended yet are
+// counted until end. The costs of free plans are ignored, and so are costs in units
+// other than units of time: those are listed as unmetered.
+func Report(usages []backingserviceinstanceapi.BackingServiceUsage, start, end time.Time) backingserviceinstanceapi.BackingServiceUsageReportStatus {
+	plans := map[string]*backingserviceinstanceapi.PlanUsage{}
+	instances := map[string]sets.String{}
+	unmetered := map[string]sets.String{}
+	keys := []string{}
+
+	for i := range usages {
+		usage := &usages[i]
+		from, to := usage.Start.Time, end
+		if usage.End != nil && usage.End.Time.Before(end) {
+			to = usage.End.Time
+		}
+		if from.Before(start) {
+			from = start
+		}
+		if !to.After(from) {
+			continue
+		}
+
+		key := usage.BackingServiceName + "/" + usage.BackingServicePlanGuid
+		plan, ok := plans[key]
+		if !ok {
+			plan = &backingserviceinstanceapi.PlanUsage{
+				BackingServiceName:     usage.BackingServiceName,
+				BackingServicePlanGuid: usage.BackingServicePlanGuid,
+				BackingServicePlanName: usage.BackingServicePlanName,
+				Free:                   usage.Free,
+				Cost:                   map[string]float64{},
+			}
+			plans[key] = plan
+			instances[key] = sets.NewString()
+			unmetered[key] = sets.NewString()
+			keys = append(keys, key)
+		}
+		instances[key].Insert(usage.BackingServiceInstanceUID)
+
+		hours := to.Sub(from).Hours()
+		plan.Hours += hours
+		if usage.Free {
+			continue
+		}
+		for _, cost := range usage.Costs {
+			perUnit, ok := hoursPerUnit(cost.Unit)
+			if !ok {
+				unmetered[key].Insert(cost.Unit)
+				continue
+			}
+			for currency, amount := range cost.Amount {
+				plan.Cost[currency] += amount * hours / perUnit
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	status := backingserviceinstanceapi.BackingServiceUsageReportStatus{Total: map[string]float64{}}
+	for _, key := range keys {
+		plan := plans[key]
+		plan.Instances = instances[key].Len()
+		plan.UnmeteredUnits = unmetered[key].List()
+		for currency, amount := range plan.Cost {
+			status.Total[currency] += amount
+		}
+		status.Plans = append(status.Plans, *plan)
+	}
+	return status
+}
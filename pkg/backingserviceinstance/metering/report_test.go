@@ -0,0 +1,88 @@
+package metering
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+func usageOf(uid, plan string, free bool, start time.Time, end *time.Time, costs ...backingserviceinstanceapi.UsageCost) backingserviceinstanceapi.BackingServiceUsage {
+	usage := backingserviceinstanceapi.BackingServiceUsage{
+		BackingServiceInstanceUID: uid,
+		BackingServiceName:        "mysql",
+		BackingServicePlanGuid:    plan,
+		BackingServicePlanName:    plan,
+		Free:                      free,
+		Costs:                     costs,
+		Start:                     unversioned.NewTime(start),
+	}
+	if end != nil {
+		t := unversioned.NewTime(*end)
+		usage.End = &t
+	}
+	return usage
+}
+
+func TestReport(t *testing.T) {
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(730 * time.Hour)
+	monthly := backingserviceinstanceapi.UsageCost{Amount: map[string]float64{"usd": 73}, Unit: "MONTHLY"}
+	perGB := backingserviceinstanceapi.UsageCost{Amount: map[string]float64{"usd": 1}, Unit: "GB"}
+	before := start.Add(-10 * time.Hour)
+	tenHours := start.Add(10 * time.Hour)
+
+	usages := []backingserviceinstanceapi.BackingServiceUsage{
+		// clipped to the start of the period, ended after 10 hours.
+		usageOf("a", "large", false, before, &tenHours, monthly, perGB),
+		// not ended, counted until the end of the period.
+		usageOf("b", "large", false, end.Add(-20*time.Hour), nil, monthly),
+		// ended before the period.
+		usageOf("c", "large", false, before, &before, monthly),
+		usageOf("a", "free", true, tenHours, nil, monthly),
+	}
+
+	status := Report(usages, start, end)
+	if len(status.Plans) != 2 {
+		t.Fatalf("expected 2 plans, got %#v", status.Plans)
+	}
+
+	free := status.Plans[0]
+	if free.BackingServicePlanGuid != "free" || free.Instances != 1 || free.Hours != 720 || len(free.Cost) != 0 {
+		t.Errorf("unexpected free plan usage %#v", free)
+	}
+
+	large := status.Plans[1]
+	if large.Instances != 2 || large.Hours != 30 {
+		t.Errorf("expected 2 instances for 30 hours, got %#v", large)
+	}
+	if cost := large.Cost["usd"]; math.Abs(cost-3) > 1e-9 {
+		t.Errorf("expected a cost of 3 usd, got %v", cost)
+	}
+	if !reflect.DeepEqual(large.UnmeteredUnits, []string{"GB"}) {
+		t.Errorf("expected GB to be unmetered, got %v", large.UnmeteredUnits)
+	}
+	if total := status.Total["usd"]; math.Abs(total-3) > 1e-9 {
+		t.Errorf("expected a total of 3 usd, got %v", total)
+	}
+}
+
+func TestHoursPerUnit(t *testing.T) {
+	tests := map[string]float64{
+		"MONTHLY":   730,
+		"per month": 730,
+		"Hourly":    1,
+	}
+	for unit, expected := range tests {
+		if hours, ok := hoursPerUnit(unit); !ok || hours != expected {
+			t.Errorf("%s: expected %v hours, got %v", unit, expected, hours)
+		}
+	}
+	if _, ok := hoursPerUnit("GB"); ok {
+		t.Errorf("expected GB not to be a unit of time")
+	}
+}
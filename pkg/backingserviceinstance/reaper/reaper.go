@@ -0,0 +1,53 @@
+package reaper
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client"
+)
+
+// NewBackingServiceInstanceReaper returns a new reaper for BackingServiceInstances.
+func NewBackingServiceInstanceReaper(oc client.Interface) kubectl.Reaper {
+	return &BackingServiceInstanceReaper{oc: oc}
+}
+
+// BackingServiceInstanceReaper deletes a BackingServiceInstance together with its
+// bindings. It is only used when the cascade is asked for explicitly, with
+// oc delete-backingserviceinstance --unbind, never as the reaper of oc delete
+// which cascades by default.
+type BackingServiceInstanceReaper struct {
+	oc client.Interface
+}
+
+// Stop marks the instance for a cascading deletion and deletes it. The controller
+// unbinds its bindings before deprovisioning it.
+func (reaper *BackingServiceInstanceReaper) Stop(namespace, name string, timeout time.Duration, gracePeriod *kapi.DeleteOptions) (string, error) {
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		bsi, err := reaper.oc.BackingServiceInstances(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if bsi.Annotations[backingserviceinstanceapi.CascadeDeletionAnnotation] == "true" {
+			return nil
+		}
+		if bsi.Annotations == nil {
+			bsi.Annotations = map[string]string{}
+		}
+		bsi.Annotations[backingserviceinstanceapi.CascadeDeletionAnnotation] = "true"
+		_, err = reaper.oc.BackingServiceInstances(namespace).Update(bsi)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := reaper.oc.BackingServiceInstances(namespace).Delete(name); err != nil {
+		return "", err
+	}
+	return "", nil
+}
@@ -0,0 +1,177 @@
+package etcd
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+	backingservicebinding "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingservicebinding"
+)
+
+const BackingServiceBindingPath = "/backingservicebindings"
+
+type REST struct {
+	store *etcdgeneric.Etcd
+
+	instances       rest.Getter
+	backingServices rest.Getter
+}
+
+// NewREST returns a new REST, instances and backingServices are used to validate
+// the parameters of new bindings.
+func NewREST(s storage.Interface, instances rest.Getter, backingServices rest.Getter) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc: func() runtime.Object {
+			return &backingserviceinstanceapi.BackingServiceBinding{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &backingserviceinstanceapi.BackingServiceBindingList{}
+		},
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, BackingServiceBindingPath)
+		},
+		KeyFunc: func(ctx kapi.Context, id string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, BackingServiceBindingPath, id)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*backingserviceinstanceapi.BackingServiceBinding).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return backingservicebinding.Matcher(label, field)
+		},
+		EndpointName: "backingservicebinding",
+
+		CreateStrategy: backingservicebinding.BindingStrategy,
+		UpdateStrategy: backingservicebinding.BindingStrategy,
+
+		ReturnDeletedObject: false,
+
+		Storage: s,
+	}
+
+	return &REST{store: store, instances: instances, backingServices: backingServices}
+}
+
+func (r *REST) New() runtime.Object {
+	return r.store.NewFunc()
+}
+
+func (r *REST) NewList() runtime.Object {
+	return r.store.NewListFunc()
+}
+
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return r.store.Get(ctx, name)
+}
+
+func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return r.store.List(ctx, label, field)
+}
+
+// Create creates a binding, unless the resource is already bound to the instance.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	binding, ok := obj.(*backingserviceinstanceapi.BackingServiceBinding)
+	if !ok {
+		return nil, fmt.Errorf("not a backingservicebinding: %#v", obj)
+	}
+
+	existing, err := r.Find(ctx, binding.Spec.BackingServiceInstanceName, binding.Spec.BindKind, binding.Spec.ResourceName)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, kerrors.NewAlreadyExists("backingservicebinding", existing.Name)
+	}
+
+	if err := r.validateParameters(ctx, binding); err != nil {
+		return nil, err
+	}
+
+	return r.store.Create(ctx, obj)
+}
+
+func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
+
+// Delete marks a binding sent to the broker to be unbound by the controller, which
+// deletes it again once done. Bindings which never got a binding id are deleted at once.
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	obj, err := r.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	binding := obj.(*backingserviceinstanceapi.BackingServiceBinding)
+
+	if binding.DeletionTimestamp.IsZero() && len(binding.Status.BindUuid) > 0 {
+		now := unversioned.Now()
+		binding.DeletionTimestamp = &now
+		binding.Status.Phase = backingserviceinstanceapi.BackingServiceBindingPhaseUnbinding
+
+		result, _, err := r.store.Update(ctx, binding)
+		return result, err
+	}
+
+	return r.store.Delete(ctx, name, options)
+}
+
+func (r *REST) Watch(ctx kapi.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return r.store.Watch(ctx, label, field, resourceVersion)
+}
+
+// validateParameters validates the parameters of binding against the plan of its
+// instance. Bindings of instances not created yet are checked when they are bound.
+func (r *REST) validateParameters(ctx kapi.Context, binding *backingserviceinstanceapi.BackingServiceBinding) error {
+	bsiObj, err := r.instances.Get(ctx, binding.Spec.BackingServiceInstanceName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	bsi := bsiObj.(*backingserviceinstanceapi.BackingServiceInstance)
+
+	bsObj, err := r.backingServices.Get(kapi.WithNamespace(ctx, backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)), bsi.Spec.BackingServiceName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if errs := validation.ValidateBackingServiceBindingParameters(binding, bsi, bsObj.(*backingserviceapi.BackingService)); len(errs) > 0 {
+		return kerrors.NewInvalid("BackingServiceBinding", binding.Name, errs)
+	}
+	return nil
+}
+
+// Find returns the binding of the resource name of kind to the instance bsi.
+func (r *REST) Find(ctx kapi.Context, bsi, kind, name string) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: bsi})
+	obj, err := r.store.List(ctx, selector, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	list := obj.(*backingserviceinstanceapi.BackingServiceBindingList)
+	key := backingserviceinstanceapi.BindingAnnotationKey(kind, name)
+	for i := range list.Items {
+		if backingserviceinstanceapi.BindingAnnotationKey(list.Items[i].Spec.BindKind, list.Items[i].Spec.ResourceName) == key {
+			return &list.Items[i], nil
+		}
+	}
+	return nil, kerrors.NewNotFound("backingservicebinding", key)
+}
@@ -0,0 +1,83 @@
+package backingservicebinding
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+
+	"github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+)
+
+// Strategy implements behavior for BackingServiceBindings
+type Strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// BindingStrategy is the default logic that applies when creating and updating
+// BackingServiceBinding objects via the REST API.
+var BindingStrategy = Strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true, bindings live with the instance they bind.
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate labels a binding with its instance, so that the bindings
+// of an instance can be listed, and defaults its injection.
+func (Strategy) PrepareForCreate(obj runtime.Object) {
+	binding := obj.(*api.BackingServiceBinding)
+	labelWithInstance(binding)
+	if len(binding.Spec.Injection) == 0 {
+		binding.Spec.Injection = api.DefaultBindingInjection(binding.Spec.BindKind)
+	}
+}
+
+func (Strategy) PrepareForUpdate(obj, old runtime.Object) {
+	labelWithInstance(obj.(*api.BackingServiceBinding))
+}
+
+func labelWithInstance(binding *api.BackingServiceBinding) {
+	if binding.Labels == nil {
+		binding.Labels = map[string]string{}
+	}
+	binding.Labels[api.BackingServiceInstanceLabel] = binding.Spec.BackingServiceInstanceName
+}
+
+// Validate validates a new binding
+func (Strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateBackingServiceBinding(obj.(*api.BackingServiceBinding))
+}
+
+// AllowCreateOnUpdate is false for bindings
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for a binding
+func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateBackingServiceBindingUpdate(obj.(*api.BackingServiceBinding), old.(*api.BackingServiceBinding))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: getAttrs}
+}
+
+func getAttrs(obj runtime.Object) (objLabels labels.Set, objFields fields.Set, err error) {
+	binding, ok := obj.(*api.BackingServiceBinding)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a BackingServiceBinding")
+	}
+	return labels.Set(binding.Labels), api.BackingServiceBindingToSelectableFields(binding), nil
+}
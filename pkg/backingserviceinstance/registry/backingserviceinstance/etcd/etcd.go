@@ -4,9 +4,9 @@ import (
 	"errors"
 	"fmt"
 
-	//"k8s.io/kubernetes/pkg/api/rest"
-
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
@@ -20,7 +20,10 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 
 	//backingserviceregistry "github.com/openshift/origin/pkg/backingservice/registry"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+	bindingetcd "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingservicebinding/etcd"
 	backingserviceinstanceregistry "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceinstance"
 	//backingserviceinstancecontroller "github.com/openshift/origin/pkg/backingserviceinstance/controller"
 	deployconfigregistry "github.com/openshift/origin/pkg/deploy/registry/deployconfig"
@@ -35,9 +38,14 @@ type BackingServiceInstanceStorage struct {
 
 type REST struct {
 	store *etcdgeneric.Etcd
+
+	backingServices rest.Getter
 }
 
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a new REST, backingServices resolves the BackingServices of new
+// instances and is used to validate plans.
+func NewREST(s storage.Interface, backingServices rest.Getter) *REST {
+	strategy := backingserviceinstanceregistry.NewStrategy(backingServices)
 	store := &etcdgeneric.Etcd{
 		NewFunc: func() runtime.Object {
 			bsi := &backingserviceinstanceapi.BackingServiceInstance{}
@@ -61,15 +69,15 @@ func NewREST(s storage.Interface) *REST {
 		},
 		EndpointName: "backingserviceinstance",
 
-		CreateStrategy: backingserviceinstanceregistry.BsiStrategy,
-		UpdateStrategy: backingserviceinstanceregistry.BsiStrategy,
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
 
 		ReturnDeletedObject: false,
 
 		Storage: s,
 	}
 
-	return &REST{store: store}
+	return &REST{store: store, backingServices: backingServices}
 }
 
 func (r *REST) New() runtime.Object {
@@ -88,7 +96,25 @@ func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Select
 	return r.store.List(ctx, label, field)
 }
 
+// Create resolves the BackingService of the instance, one of its namespace or a shared
+// one visible to it, and validates the plan against it.
 func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	bsi, ok := obj.(*backingserviceinstanceapi.BackingServiceInstance)
+	if !ok {
+		return nil, fmt.Errorf("not a backingserviceinstance: %#v", obj)
+	}
+
+	bsObj, err := r.backingServices.Get(ctx, bsi.Spec.BackingServiceName)
+	if err != nil {
+		return nil, err
+	}
+	bs := bsObj.(*backingserviceapi.BackingService)
+
+	if errs := validation.ValidateBackingServiceInstancePlan(bsi, bs); len(errs) > 0 {
+		return nil, kerrors.NewInvalid("BackingServiceInstance", bsi.Name, errs)
+	}
+	bsi.Spec.BackingServiceNamespace = bs.Namespace
+
 	return r.store.Create(ctx, obj)
 }
 
@@ -103,13 +129,9 @@ func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions
 	}
 	bsi := bsiObj.(*backingserviceinstanceapi.BackingServiceInstance)
 
-
-	if len(bsi.Annotations) > 0 {
-		for dc, bound := range bsi.Annotations {
-			if bound == backingserviceinstanceapi.BindDeploymentConfigBound {
-				return nil, fmt.Errorf("'%s' is bound to this instance, unbind it first.", dc)
-			}
-		}
+	// a cascading deletion unbinds the bound resources first.
+	if bsi.Spec.Bound > 0 && bsi.Annotations[backingserviceinstanceapi.CascadeDeletionAnnotation] != "true" {
+		return nil, fmt.Errorf("%d resources are bound to this instance, unbind them first or delete it with oc delete-backingserviceinstance --unbind.", bsi.Spec.Bound)
 	}
 
 	if bsi.DeletionTimestamp.IsZero() {
@@ -131,88 +153,75 @@ func (r *REST) Watch(ctx kapi.Context, label labels.Selector, field fields.Selec
 
 //============================================
 
-func NewBindingREST(bsir backingserviceinstanceregistry.Registry, dcr deployconfigregistry.Registry) *BindingREST {
+func NewBindingREST(bsir backingserviceinstanceregistry.Registry, dcr deployconfigregistry.Registry, bindings *bindingetcd.REST) *BindingREST {
 	return &BindingREST{
 		backingServiceInstanceRegistry: bsir,
 		deployConfigRegistry:           dcr,
+		bindings:                       bindings,
 	}
 }
 
+// BindingREST is the binding subresource of backingserviceinstances, kept for
+// clients predating BackingServiceBindings. It creates and deletes those.
 type BindingREST struct {
 	backingServiceInstanceRegistry backingserviceinstanceregistry.Registry
 	deployConfigRegistry           deployconfigregistry.Registry
-	//store *etcdgeneric.Etcd
+	bindings                       *bindingetcd.REST
 }
 
 func (r *BindingREST) New() runtime.Object {
 	return &backingserviceinstanceapi.BindingRequestOptions{}
 }
 
+// Create binds a resource to the instance, creating a BackingServiceBinding.
 func (r *BindingREST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
 	glog.Infoln("to create a bsi binding.")
 
-	//if err := rest.BeforeCreate(backingserviceinstanceregistry.BsiStrategy, ctx, obj); err != nil {
-	//	return nil, err
-	//}
-
 	bro, ok := obj.(*backingserviceinstanceapi.BindingRequestOptions)
-	if !ok || bro.BindKind != backingserviceinstanceapi.BindKind_DeploymentConfig {
-		return nil, fmt.Errorf("unsupported bind type: %s", bro.BindKind)
+	if !ok {
+		return nil, fmt.Errorf("not a BindingRequestOptions: %#v", obj)
+	}
+	if errs := validation.ValidateBackingServiceInstanceBindingRequestOptions(bro); len(errs) > 0 {
+		return nil, kerrors.NewInvalid("BindingRequestOptions", bro.Name, errs)
 	}
 	// todo: check bro.BindResourceVersion
 
-	//kapi.FillObjectMetaSystemFields(ctx, &bro.ObjectMeta)
-
 	bsi, err := r.backingServiceInstanceRegistry.GetBackingServiceInstance(ctx, bro.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	if bound := bsi.Annotations[bro.ResourceName]; bound == backingserviceinstanceapi.BindDeploymentConfigBound {
-		return nil, fmt.Errorf("'%s' is already bound to this instance.", bro.ResourceName)
-	}
-	/*
-		if bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseUnbound {
-			return nil, errors.New("back service instance is not in unbound phase")
+	// resources of the other kinds are looked up by the controller when binding.
+	if bro.BindKind == backingserviceinstanceapi.BindKind_DeploymentConfig {
+		if _, err := r.deployConfigRegistry.GetDeploymentConfig(ctx, bro.ResourceName); err != nil {
+			return nil, err
 		}
-	*/
-
-	//bs, err := r.backingServiceRegistry.GetBackingService(ctx, bsi.Spec.BackingServiceName)
-	//if err != nil {
-	//	return nil, err
-	//}
-
-	dc, err := r.deployConfigRegistry.GetDeploymentConfig(ctx, bro.ResourceName)
-	if err != nil {
-		return nil, err
 	}
-	_ = dc
 
-	// update bsi
-
-	//need debug....bsi.Spec.BindDeploymentConfig = bro.ResourceName // dc.Name
-
-	if bsi.Annotations == nil {
-		bsi.Annotations = map[string]string{}
+	binding := &backingserviceinstanceapi.BackingServiceBinding{
+		ObjectMeta: kapi.ObjectMeta{
+			GenerateName: bsi.Name + "-",
+		},
+		Spec: backingserviceinstanceapi.BackingServiceBindingSpec{
+			BackingServiceInstanceName: bsi.Name,
+			BindKind:                   bro.BindKind,
+			ResourceName:               bro.ResourceName,
+			Injection:                  bro.Injection,
+			MountPath:                  bro.MountPath,
+		},
 	}
-	bsi.Annotations[bro.ResourceName] = backingserviceinstanceapi.BindDeploymentConfigBinding
-
-	bsi.Status.Action = backingserviceinstanceapi.BackingServiceInstanceActionToBind
-
-	bsi, err = r.backingServiceInstanceRegistry.UpdateBackingServiceInstance(ctx, bsi)
-	if err != nil {
+	if _, err := r.bindings.Create(ctx, binding); err != nil {
 		return nil, err
 	}
 
-	// ...
-
 	return bsi, nil
 }
 
+// Update unbinds a resource from the instance, deleting its BackingServiceBinding.
 func (r *BindingREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
 	bro, ok := obj.(*backingserviceinstanceapi.BindingRequestOptions)
-	if !ok || bro.BindKind != backingserviceinstanceapi.BindKind_DeploymentConfig {
-		return nil, false, fmt.Errorf("unsupported bind type: '%s'", bro.BindKind)
+	if !ok {
+		return nil, false, fmt.Errorf("not a BindingRequestOptions: %#v", obj)
 	}
 
 	bsi, err := r.backingServiceInstanceRegistry.GetBackingServiceInstance(ctx, bro.Name)
@@ -220,19 +229,20 @@ func (r *BindingREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Obje
 		return nil, false, err
 	}
 
-	if bound, ok := bsi.Annotations[bro.ResourceName]; !ok || bound == "unbound"/*unbound should never happen.*/ {
-		return nil, false, fmt.Errorf("DeploymentConfig '%s' not bound to this instance yet.", bro.ResourceName)
-	} else {
-		bsi.Annotations[bro.ResourceName] = backingserviceinstanceapi.BindDeploymentConfigUnbinding
-		bsi.Status.Action = backingserviceinstanceapi.BackingServiceInstanceActionToUnbind
-	}
-	bsi, err = r.backingServiceInstanceRegistry.UpdateBackingServiceInstance(ctx, bsi)
+	binding, err := r.bindings.Find(ctx, bsi.Name, bro.BindKind, bro.ResourceName)
 	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, false, fmt.Errorf("%s '%s' not bound to this instance yet.", bro.BindKind, bro.ResourceName)
+		}
+		return nil, false, err
+	}
+	if _, err := r.bindings.Delete(ctx, binding.Name, nil); err != nil {
 		return nil, false, err
 	}
 	return bsi, true, nil
 }
 
+// Delete unbinds everything bound to the instance.
 func (r *BindingREST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
 	glog.Infoln("to delete a bsi binding")
 
@@ -241,17 +251,20 @@ func (r *BindingREST) Delete(ctx kapi.Context, name string, options *kapi.Delete
 		return nil, err
 	}
 
-	if bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseBound {
-		return nil, errors.New("back service instance is not bound yet")
-	}
-
-	bsi.Status.Action = backingserviceinstanceapi.BackingServiceInstanceActionToUnbind
-	//bsi.Annotations[]
-
-	bsi, err = r.backingServiceInstanceRegistry.UpdateBackingServiceInstance(ctx, bsi)
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: bsi.Name})
+	obj, err := r.bindings.List(ctx, selector, fields.Everything())
 	if err != nil {
 		return nil, err
 	}
+	bindings := obj.(*backingserviceinstanceapi.BackingServiceBindingList)
+	if len(bindings.Items) == 0 {
+		return nil, errors.New("back service instance is not bound yet")
+	}
+	for _, binding := range bindings.Items {
+		if _, err := r.bindings.Delete(ctx, binding.Name, nil); err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
 
 	return bsi, nil
 }
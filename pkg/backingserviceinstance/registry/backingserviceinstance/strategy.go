@@ -4,25 +4,32 @@ import (
 	"fmt"
 	
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	"github.com/openshift/origin/pkg/backingserviceinstance/api"
-	//"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
 )
 
 // sdnStrategy implements behavior for HostSubnets
 type Strategy struct {
 	runtime.ObjectTyper
 	kapi.NameGenerator
+
+	backingServices rest.Getter
 }
 
-// Strategy is the default logic that applies when creating and updating HostSubnet
-// objects via the REST API.
-var BsiStrategy = Strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+// NewStrategy returns the logic that applies when creating and updating
+// BackingServiceInstances via the REST API, backingServices resolves the
+// BackingServices plan changes are validated against.
+func NewStrategy(backingServices rest.Getter) Strategy {
+	return Strategy{kapi.Scheme, kapi.SimpleNameGenerator, backingServices}
+}
 
 func (Strategy) PrepareForUpdate(obj, old runtime.Object) {}
 
@@ -59,13 +66,28 @@ func (Strategy) CheckGracefulDelete(obj runtime.Object, options *kapi.DeleteOpti
 	return false
 }
 
-// ValidateUpdate is the default update validation for a HostSubnet
-func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
-	return fielderrors.ValidationErrorList{}
-	// todo
-	// ldBsi := old.(*api.BackingServiceInstance)
-	// objBsi := obj.(*api.BackingServiceInstance)
-	// return validation.ValidateBackingServiceInstance(objBsi, ldBsi)
+// ValidateUpdate validates a change of the plan or the parameters of an instance
+// against the BackingService it is provisioned from.
+func (s Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	bsi, older := obj.(*api.BackingServiceInstance), old.(*api.BackingServiceInstance)
+
+	planChanged := bsi.Spec.BackingServicePlanGuid != older.Spec.BackingServicePlanGuid
+	parametersChanged := !api.ParametersEqual(api.ParametersOf(bsi), api.ParametersOf(older))
+	if !planChanged && !parametersChanged {
+		return fielderrors.ValidationErrorList{}
+	}
+
+	bsObj, err := s.backingServices.Get(ctx, bsi.Spec.BackingServiceName)
+	if err != nil {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldNotFound("spec.backingservice_name", bsi.Spec.BackingServiceName)}
+	}
+	bs := bsObj.(*backingserviceapi.BackingService)
+	if bs.Namespace != api.BackingServiceNamespaceOf(older) {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldNotFound("spec.backingservice_name", bsi.Spec.BackingServiceName)}
+	}
+
+	errs := validation.ValidateBackingServiceInstancePlanUpdate(bsi, older, bs)
+	return append(errs, validation.ValidateBackingServiceInstanceParametersUpdate(bsi, older, bs)...)
 }
 
 // Matcher returns a generic matcher for a given label and field selector.
@@ -0,0 +1,86 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	backingserviceusage "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceusage"
+)
+
+const BackingServiceUsagePath = "/backingserviceusages"
+
+type REST struct {
+	store *etcdgeneric.Etcd
+}
+
+// NewREST returns a new REST.
+func NewREST(s storage.Interface) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc: func() runtime.Object {
+			return &backingserviceinstanceapi.BackingServiceUsage{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &backingserviceinstanceapi.BackingServiceUsageList{}
+		},
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, BackingServiceUsagePath)
+		},
+		KeyFunc: func(ctx kapi.Context, id string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, BackingServiceUsagePath, id)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*backingserviceinstanceapi.BackingServiceUsage).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return backingserviceusage.Matcher(label, field)
+		},
+		EndpointName: "backingserviceusage",
+
+		CreateStrategy: backingserviceusage.UsageStrategy,
+		UpdateStrategy: backingserviceusage.UsageStrategy,
+
+		ReturnDeletedObject: false,
+
+		Storage: s,
+	}
+	return &REST{store: store}
+}
+
+func (r *REST) New() runtime.Object {
+	return r.store.NewFunc()
+}
+
+func (r *REST) NewList() runtime.Object {
+	return r.store.NewListFunc()
+}
+
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return r.store.Get(ctx, name)
+}
+
+func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return r.store.List(ctx, label, field)
+}
+
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	return r.store.Create(ctx, obj)
+}
+
+func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
+
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	return r.store.Delete(ctx, name, options)
+}
+
+func (r *REST) Watch(ctx kapi.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return r.store.Watch(ctx, label, field, resourceVersion)
+}
@@ -0,0 +1,77 @@
+package backingserviceusage
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+
+	"github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+)
+
+// Strategy implements behavior for BackingServiceUsages
+type Strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// UsageStrategy is the default logic that applies when creating and updating
+// BackingServiceUsage objects via the REST API.
+var UsageStrategy = Strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true, usages are accounted to the project of their instance.
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate labels a usage with its instance, and as open until its period ends.
+func (Strategy) PrepareForCreate(obj runtime.Object) {
+	labelUsage(obj.(*api.BackingServiceUsage))
+}
+
+func (Strategy) PrepareForUpdate(obj, old runtime.Object) {
+	labelUsage(obj.(*api.BackingServiceUsage))
+}
+
+func labelUsage(usage *api.BackingServiceUsage) {
+	if usage.Labels == nil {
+		usage.Labels = map[string]string{}
+	}
+	usage.Labels[api.BackingServiceInstanceLabel] = usage.BackingServiceInstanceName
+	if usage.End == nil {
+		usage.Labels[api.UsageOpenLabel] = "true"
+	} else {
+		delete(usage.Labels, api.UsageOpenLabel)
+	}
+}
+
+// Validate validates a new usage
+func (Strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateBackingServiceUsage(obj.(*api.BackingServiceUsage))
+}
+
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for a usage
+func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateBackingServiceUsageUpdate(obj.(*api.BackingServiceUsage), old.(*api.BackingServiceUsage))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: getAttrs}
+}
+
+func getAttrs(obj runtime.Object) (objLabels labels.Set, objFields fields.Set, err error) {
+	usage := obj.(*api.BackingServiceUsage)
+	return labels.Set(usage.Labels), api.BackingServiceUsageToSelectableFields(usage), nil
+}
@@ -0,0 +1,63 @@
+package backingserviceusagereport
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/backingserviceinstance/api/validation"
+	"github.com/openshift/origin/pkg/backingserviceinstance/metering"
+)
+
+// REST computes the BackingServiceUsageReports of a project from its usages.
+type REST struct {
+	usages rest.Lister
+}
+
+// NewREST returns a new REST, usages lists the BackingServiceUsages of a project.
+func NewREST(usages rest.Lister) *REST {
+	return &REST{usages: usages}
+}
+
+func (r *REST) New() runtime.Object {
+	return &backingserviceinstanceapi.BackingServiceUsageReport{}
+}
+
+// Create returns the report with the usage of the period it asks for.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	report, ok := obj.(*backingserviceinstanceapi.BackingServiceUsageReport)
+	if !ok {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("not a backingServiceUsageReport: %#v", obj))
+	}
+	namespace := kapi.NamespaceValue(ctx)
+	if len(namespace) == 0 {
+		return nil, kerrors.NewBadRequest("namespace is required on this type")
+	}
+	// reports aren't stored, they are named after their project unless asked otherwise.
+	report.Namespace = namespace
+	if len(report.Name) == 0 {
+		report.Name = namespace
+	}
+	if errs := validation.ValidateBackingServiceUsageReport(report); len(errs) > 0 {
+		return nil, kerrors.NewInvalid("BackingServiceUsageReport", report.Name, errs)
+	}
+
+	list, err := r.usages.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	end := time.Now()
+	if report.Spec.End != nil {
+		end = report.Spec.End.Time
+	}
+
+	report.Status = metering.Report(list.(*backingserviceinstanceapi.BackingServiceUsageList).Items, report.Spec.Start.Time, end)
+	return report, nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+// ApplicationPromotionsNamespacer has methods to work with ApplicationPromotion resources in a namespace
+type ApplicationPromotionsNamespacer interface {
+	ApplicationPromotions(namespace string) ApplicationPromotionInterface
+}
+
+// ApplicationPromotionInterface exposes methods on ApplicationPromotion resources.
+type ApplicationPromotionInterface interface {
+	Create(p *applicationapi.ApplicationPromotion) (*applicationapi.ApplicationPromotion, error)
+	Delete(name string) error
+	Update(p *applicationapi.ApplicationPromotion) (*applicationapi.ApplicationPromotion, error)
+	Get(name string) (*applicationapi.ApplicationPromotion, error)
+	List(label labels.Selector, field fields.Selector) (*applicationapi.ApplicationPromotionList, error)
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+type applicationPromotions struct {
+	r  *Client
+	ns string
+}
+
+// newApplicationPromotions returns an applicationPromotions
+func newApplicationPromotions(c *Client, namespace string) *applicationPromotions {
+	return &applicationPromotions{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Get returns information about a particular promotion or an error
+func (c *applicationPromotions) Get(name string) (result *applicationapi.ApplicationPromotion, err error) {
+	result = &applicationapi.ApplicationPromotion{}
+	err = c.r.Get().Namespace(c.ns).Resource("applicationPromotions").Name(name).Do().Into(result)
+	return
+}
+
+// List returns all promotions matching the label selector
+func (c *applicationPromotions) List(label labels.Selector, field fields.Selector) (result *applicationapi.ApplicationPromotionList, err error) {
+	result = &applicationapi.ApplicationPromotionList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("applicationPromotions").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Create creates a new promotion
+func (c *applicationPromotions) Create(p *applicationapi.ApplicationPromotion) (result *applicationapi.ApplicationPromotion, err error) {
+	result = &applicationapi.ApplicationPromotion{}
+	err = c.r.Post().Namespace(c.ns).Resource("applicationPromotions").Body(p).Do().Into(result)
+	return
+}
+
+// Update updates the promotion on server
+func (c *applicationPromotions) Update(p *applicationapi.ApplicationPromotion) (result *applicationapi.ApplicationPromotion, err error) {
+	result = &applicationapi.ApplicationPromotion{}
+	err = c.r.Put().Namespace(c.ns).Resource("applicationPromotions").Name(p.Name).Body(p).Do().Into(result)
+	return
+}
+
+// Delete deletes the promotion, the items it promoted are kept
+func (c *applicationPromotions) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("applicationPromotions").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested promotions
+func (c *applicationPromotions) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("applicationPromotions").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
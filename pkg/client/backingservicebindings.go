@@ -0,0 +1,89 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// BackingServiceBindingsNamespacer has methods to work with BackingServiceBinding resources in a namespace
+type BackingServiceBindingsNamespacer interface {
+	BackingServiceBindings(namespace string) BackingServiceBindingInterface
+}
+
+// BackingServiceBindingInterface exposes methods on BackingServiceBinding resources.
+type BackingServiceBindingInterface interface {
+	Create(b *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error)
+	Delete(name string) error
+	Update(b *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error)
+	Get(name string) (*backingserviceinstanceapi.BackingServiceBinding, error)
+	List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceBindingList, error)
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+type backingServiceBindings struct {
+	r  *Client
+	ns string
+}
+
+// newBackingServiceBindings returns a backingServiceBindings
+func newBackingServiceBindings(c *Client, namespace string) *backingServiceBindings {
+	return &backingServiceBindings{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Get returns information about a particular binding or an error
+func (c *backingServiceBindings) Get(name string) (result *backingserviceinstanceapi.BackingServiceBinding, err error) {
+	result = &backingserviceinstanceapi.BackingServiceBinding{}
+	err = c.r.Get().Namespace(c.ns).Resource("backingServiceBindings").Name(name).Do().Into(result)
+	return
+}
+
+// List returns all bindings matching the label selector
+func (c *backingServiceBindings) List(label labels.Selector, field fields.Selector) (result *backingserviceinstanceapi.BackingServiceBindingList, err error) {
+	result = &backingserviceinstanceapi.BackingServiceBindingList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("backingServiceBindings").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Create creates a new binding
+func (c *backingServiceBindings) Create(b *backingserviceinstanceapi.BackingServiceBinding) (result *backingserviceinstanceapi.BackingServiceBinding, err error) {
+	result = &backingserviceinstanceapi.BackingServiceBinding{}
+	err = c.r.Post().Namespace(c.ns).Resource("backingServiceBindings").Body(b).Do().Into(result)
+	return
+}
+
+// Update updates the binding on server
+func (c *backingServiceBindings) Update(b *backingserviceinstanceapi.BackingServiceBinding) (result *backingserviceinstanceapi.BackingServiceBinding, err error) {
+	result = &backingserviceinstanceapi.BackingServiceBinding{}
+	err = c.r.Put().Namespace(c.ns).Resource("backingServiceBindings").Name(b.Name).Body(b).Do().Into(result)
+	return
+}
+
+// Delete deletes the binding, which unbinds it
+func (c *backingServiceBindings) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("backingServiceBindings").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested bindings
+func (c *backingServiceBindings) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("backingServiceBindings").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
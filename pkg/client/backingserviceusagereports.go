@@ -0,0 +1,35 @@
+package client
+
+import (
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// BackingServiceUsageReportsNamespacer has methods to work with BackingServiceUsageReport resources in a namespace
+type BackingServiceUsageReportsNamespacer interface {
+	BackingServiceUsageReports(namespace string) BackingServiceUsageReportInterface
+}
+
+// BackingServiceUsageReportInterface exposes methods on BackingServiceUsageReport resources.
+type BackingServiceUsageReportInterface interface {
+	Create(report *backingserviceinstanceapi.BackingServiceUsageReport) (*backingserviceinstanceapi.BackingServiceUsageReport, error)
+}
+
+type backingServiceUsageReports struct {
+	r  *Client
+	ns string
+}
+
+// newBackingServiceUsageReports returns a backingServiceUsageReports
+func newBackingServiceUsageReports(c *Client, namespace string) *backingServiceUsageReports {
+	return &backingServiceUsageReports{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Create returns report with the usage of the period it asks for
+func (c *backingServiceUsageReports) Create(report *backingserviceinstanceapi.BackingServiceUsageReport) (result *backingserviceinstanceapi.BackingServiceUsageReport, err error) {
+	result = &backingserviceinstanceapi.BackingServiceUsageReport{}
+	err = c.r.Post().Namespace(c.ns).Resource("backingServiceUsageReports").Body(report).Do().Into(result)
+	return
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// BackingServiceUsagesNamespacer has methods to work with BackingServiceUsage resources in a namespace
+type BackingServiceUsagesNamespacer interface {
+	BackingServiceUsages(namespace string) BackingServiceUsageInterface
+}
+
+// BackingServiceUsageInterface exposes methods on BackingServiceUsage resources.
+type BackingServiceUsageInterface interface {
+	Create(u *backingserviceinstanceapi.BackingServiceUsage) (*backingserviceinstanceapi.BackingServiceUsage, error)
+	Delete(name string) error
+	Update(u *backingserviceinstanceapi.BackingServiceUsage) (*backingserviceinstanceapi.BackingServiceUsage, error)
+	Get(name string) (*backingserviceinstanceapi.BackingServiceUsage, error)
+	List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceUsageList, error)
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+type backingServiceUsages struct {
+	r  *Client
+	ns string
+}
+
+// newBackingServiceUsages returns a backingServiceUsages
+func newBackingServiceUsages(c *Client, namespace string) *backingServiceUsages {
+	return &backingServiceUsages{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Get returns information about a particular usage or an error
+func (c *backingServiceUsages) Get(name string) (result *backingserviceinstanceapi.BackingServiceUsage, err error) {
+	result = &backingserviceinstanceapi.BackingServiceUsage{}
+	err = c.r.Get().Namespace(c.ns).Resource("backingServiceUsages").Name(name).Do().Into(result)
+	return
+}
+
+// List returns all usages matching the label selector
+func (c *backingServiceUsages) List(label labels.Selector, field fields.Selector) (result *backingserviceinstanceapi.BackingServiceUsageList, err error) {
+	result = &backingserviceinstanceapi.BackingServiceUsageList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("backingServiceUsages").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Create creates a new usage
+func (c *backingServiceUsages) Create(u *backingserviceinstanceapi.BackingServiceUsage) (result *backingserviceinstanceapi.BackingServiceUsage, err error) {
+	result = &backingserviceinstanceapi.BackingServiceUsage{}
+	err = c.r.Post().Namespace(c.ns).Resource("backingServiceUsages").Body(u).Do().Into(result)
+	return
+}
+
+// Update updates the usage on server
+func (c *backingServiceUsages) Update(u *backingserviceinstanceapi.BackingServiceUsage) (result *backingserviceinstanceapi.BackingServiceUsage, err error) {
+	result = &backingserviceinstanceapi.BackingServiceUsage{}
+	err = c.r.Put().Namespace(c.ns).Resource("backingServiceUsages").Name(u.Name).Body(u).Do().Into(result)
+	return
+}
+
+// Delete deletes a usage
+func (c *backingServiceUsages) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("backingServiceUsages").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested usages
+func (c *backingServiceUsages) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("backingServiceUsages").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
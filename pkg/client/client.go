@@ -16,9 +16,14 @@ import (
 // Interface exposes methods on OpenShift resources.
 type Interface interface {
 	ApplicationsInterface
+	ApplicationPromotionsNamespacer
 	ServiceBrokersInterface
+	ProjectServiceBrokersNamespacer
 	BackingServicesInterface
 	BackingServiceInstancesInterface
+	BackingServiceBindingsNamespacer
+	BackingServiceUsagesNamespacer
+	BackingServiceUsageReportsNamespacer
 	BuildsNamespacer
 	BuildConfigsNamespacer
 	BuildLogsNamespacer
@@ -48,6 +53,7 @@ type Interface interface {
 	TemplatesNamespacer
 	TemplateConfigsNamespacer
 	OAuthAccessTokensInterface
+	OAuthClientsInterface
 	PoliciesNamespacer
 	PolicyBindingsNamespacer
 	RolesNamespacer
@@ -63,11 +69,21 @@ func (c *Client) Applications(namespace string) ApplicationInterface {
 	return newApplications(c, namespace)
 }
 
+// ApplicationPromotions provides a REST client for ApplicationPromotions
+func (c *Client) ApplicationPromotions(namespace string) ApplicationPromotionInterface {
+	return newApplicationPromotions(c, namespace)
+}
+
 // ServiceBroker provides a REST client for servicebroker
 func (c *Client) ServiceBrokers() ServiceBrokerInterface {
 	return newServiceBrokers(c)
 }
 
+// ProjectServiceBrokers provides a REST client for ProjectServiceBrokers
+func (c *Client) ProjectServiceBrokers(namespace string) ProjectServiceBrokerInterface {
+	return newProjectServiceBrokers(c, namespace)
+}
+
 // BackingService provides a REST client for backingservice
 func (c *Client) BackingServices(namespace string) BackingServiceInterface {
 	return newBackingServices(c, namespace)
@@ -78,6 +94,21 @@ func (c *Client) BackingServiceInstances(namespace string) BackingServiceInstanc
 	return newBackingServiceInstances(c, namespace)
 }
 
+// BackingServiceBindings provides a REST client for BackingServiceBindings
+func (c *Client) BackingServiceBindings(namespace string) BackingServiceBindingInterface {
+	return newBackingServiceBindings(c, namespace)
+}
+
+// BackingServiceUsages provides a REST client for BackingServiceUsages
+func (c *Client) BackingServiceUsages(namespace string) BackingServiceUsageInterface {
+	return newBackingServiceUsages(c, namespace)
+}
+
+// BackingServiceUsageReports provides a REST client for BackingServiceUsageReports
+func (c *Client) BackingServiceUsageReports(namespace string) BackingServiceUsageReportInterface {
+	return newBackingServiceUsageReports(c, namespace)
+}
+
 // Builds provides a REST client for Builds
 func (c *Client) Builds(namespace string) BuildInterface {
 	return newBuilds(c, namespace)
@@ -243,6 +274,11 @@ func (c *Client) OAuthAccessTokens() OAuthAccessTokenInterface {
 	return newOAuthAccessTokens(c)
 }
 
+// OAuthClients provides a REST client for OAuthClients
+func (c *Client) OAuthClients() OAuthClientInterface {
+	return newOAuthClients(c)
+}
+
 func (c *Client) ClusterPolicies() ClusterPolicyInterface {
 	return newClusterPolicies(c)
 }
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// OAuthClientsInterface has methods to work with OAuthClient resources
+type OAuthClientsInterface interface {
+	OAuthClients() OAuthClientInterface
+}
+
+// OAuthClientInterface exposes methods on OAuthClient resources.
+type OAuthClientInterface interface {
+	Create(client *oauthapi.OAuthClient) (*oauthapi.OAuthClient, error)
+	Delete(name string) error
+	Update(client *oauthapi.OAuthClient) (*oauthapi.OAuthClient, error)
+	Get(name string) (*oauthapi.OAuthClient, error)
+	List(label labels.Selector, field fields.Selector) (*oauthapi.OAuthClientList, error)
+}
+
+type oauthClients struct {
+	r *Client
+}
+
+func newOAuthClients(c *Client) *oauthClients {
+	return &oauthClients{
+		r: c,
+	}
+}
+
+// Get returns information about a particular OAuthClient or an error
+func (c *oauthClients) Get(name string) (result *oauthapi.OAuthClient, err error) {
+	result = &oauthapi.OAuthClient{}
+	err = c.r.Get().Resource("oAuthClients").Name(name).Do().Into(result)
+	return
+}
+
+// List returns all OAuthClients matching the label selector
+func (c *oauthClients) List(label labels.Selector, field fields.Selector) (result *oauthapi.OAuthClientList, err error) {
+	result = &oauthapi.OAuthClientList{}
+	err = c.r.Get().
+		Resource("oAuthClients").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Create creates a new OAuthClient
+func (c *oauthClients) Create(client *oauthapi.OAuthClient) (result *oauthapi.OAuthClient, err error) {
+	result = &oauthapi.OAuthClient{}
+	err = c.r.Post().Resource("oAuthClients").Body(client).Do().Into(result)
+	return
+}
+
+// Update updates the OAuthClient on server
+func (c *oauthClients) Update(client *oauthapi.OAuthClient) (result *oauthapi.OAuthClient, err error) {
+	result = &oauthapi.OAuthClient{}
+	err = c.r.Put().Resource("oAuthClients").Name(client.Name).Body(client).Do().Into(result)
+	return
+}
+
+// Delete removes the OAuthClient on server
+func (c *oauthClients) Delete(name string) (err error) {
+	err = c.r.Delete().Resource("oAuthClients").Name(name).Do().Error()
+	return
+}
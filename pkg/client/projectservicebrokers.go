@@ -0,0 +1,89 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+)
+
+// ProjectServiceBrokersNamespacer has methods to work with ProjectServiceBroker resources in a namespace
+type ProjectServiceBrokersNamespacer interface {
+	ProjectServiceBrokers(namespace string) ProjectServiceBrokerInterface
+}
+
+// ProjectServiceBrokerInterface exposes methods on ProjectServiceBroker resources.
+type ProjectServiceBrokerInterface interface {
+	Create(b *servicebrokerapi.ProjectServiceBroker) (*servicebrokerapi.ProjectServiceBroker, error)
+	Delete(name string) error
+	Update(b *servicebrokerapi.ProjectServiceBroker) (*servicebrokerapi.ProjectServiceBroker, error)
+	Get(name string) (*servicebrokerapi.ProjectServiceBroker, error)
+	List(label labels.Selector, field fields.Selector) (*servicebrokerapi.ProjectServiceBrokerList, error)
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+type projectServiceBrokers struct {
+	r  *Client
+	ns string
+}
+
+// newProjectServiceBrokers returns a projectServiceBrokers
+func newProjectServiceBrokers(c *Client, namespace string) *projectServiceBrokers {
+	return &projectServiceBrokers{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Get returns information about a particular broker or an error
+func (c *projectServiceBrokers) Get(name string) (result *servicebrokerapi.ProjectServiceBroker, err error) {
+	result = &servicebrokerapi.ProjectServiceBroker{}
+	err = c.r.Get().Namespace(c.ns).Resource("projectServiceBrokers").Name(name).Do().Into(result)
+	return
+}
+
+// List returns all brokers matching the label selector
+func (c *projectServiceBrokers) List(label labels.Selector, field fields.Selector) (result *servicebrokerapi.ProjectServiceBrokerList, err error) {
+	result = &servicebrokerapi.ProjectServiceBrokerList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("projectServiceBrokers").
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Do().
+		Into(result)
+	return
+}
+
+// Create creates a new broker
+func (c *projectServiceBrokers) Create(b *servicebrokerapi.ProjectServiceBroker) (result *servicebrokerapi.ProjectServiceBroker, err error) {
+	result = &servicebrokerapi.ProjectServiceBroker{}
+	err = c.r.Post().Namespace(c.ns).Resource("projectServiceBrokers").Body(b).Do().Into(result)
+	return
+}
+
+// Update updates the broker on server
+func (c *projectServiceBrokers) Update(b *servicebrokerapi.ProjectServiceBroker) (result *servicebrokerapi.ProjectServiceBroker, err error) {
+	result = &servicebrokerapi.ProjectServiceBroker{}
+	err = c.r.Put().Namespace(c.ns).Resource("projectServiceBrokers").Name(b.Name).Body(b).Do().Into(result)
+	return
+}
+
+// Delete deletes the broker once its services are deactivated
+func (c *projectServiceBrokers) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("projectServiceBrokers").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested brokers
+func (c *projectServiceBrokers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("projectServiceBrokers").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(label).
+		FieldsSelectorParam(field).
+		Watch()
+}
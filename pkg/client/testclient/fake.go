@@ -120,11 +120,51 @@ func (c *Fake) Actions() []ktestclient.Action {
 
 var _ client.Interface = &Fake{}
 
+// Applications provides a fake REST client for Applications
+func (c *Fake) Applications(namespace string) client.ApplicationInterface {
+	return &FakeApplications{Fake: c, Namespace: namespace}
+}
+
+// ApplicationPromotions provides a fake REST client for ApplicationPromotions
+func (c *Fake) ApplicationPromotions(namespace string) client.ApplicationPromotionInterface {
+	return &FakeApplicationPromotions{Fake: c, Namespace: namespace}
+}
+
 // Projects provides a fake REST client for ServiceBrokers
 func (c *Fake) ServiceBrokers() client.ServiceBrokerInterface {
 	return &FakeServiceBrokers{Fake: c}
 }
 
+// ProjectServiceBrokers provides a fake REST client for ProjectServiceBrokers
+func (c *Fake) ProjectServiceBrokers(namespace string) client.ProjectServiceBrokerInterface {
+	return &FakeProjectServiceBrokers{Fake: c, Namespace: namespace}
+}
+
+// BackingServices provides a fake REST client for BackingServices
+func (c *Fake) BackingServices(namespace string) client.BackingServiceInterface {
+	return &FakeBackingServices{Fake: c, Namespace: namespace}
+}
+
+// BackingServiceInstances provides a fake REST client for BackingServiceInstances
+func (c *Fake) BackingServiceInstances(namespace string) client.BackingServiceInstanceInterface {
+	return &FakeBackingServiceInstances{Fake: c, Namespace: namespace}
+}
+
+// BackingServiceBindings provides a fake REST client for BackingServiceBindings
+func (c *Fake) BackingServiceBindings(namespace string) client.BackingServiceBindingInterface {
+	return &FakeBackingServiceBindings{Fake: c, Namespace: namespace}
+}
+
+// BackingServiceUsages provides a fake REST client for BackingServiceUsages
+func (c *Fake) BackingServiceUsages(namespace string) client.BackingServiceUsageInterface {
+	return &FakeBackingServiceUsages{Fake: c, Namespace: namespace}
+}
+
+// BackingServiceUsageReports provides a fake REST client for BackingServiceUsageReports
+func (c *Fake) BackingServiceUsageReports(namespace string) client.BackingServiceUsageReportInterface {
+	return &FakeBackingServiceUsageReports{Fake: c, Namespace: namespace}
+}
+
 // Builds provides a fake REST client for Builds
 func (c *Fake) Builds(namespace string) client.BuildInterface {
 	return &FakeBuilds{Fake: c, Namespace: namespace}
@@ -280,6 +320,11 @@ func (c *Fake) OAuthAccessTokens() client.OAuthAccessTokenInterface {
 	return &FakeOAuthAccessTokens{Fake: c}
 }
 
+// OAuthClients provides a fake REST client for OAuthClients
+func (c *Fake) OAuthClients() client.OAuthClientInterface {
+	return &FakeOAuthClients{Fake: c}
+}
+
 // LocalSubjectAccessReviews provides a fake REST client for SubjectAccessReviews
 func (c *Fake) LocalSubjectAccessReviews(namespace string) client.LocalSubjectAccessReviewInterface {
 	return &FakeLocalSubjectAccessReviews{Fake: c}
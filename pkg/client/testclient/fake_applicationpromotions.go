@@ -0,0 +1,62 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+// FakeApplicationPromotions implements ApplicationPromotionInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeApplicationPromotions struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeApplicationPromotions) Get(name string) (*applicationapi.ApplicationPromotion, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("applicationpromotions", c.Namespace, name), &applicationapi.ApplicationPromotion{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.ApplicationPromotion), err
+}
+
+func (c *FakeApplicationPromotions) List(label labels.Selector, field fields.Selector) (*applicationapi.ApplicationPromotionList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("applicationpromotions", c.Namespace, label, field), &applicationapi.ApplicationPromotionList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.ApplicationPromotionList), err
+}
+
+func (c *FakeApplicationPromotions) Create(inObj *applicationapi.ApplicationPromotion) (*applicationapi.ApplicationPromotion, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("applicationpromotions", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.ApplicationPromotion), err
+}
+
+func (c *FakeApplicationPromotions) Update(inObj *applicationapi.ApplicationPromotion) (*applicationapi.ApplicationPromotion, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("applicationpromotions", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.ApplicationPromotion), err
+}
+
+func (c *FakeApplicationPromotions) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("applicationpromotions", c.Namespace, name), &applicationapi.ApplicationPromotion{})
+	return err
+}
+
+func (c *FakeApplicationPromotions) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("applicationpromotions", c.Namespace, label, field, resourceVersion))
+}
@@ -0,0 +1,62 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// FakeBackingServiceBindings implements BackingServiceBindingInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeBackingServiceBindings struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeBackingServiceBindings) Get(name string) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("backingservicebindings", c.Namespace, name), &backingserviceinstanceapi.BackingServiceBinding{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), err
+}
+
+func (c *FakeBackingServiceBindings) List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceBindingList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("backingservicebindings", c.Namespace, label, field), &backingserviceinstanceapi.BackingServiceBindingList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceBindingList), err
+}
+
+func (c *FakeBackingServiceBindings) Create(inObj *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingservicebindings", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), err
+}
+
+func (c *FakeBackingServiceBindings) Update(inObj *backingserviceinstanceapi.BackingServiceBinding) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("backingservicebindings", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceBinding), err
+}
+
+func (c *FakeBackingServiceBindings) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("backingservicebindings", c.Namespace, name), &backingserviceinstanceapi.BackingServiceBinding{})
+	return err
+}
+
+func (c *FakeBackingServiceBindings) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("backingservicebindings", c.Namespace, label, field, resourceVersion))
+}
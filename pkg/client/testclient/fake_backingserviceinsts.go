@@ -4,6 +4,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
 
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
 )
@@ -11,11 +12,12 @@ import (
 // FakeBackingServiceInstances implements BackingServiceInstanceInterface. Meant to be embedded into a struct to get a default
 // implementation. This makes faking out just the methods you want to test easier.
 type FakeBackingServiceInstances struct {
-	Fake *Fake
+	Fake      *Fake
+	Namespace string
 }
 
 func (c *FakeBackingServiceInstances) Get(name string) (*backingserviceinstanceapi.BackingServiceInstance, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootGetAction("backingserviceinstances", name), &backingserviceinstanceapi.BackingServiceInstance{})
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("backingserviceinstances", c.Namespace, name), &backingserviceinstanceapi.BackingServiceInstance{})
 	if obj == nil {
 		return nil, err
 	}
@@ -24,7 +26,7 @@ func (c *FakeBackingServiceInstances) Get(name string) (*backingserviceinstancea
 }
 
 func (c *FakeBackingServiceInstances) List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceInstanceList, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootListAction("backingserviceinstances", label, field), &backingserviceinstanceapi.BackingServiceInstanceList{})
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("backingserviceinstances", c.Namespace, label, field), &backingserviceinstanceapi.BackingServiceInstanceList{})
 	if obj == nil {
 		return nil, err
 	}
@@ -33,7 +35,7 @@ func (c *FakeBackingServiceInstances) List(label labels.Selector, field fields.S
 }
 
 func (c *FakeBackingServiceInstances) Create(inObj *backingserviceinstanceapi.BackingServiceInstance) (*backingserviceinstanceapi.BackingServiceInstance, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootCreateAction("backingserviceinstances", inObj), inObj)
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingserviceinstances", c.Namespace, inObj), inObj)
 	if obj == nil {
 		return nil, err
 	}
@@ -42,7 +44,7 @@ func (c *FakeBackingServiceInstances) Create(inObj *backingserviceinstanceapi.Ba
 }
 
 func (c *FakeBackingServiceInstances) Update(inObj *backingserviceinstanceapi.BackingServiceInstance) (*backingserviceinstanceapi.BackingServiceInstance, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootUpdateAction("backingserviceinstances", inObj), inObj)
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("backingserviceinstances", c.Namespace, inObj), inObj)
 	if obj == nil {
 		return nil, err
 	}
@@ -51,6 +53,25 @@ func (c *FakeBackingServiceInstances) Update(inObj *backingserviceinstanceapi.Ba
 }
 
 func (c *FakeBackingServiceInstances) Delete(name string) error {
-	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("backingserviceinstances", name), &backingserviceinstanceapi.BackingServiceInstance{})
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("backingserviceinstances", c.Namespace, name), &backingserviceinstanceapi.BackingServiceInstance{})
+	return err
+}
+
+func (c *FakeBackingServiceInstances) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("backingserviceinstances", c.Namespace, label, field, resourceVersion))
+}
+
+func (c *FakeBackingServiceInstances) CreateBinding(name string, request *backingserviceinstanceapi.BindingRequestOptions) error {
+	_, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingserviceinstances/binding", c.Namespace, request), &backingserviceinstanceapi.BackingServiceInstance{})
+	return err
+}
+
+func (c *FakeBackingServiceInstances) UpdateBinding(name string, request *backingserviceinstanceapi.BindingRequestOptions) error {
+	_, err := c.Fake.Invokes(ktestclient.NewUpdateAction("backingserviceinstances/binding", c.Namespace, request), &backingserviceinstanceapi.BackingServiceInstance{})
+	return err
+}
+
+func (c *FakeBackingServiceInstances) DeleteBinding(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("backingserviceinstances/binding", c.Namespace, name), &backingserviceinstanceapi.BackingServiceInstance{})
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
 
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 )
@@ -11,11 +12,12 @@ import (
 // FakeBackingServices implements BackingServiceInterface. Meant to be embedded into a struct to get a default
 // implementation. This makes faking out just the methods you want to test easier.
 type FakeBackingServices struct {
-	Fake *Fake
+	Fake      *Fake
+	Namespace string
 }
 
 func (c *FakeBackingServices) Get(name string) (*backingserviceapi.BackingService, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootGetAction("backingservices", name), &backingserviceapi.BackingService{})
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("backingservices", c.Namespace, name), &backingserviceapi.BackingService{})
 	if obj == nil {
 		return nil, err
 	}
@@ -24,7 +26,7 @@ func (c *FakeBackingServices) Get(name string) (*backingserviceapi.BackingServic
 }
 
 func (c *FakeBackingServices) List(label labels.Selector, field fields.Selector) (*backingserviceapi.BackingServiceList, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootListAction("backingservices", label, field), &backingserviceapi.BackingServiceList{})
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("backingservices", c.Namespace, label, field), &backingserviceapi.BackingServiceList{})
 	if obj == nil {
 		return nil, err
 	}
@@ -33,7 +35,7 @@ func (c *FakeBackingServices) List(label labels.Selector, field fields.Selector)
 }
 
 func (c *FakeBackingServices) Create(inObj *backingserviceapi.BackingService) (*backingserviceapi.BackingService, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootCreateAction("backingservices", inObj), inObj)
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingservices", c.Namespace, inObj), inObj)
 	if obj == nil {
 		return nil, err
 	}
@@ -42,7 +44,7 @@ func (c *FakeBackingServices) Create(inObj *backingserviceapi.BackingService) (*
 }
 
 func (c *FakeBackingServices) Update(inObj *backingserviceapi.BackingService) (*backingserviceapi.BackingService, error) {
-	obj, err := c.Fake.Invokes(ktestclient.NewRootUpdateAction("backingservices", inObj), inObj)
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("backingservices", c.Namespace, inObj), inObj)
 	if obj == nil {
 		return nil, err
 	}
@@ -51,6 +53,10 @@ func (c *FakeBackingServices) Update(inObj *backingserviceapi.BackingService) (*
 }
 
 func (c *FakeBackingServices) Delete(name string) error {
-	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("backingservices", name), &backingserviceapi.BackingService{})
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("backingservices", c.Namespace, name), &backingserviceapi.BackingService{})
 	return err
 }
+
+func (c *FakeBackingServices) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("backingservices", c.Namespace, label, field, resourceVersion))
+}
@@ -0,0 +1,23 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// FakeBackingServiceUsageReports implements BackingServiceUsageReportInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeBackingServiceUsageReports struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeBackingServiceUsageReports) Create(inObj *backingserviceinstanceapi.BackingServiceUsageReport) (*backingserviceinstanceapi.BackingServiceUsageReport, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingserviceusagereports", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceUsageReport), err
+}
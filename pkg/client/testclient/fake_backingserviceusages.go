@@ -0,0 +1,62 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// FakeBackingServiceUsages implements BackingServiceUsageInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeBackingServiceUsages struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeBackingServiceUsages) Get(name string) (*backingserviceinstanceapi.BackingServiceUsage, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("backingserviceusages", c.Namespace, name), &backingserviceinstanceapi.BackingServiceUsage{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceUsage), err
+}
+
+func (c *FakeBackingServiceUsages) List(label labels.Selector, field fields.Selector) (*backingserviceinstanceapi.BackingServiceUsageList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("backingserviceusages", c.Namespace, label, field), &backingserviceinstanceapi.BackingServiceUsageList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceUsageList), err
+}
+
+func (c *FakeBackingServiceUsages) Create(inObj *backingserviceinstanceapi.BackingServiceUsage) (*backingserviceinstanceapi.BackingServiceUsage, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("backingserviceusages", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceUsage), err
+}
+
+func (c *FakeBackingServiceUsages) Update(inObj *backingserviceinstanceapi.BackingServiceUsage) (*backingserviceinstanceapi.BackingServiceUsage, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("backingserviceusages", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*backingserviceinstanceapi.BackingServiceUsage), err
+}
+
+func (c *FakeBackingServiceUsages) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("backingserviceusages", c.Namespace, name), &backingserviceinstanceapi.BackingServiceUsage{})
+	return err
+}
+
+func (c *FakeBackingServiceUsages) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("backingserviceusages", c.Namespace, label, field, resourceVersion))
+}
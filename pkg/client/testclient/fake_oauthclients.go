@@ -0,0 +1,56 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// FakeOAuthClients implements OAuthClientInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeOAuthClients struct {
+	Fake *Fake
+}
+
+func (c *FakeOAuthClients) Get(name string) (*oauthapi.OAuthClient, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootGetAction("oauthclients", name), &oauthapi.OAuthClient{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthClient), err
+}
+
+func (c *FakeOAuthClients) List(label labels.Selector, field fields.Selector) (*oauthapi.OAuthClientList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootListAction("oauthclients", label, field), &oauthapi.OAuthClientList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthClientList), err
+}
+
+func (c *FakeOAuthClients) Create(inObj *oauthapi.OAuthClient) (*oauthapi.OAuthClient, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootCreateAction("oauthclients", inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthClient), err
+}
+
+func (c *FakeOAuthClients) Update(inObj *oauthapi.OAuthClient) (*oauthapi.OAuthClient, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootUpdateAction("oauthclients", inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthClient), err
+}
+
+func (c *FakeOAuthClients) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("oauthclients", name), &oauthapi.OAuthClient{})
+	return err
+}
@@ -0,0 +1,62 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+)
+
+// FakeProjectServiceBrokers implements ProjectServiceBrokerInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeProjectServiceBrokers struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeProjectServiceBrokers) Get(name string) (*servicebrokerapi.ProjectServiceBroker, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("projectservicebrokers", c.Namespace, name), &servicebrokerapi.ProjectServiceBroker{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*servicebrokerapi.ProjectServiceBroker), err
+}
+
+func (c *FakeProjectServiceBrokers) List(label labels.Selector, field fields.Selector) (*servicebrokerapi.ProjectServiceBrokerList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("projectservicebrokers", c.Namespace, label, field), &servicebrokerapi.ProjectServiceBrokerList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*servicebrokerapi.ProjectServiceBrokerList), err
+}
+
+func (c *FakeProjectServiceBrokers) Create(inObj *servicebrokerapi.ProjectServiceBroker) (*servicebrokerapi.ProjectServiceBroker, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("projectservicebrokers", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*servicebrokerapi.ProjectServiceBroker), err
+}
+
+func (c *FakeProjectServiceBrokers) Update(inObj *servicebrokerapi.ProjectServiceBroker) (*servicebrokerapi.ProjectServiceBroker, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("projectservicebrokers", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*servicebrokerapi.ProjectServiceBroker), err
+}
+
+func (c *FakeProjectServiceBrokers) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("projectservicebrokers", c.Namespace, name), &servicebrokerapi.ProjectServiceBroker{})
+	return err
+}
+
+func (c *FakeProjectServiceBrokers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("projectservicebrokers", c.Namespace, label, field, resourceVersion))
+}
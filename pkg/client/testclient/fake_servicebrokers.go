@@ -4,6 +4,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
 
 	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
 )
@@ -54,3 +55,7 @@ func (c *FakeServiceBrokers) Delete(name string) error {
 	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("servicebroker", name), &servicebrokerapi.ServiceBroker{})
 	return err
 }
+
+func (c *FakeServiceBrokers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewRootWatchAction("servicebroker", label, field, resourceVersion))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/admin/prune"
 	"github.com/openshift/origin/pkg/cmd/admin/registry"
 	"github.com/openshift/origin/pkg/cmd/admin/router"
+	"github.com/openshift/origin/pkg/cmd/admin/serviceusage"
 	"github.com/openshift/origin/pkg/cmd/cli/cmd"
 	"github.com/openshift/origin/pkg/cmd/experimental/buildchain"
 	exipfailover "github.com/openshift/origin/pkg/cmd/experimental/ipfailover"
@@ -65,6 +66,7 @@ func NewCommandAdmin(name, fullName string, out io.Writer) *cobra.Command {
 				buildchain.NewCmdBuildChain(name, fullName+" "+buildchain.BuildChainRecommendedCommandName, f, out),
 				node.NewCommandManageNode(f, node.ManageNodeCommandName, fullName+" "+node.ManageNodeCommandName, out),
 				prune.NewCommandPrune(prune.PruneRecommendedName, fullName+" "+prune.PruneRecommendedName, f, out),
+				serviceusage.NewCmdServiceUsage(serviceusage.ServiceUsageRecommendedName, fullName+" "+serviceusage.ServiceUsageRecommendedName, f, out),
 			},
 		},
 		{
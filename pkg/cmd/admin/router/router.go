@@ -1,6 +1,8 @@
 package router
 
 import (
+	crand "crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -531,6 +533,12 @@ func RunCmdRouter(f *clientcmd.Factory, cmd *cobra.Command, out io.Writer, cfg *
 			fmt.Fprintf(out, "password for stats user %s has been set to %s\n", cfg.StatsUsername, cfg.StatsPassword)
 		}
 
+		// shared by the replicas, any of them may receive what a route service forwards back.
+		routeServiceSecret, err := generateRouteServiceSecret()
+		if err != nil {
+			return fmt.Errorf("router could not be created: %v", err)
+		}
+
 		env := app.Environment{
 			"OPENSHIFT_MASTER":                    config.Host,
 			"OPENSHIFT_CA_DATA":                   string(config.CAData),
@@ -551,6 +559,7 @@ func RunCmdRouter(f *clientcmd.Factory, cmd *cobra.Command, out io.Writer, cfg *
 			"STATS_PORT":                          strconv.Itoa(cfg.StatsPort),
 			"STATS_USERNAME":                      cfg.StatsUsername,
 			"STATS_PASSWORD":                      cfg.StatsPassword,
+			"ROUTE_SERVICE_SECRET":                routeServiceSecret,
 		}
 
 		updatePercent := int(-25)
@@ -678,6 +687,16 @@ func generateStatsPassword() string {
 	return strings.Join(password, "")
 }
 
+// generateRouteServiceSecret creates a random secret signing the requests route services
+// forward back to their routes.
+func generateRouteServiceSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := crand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
 func validateServiceAccount(kClient *kclient.Client, ns string, sa string) error {
 	// get cluster sccs
 	sccList, err := kClient.SecurityContextConstraints().List(labels.Everything(), fields.Everything())
@@ -0,0 +1,185 @@
+package serviceusage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
+
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	ServiceUsageRecommendedName = "service-usage"
+	serviceUsageLong            = `
+Report the usage and costs of backing service plans
+
+Lists how many instances of each backing service plan a project used over a period,
+for how many hours, and what they cost from the costs per unit of time of the plans.
+Costs in other units, and costs of free plans, are not accounted. The period starts
+at the beginning of the current month and ends now by default.`
+
+	serviceUsageExample = `  # Report the usage of the current project this month
+  $ %[1]s
+
+  # Report the usage of all projects in January
+  $ %[1]s --all-projects --start=2016-01-01 --end=2016-02-01`
+)
+
+// dateLayout is the layout of dates without a time, which start at midnight UTC.
+const dateLayout = "2006-01-02"
+
+type ServiceUsageOptions struct {
+	Client client.Interface
+	Out    io.Writer
+
+	Projects []string
+	Start    time.Time
+	End      *time.Time
+}
+
+func NewCmdServiceUsage(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ServiceUsageOptions{Out: out}
+	var start, end string
+	var allProjects bool
+
+	cmd := &cobra.Command{
+		Use:     name + " [--all-projects] [--start=DATE] [--end=DATE]",
+		Short:   "Report the usage and costs of backing service plans",
+		Long:    serviceUsageLong,
+		Example: fmt.Sprintf(serviceUsageExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, allProjects, start, end); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%v", err))
+			}
+
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+
+	cmd.Flags().BoolVar(&allProjects, "all-projects", false, "Report the usage of all projects")
+	cmd.Flags().StringVar(&start, "start", "", "Beginning of the period, a date (2006-01-02) or a time (RFC3339). Defaults to the beginning of the month.")
+	cmd.Flags().StringVar(&end, "end", "", "End of the period, a date (2006-01-02) or a time (RFC3339). Defaults to now.")
+
+	return cmd
+}
+
+func (o *ServiceUsageOptions) Complete(f *clientcmd.Factory, allProjects bool, start, end string) error {
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Client = osClient
+
+	if len(start) == 0 {
+		now := time.Now()
+		o.Start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	} else if o.Start, err = parseTime(start); err != nil {
+		return fmt.Errorf("--start: %v", err)
+	}
+	if len(end) > 0 {
+		t, err := parseTime(end)
+		if err != nil {
+			return fmt.Errorf("--end: %v", err)
+		}
+		if !t.After(o.Start) {
+			return fmt.Errorf("--end must be after --start")
+		}
+		o.End = &t
+	}
+
+	if !allProjects {
+		namespace, _, err := f.DefaultNamespace()
+		if err != nil {
+			return err
+		}
+		o.Projects = []string{namespace}
+		return nil
+	}
+	projects, err := osClient.Projects().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for _, project := range projects.Items {
+		o.Projects = append(o.Projects, project.Name)
+	}
+	sort.Strings(o.Projects)
+	return nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// Run prints the report of each project, followed by the total of its costs.
+func (o *ServiceUsageOptions) Run() error {
+	request := &backingserviceinstanceapi.BackingServiceUsageReport{}
+	request.Spec.Start = unversioned.NewTime(o.Start)
+	if o.End != nil {
+		end := unversioned.NewTime(*o.End)
+		request.Spec.End = &end
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintln(w, "PROJECT\tSERVICE\tPLAN\tINSTANCES\tHOURS\tCOST")
+	for _, project := range o.Projects {
+		report, err := o.Client.BackingServiceUsageReports(project).Create(request)
+		if err != nil {
+			return err
+		}
+		for _, plan := range report.Status.Plans {
+			name := plan.BackingServicePlanName
+			if len(name) == 0 {
+				name = plan.BackingServicePlanGuid
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.1f\t%s\n", project, plan.BackingServiceName, name, plan.Instances, plan.Hours, formatCost(plan))
+		}
+		if len(report.Status.Total) > 0 {
+			fmt.Fprintf(w, "%s\t\t\t\t\t%s\n", project, "total "+formatAmounts(report.Status.Total))
+		}
+	}
+	return nil
+}
+
+func formatCost(plan backingserviceinstanceapi.PlanUsage) string {
+	if plan.Free {
+		return "free"
+	}
+	cost := formatAmounts(plan.Cost)
+	if len(plan.UnmeteredUnits) > 0 {
+		cost += fmt.Sprintf(" (unmetered: %s)", strings.Join(plan.UnmeteredUnits, ", "))
+	}
+	return cost
+}
+
+// formatAmounts prints amounts by currency, sorted by currency.
+func formatAmounts(amounts map[string]float64) string {
+	if len(amounts) == 0 {
+		return "-"
+	}
+	currencies := []string{}
+	for currency := range amounts {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	formatted := []string{}
+	for _, currency := range currencies {
+		formatted = append(formatted, fmt.Sprintf("%.2f %s", amounts[currency], currency))
+	}
+	return strings.Join(formatted, ", ")
+}
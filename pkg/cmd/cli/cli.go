@@ -77,8 +77,12 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdProject(fullName+" project", f, out),
 				cmd.NewCmdApplication(fullName+" new-application ", f, out),
 				cmd.NewCmdDeleteApplication(fullName+" delete-application ", f, out),
+				cmd.NewCmdApplyApplication(fullName+" apply-application", f, out),
+				cmd.NewCmdPromote(fullName+" promote", f, out),
 				cmd.NewCmdServiceBroker(fullName+" new-servicebroker", f, out),
 				cmd.NewCmdNewBackingServiceInstance(fullName+" new-backingserviceinstance", f, out),
+				cmd.NewCmdEditBackingServiceInstance(fullName+" edit-backingserviceinstance", f, out),
+				cmd.NewCmdDeleteBackingServiceInstance(fullName+" delete-backingserviceinstance", f, out),
 			},
 		},
 		{
@@ -37,6 +37,9 @@ Create a new application to partition resources for a comfortable knowledge of m
   $ %[1]s  mobile_app  --items="Pod=php,Pod=mysql,ServiceBroker=redis"
 
   $ %[1]s  mobile_app  --items="po=php,no=mysql,sb=redis"
+
+  # Create the application exported as the template shop, and its items
+  $ %[1]s  shop  --from-template=shop -p MYSQL_PLAN=5c3d471a-f8b4-4b6a-b2a7-c5e4e7f2e0f9
   `
 
 )
@@ -46,6 +49,10 @@ type NewApplicationOptions struct {
 	Items applicationapi.ItemList
 	Item  string
 
+	// Template is the name of a template an application was exported as.
+	Template           string
+	TemplateParameters []string
+
 	Client client.Interface
 
 	Out io.Writer
@@ -56,7 +63,7 @@ func NewCmdApplication(fullName string, f *clientcmd.Factory, out io.Writer) *co
 	options.Out = out
 
 	cmd := &cobra.Command{
-		Use:     `new-application NAME [--items="KIND=KINDNAME,KIND=KINDNAME"]`,
+		Use:     `new-application NAME [--items="KIND=KINDNAME,KIND=KINDNAME" | --from-template=TEMPLATE]`,
 		Short:   "create a new application",
 		Long:    newApplicationLong,
 		Example: fmt.Sprintf(newApplicationExample, fullName),
@@ -80,12 +87,27 @@ func NewCmdApplication(fullName string, f *clientcmd.Factory, out io.Writer) *co
 	}
 
 	cmd.Flags().StringVar(&options.Item, "items", "", "application items")
+	cmd.Flags().StringVar(&options.Template, "from-template", "", "Create the application exported as this template, and its items, from the project or the openshift project")
+	cmd.Flags().StringSliceVarP(&options.TemplateParameters, "param", "p", options.TemplateParameters, "Specify a list of key value pairs (e.g., -p FOO=BAR,BAR=FOO) to set/override parameter values in the template.")
 
 	return cmd
 }
 
 func (o *NewApplicationOptions) complete(cmd *cobra.Command, f *clientcmd.Factory) error {
 	args := cmd.Flags().Args()
+	if len(o.Template) > 0 {
+		if len(o.Item) > 0 {
+			return errors.New("--items and --from-template may not both be specified")
+		}
+		if len(args) > 1 {
+			cmd.Help()
+			return errors.New("must have at most one argument")
+		}
+		if len(args) == 1 {
+			o.Name = args[0]
+		}
+		return nil
+	}
 	if len(args) != 1 {
 		cmd.Help()
 		return errors.New("must have exactly one argument")
@@ -115,6 +137,10 @@ func (o *NewApplicationOptions) Run(f *clientcmd.Factory) error {
 		return err
 	}
 
+	if len(o.Template) > 0 {
+		return o.runFromTemplate(f, namespace)
+	}
+
 	_, err = o.Client.Applications(namespace).Get(o.Name)
 	if err == nil {
 		return errors.New(fmt.Sprintf("application %s already exists", o.Name))
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	configcmd "github.com/openshift/origin/pkg/config/cmd"
+	"github.com/openshift/origin/pkg/template"
+)
+
+// runFromTemplate processes the template an application was exported as, and creates
+// its items then the application, which can only be created once its items exist.
+func (o *NewApplicationOptions) runFromTemplate(f *clientcmd.Factory, namespace string) error {
+	tpl, err := o.Client.Templates(namespace).Get(o.Template)
+	if openshiftNamespace := "openshift"; kerrors.IsNotFound(err) && namespace != openshiftNamespace {
+		tpl, err = o.Client.Templates(openshiftNamespace).Get(o.Template)
+	}
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{}
+	if len(o.Name) > 0 {
+		if template.GetParameterByName(tpl, ApplicationNameParameter) == nil {
+			return fmt.Errorf("template %s was not exported from an application, it has no %s parameter", o.Template, ApplicationNameParameter)
+		}
+		values[ApplicationNameParameter] = o.Name
+	}
+	for _, pair := range o.TemplateParameters {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid parameter assignment in %q: expected KEY=VALUE", pair)
+		}
+		values[kv[0]] = kv[1]
+	}
+	for name, value := range values {
+		param := template.GetParameterByName(tpl, name)
+		if param == nil {
+			return fmt.Errorf("unexpected parameter name %q", name)
+		}
+		param.Value = value
+		param.Generate = ""
+	}
+
+	result, err := o.Client.TemplateConfigs(namespace).Create(tpl)
+	if err != nil {
+		return fmt.Errorf("error processing template %s: %v", o.Template, err)
+	}
+	if errs := runtime.DecodeList(result.Objects, kapi.Scheme); len(errs) > 0 {
+		return fmt.Errorf("error processing template %s: %v", o.Template, utilerrors.NewAggregate(errs))
+	}
+
+	items := &kapi.List{}
+	applications := []*applicationapi.Application{}
+	for _, obj := range result.Objects {
+		if application, ok := obj.(*applicationapi.Application); ok {
+			applications = append(applications, application)
+			continue
+		}
+		items.Items = append(items.Items, obj)
+	}
+	if len(applications) == 0 {
+		return fmt.Errorf("template %s was not exported from an application", o.Template)
+	}
+	for _, application := range applications {
+		if _, err := o.Client.Applications(namespace).Get(application.Name); err == nil {
+			return fmt.Errorf("application %s already exists", application.Name)
+		}
+	}
+
+	mapper, typer := f.Object()
+	bulk := configcmd.Bulk{
+		Mapper:            mapper,
+		Typer:             typer,
+		RESTClientFactory: f.Factory.RESTClient,
+
+		After: configcmd.NewPrintNameOrErrorAfter(mapper, false, "created", o.Out, o.Out),
+	}
+	if errs := bulk.Create(items, namespace); len(errs) != 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	names := []string{}
+	for _, application := range applications {
+		application.Status = applicationapi.ApplicationStatus{}
+		if _, err := o.Client.Applications(namespace).Create(application); err != nil {
+			return err
+		}
+		names = append(names, application.Name)
+	}
+	o.Name = strings.Join(names, ", ")
+	return nil
+}
@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/strategicpatch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	applyApplicationLong = `
+Create or update an application and its items from a manifest
+
+The manifest is a List of the full definitions of the items and of the application. The items
+which don't exist are created, the others are patched with a three-way merge of their definition
+in the manifest last applied, which is recorded on the application, their definition in this
+manifest and their current state, so that the changes made by others since are kept. Status is
+left to the server. The items the application lists without defining them are linked as they are.
+
+The items defined in the manifest last applied but dropped from this one are removed from the
+application and deleted, unless another application shares them. Pass --dry-run to show the
+changes without making them.`
+
+	applyApplicationExample = `  # Create or update the application defined in shop.yaml, and its items
+  $ %[1]s -f shop.yaml
+
+  # Show what applying shop.yaml would change
+  $ %[1]s -f shop.yaml --dry-run`
+)
+
+type ApplyApplicationOptions struct {
+	Filenames []string
+	DryRun    bool
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+func NewCmdApplyApplication(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ApplyApplicationOptions{}
+	options.Out = out
+
+	cmd := &cobra.Command{
+		Use:     "apply-application -f FILENAME [--dry-run]",
+		Short:   "Create or update an application and its items from a manifest",
+		Long:    applyApplicationLong,
+		Example: fmt.Sprintf(applyApplicationExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(options.Filenames) == 0 {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "the manifest of the application must be specified with -f"))
+			}
+
+			var err error
+			if options.Client, _, err = f.Clients(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+
+			kcmdutil.CheckErr(options.Run(f))
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to the manifest of the application.")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Show the changes to the application and its items without making them.")
+
+	return cmd
+}
+
+// manifestItem is the definition of an item in a manifest.
+type manifestItem struct {
+	Item   applicationapi.Item
+	Config []byte
+}
+
+// removal is an item dropped from the manifest, deleted unless it is shared.
+type removal struct {
+	Item    applicationapi.Item
+	Mapping *meta.RESTMapping
+	Helper  *resource.Helper
+	Shared  bool
+}
+
+func (o *ApplyApplicationOptions) Run(f *clientcmd.Factory) error {
+	namespace, explicit, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	clientMapper := f.ClientMapperForCommand()
+	infos, err := resource.NewBuilder(mapper, typer, clientMapper).
+		NamespaceParam(namespace).DefaultNamespace().
+		FilenameParam(explicit, o.Filenames...).
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+
+	var manifest *applicationapi.Application
+	members := []*resource.Info{}
+	for _, info := range infos {
+		if app, ok := info.Object.(*applicationapi.Application); ok {
+			if manifest != nil {
+				return fmt.Errorf("the manifest defines applications %s and %s, only one may be applied at once", manifest.Name, app.Name)
+			}
+			manifest, namespace = app, info.Namespace
+			continue
+		}
+		if !applicationutil.Contains(applicationapi.ApplicationItemSupportKinds, info.Mapping.Kind) {
+			return fmt.Errorf("%s %s can't be an item of an application", info.Mapping.Kind, info.Name)
+		}
+		members = append(members, info)
+	}
+	if manifest == nil {
+		return fmt.Errorf("the manifest defines no application")
+	}
+
+	app, err := o.Client.Applications(namespace).Get(manifest.Name)
+	exists := err == nil
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	last := map[applicationapi.Item][]byte{}
+	lastItems := applicationapi.ItemList{}
+	if exists {
+		if data, ok := app.Annotations[applicationapi.LastAppliedManifestAnnotation]; ok {
+			if lastItems, last, err = parseLastAppliedManifest([]byte(data)); err != nil {
+				return fmt.Errorf("unable to read the manifest last applied to application %s: %v", app.Name, err)
+			}
+		}
+	}
+
+	applied := []manifestItem{}
+	items := applicationapi.ItemList{}
+	for _, info := range members {
+		if info.Mapping.Scope.Name() == meta.RESTScopeNameNamespace && info.Namespace != namespace {
+			return fmt.Errorf("%s %s is not in the namespace %s of application %s", info.Mapping.Kind, info.Name, namespace, manifest.Name)
+		}
+		item := applicationapi.Item{Kind: info.Mapping.Kind, Name: info.Name}
+		config, err := info.Mapping.Codec.Encode(info.Object)
+		if err != nil {
+			return err
+		}
+		if config, err = applyConfiguration(config); err != nil {
+			return err
+		}
+		applied = append(applied, manifestItem{Item: item, Config: config})
+		items = appendItem(items, item)
+	}
+	for _, item := range manifest.Spec.Items {
+		items = appendItem(items, applicationapi.Item{Kind: item.Kind, Name: item.Name})
+	}
+
+	// whether a dropped item is shared is decided before the application is updated,
+	// the controller removes its label from the items it no longer holds
+	removals := []removal{}
+	for _, item := range lastItems {
+		if hasApplicationItem(items, item) {
+			continue
+		}
+		mapping, err := mapper.RESTMapping(item.Kind)
+		if err != nil {
+			return err
+		}
+		restClient, err := clientMapper.ClientForMapping(mapping)
+		if err != nil {
+			return err
+		}
+		helper := resource.NewHelper(restClient, mapping)
+		obj, err := helper.Get(namespace, item.Name)
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		objMeta, err := kapi.ObjectMetaFor(obj)
+		if err != nil {
+			return err
+		}
+		shared := applicationutil.LabelExistsOtherApplicationKey(objMeta.Labels, applicationutil.LabelKey(namespace, manifest.Name))
+		removals = append(removals, removal{Item: item, Mapping: mapping, Helper: helper, Shared: shared})
+	}
+
+	suffix := ""
+	if o.DryRun {
+		suffix = " (dry run)"
+	}
+
+	for i, info := range members {
+		helper := resource.NewHelper(info.Client, info.Mapping)
+		current, err := helper.Get(info.Namespace, info.Name)
+		if kerrors.IsNotFound(err) {
+			if !o.DryRun {
+				if _, err := helper.Create(info.Namespace, true, info.Object); err != nil {
+					return err
+				}
+			}
+			kcmdutil.PrintSuccess(mapper, false, o.Out, info.Mapping.Resource, info.Name, "created"+suffix)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		currentConfig, err := info.Mapping.Codec.Encode(current)
+		if err != nil {
+			return err
+		}
+		versioned, err := kapi.Scheme.New(info.Mapping.APIVersion, info.Mapping.Kind)
+		if err != nil {
+			return err
+		}
+		patch, err := strategicpatch.CreateThreeWayMergePatch(last[applied[i].Item], applied[i].Config, currentConfig, versioned, false)
+		if err != nil {
+			return fmt.Errorf("unable to merge %s %s: %v", info.Mapping.Kind, info.Name, err)
+		}
+		if string(patch) == "{}" {
+			kcmdutil.PrintSuccess(mapper, false, o.Out, info.Mapping.Resource, info.Name, "unchanged")
+			continue
+		}
+		if !o.DryRun {
+			if _, err := helper.Patch(info.Namespace, info.Name, kapi.StrategicMergePatchType, patch); err != nil {
+				return err
+			}
+		}
+		kcmdutil.PrintSuccess(mapper, false, o.Out, info.Mapping.Resource, info.Name, "configured"+suffix)
+		if o.DryRun {
+			fmt.Fprintf(o.Out, "  %s\n", patch)
+		}
+	}
+
+	manifestData, err := lastAppliedManifest(applied)
+	if err != nil {
+		return err
+	}
+	if exists {
+		for _, item := range items {
+			if !hasApplicationItem(app.Spec.Items, item) {
+				fmt.Fprintf(o.Out, "  + %s %s\n", item.Kind, item.Name)
+			}
+		}
+		for _, item := range app.Spec.Items {
+			if !hasApplicationItem(items, item) {
+				fmt.Fprintf(o.Out, "  - %s %s\n", item.Kind, item.Name)
+			}
+		}
+
+		for k, v := range manifest.Labels {
+			if app.Labels == nil {
+				app.Labels = map[string]string{}
+			}
+			app.Labels[k] = v
+		}
+		if app.Annotations == nil {
+			app.Annotations = map[string]string{}
+		}
+		for k, v := range manifest.Annotations {
+			app.Annotations[k] = v
+		}
+		app.Annotations[applicationapi.LastAppliedManifestAnnotation] = string(manifestData)
+		app.Spec.Items = items
+		if !o.DryRun {
+			if _, err := o.Client.Applications(namespace).Update(app); err != nil {
+				return err
+			}
+		}
+		kcmdutil.PrintSuccess(mapper, false, o.Out, "application", app.Name, "configured"+suffix)
+	} else {
+		app = &applicationapi.Application{}
+		app.Name = manifest.Name
+		app.Labels = manifest.Labels
+		app.Annotations = map[string]string{}
+		for k, v := range manifest.Annotations {
+			app.Annotations[k] = v
+		}
+		app.Annotations[applicationapi.LastAppliedManifestAnnotation] = string(manifestData)
+		app.Spec.Items = items
+		if !o.DryRun {
+			if _, err := o.Client.Applications(namespace).Create(app); err != nil {
+				return err
+			}
+		}
+		kcmdutil.PrintSuccess(mapper, false, o.Out, "application", app.Name, "created"+suffix)
+	}
+
+	for _, r := range removals {
+		if r.Shared {
+			kcmdutil.PrintSuccess(mapper, false, o.Out, r.Mapping.Resource, r.Item.Name, "kept, another application shares it"+suffix)
+			continue
+		}
+		if !o.DryRun {
+			if err := r.Helper.Delete(namespace, r.Item.Name); err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+		}
+		kcmdutil.PrintSuccess(mapper, false, o.Out, r.Mapping.Resource, r.Item.Name, "deleted"+suffix)
+	}
+
+	return nil
+}
+
+func appendItem(items applicationapi.ItemList, item applicationapi.Item) applicationapi.ItemList {
+	if hasApplicationItem(items, item) {
+		return items
+	}
+	return append(items, item)
+}
+
+func hasApplicationItem(items applicationapi.ItemList, item applicationapi.Item) bool {
+	for _, i := range items {
+		if i.Kind == item.Kind && i.Name == item.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// applyConfiguration returns the part of the configuration of an item apply-application
+// manages: status, the fields set by the server and the unset fields are left out, so
+// that they are not reset by the patches.
+func applyConfiguration(data []byte) ([]byte, error) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	delete(config, "status")
+	if metadata, ok := config["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"uid", "selfLink", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds"} {
+			delete(metadata, field)
+		}
+	}
+	pruneUnset(config)
+	return json.Marshal(config)
+}
+
+// pruneUnset removes the null, empty string and empty object values of config.
+func pruneUnset(config map[string]interface{}) {
+	for k, v := range config {
+		switch t := v.(type) {
+		case nil:
+			delete(config, k)
+		case string:
+			if len(t) == 0 {
+				delete(config, k)
+			}
+		case map[string]interface{}:
+			pruneUnset(t)
+			if len(t) == 0 {
+				delete(config, k)
+			}
+		case []interface{}:
+			for _, e := range t {
+				if m, ok := e.(map[string]interface{}); ok {
+					pruneUnset(m)
+				}
+			}
+		}
+	}
+}
+
+// appliedManifest is the List recorded in the LastAppliedManifestAnnotation.
+type appliedManifest struct {
+	Kind       string            `json:"kind"`
+	APIVersion string            `json:"apiVersion"`
+	Items      []json.RawMessage `json:"items"`
+}
+
+func lastAppliedManifest(items []manifestItem) ([]byte, error) {
+	manifest := appliedManifest{Kind: "List", APIVersion: "v1", Items: []json.RawMessage{}}
+	for _, item := range items {
+		manifest.Items = append(manifest.Items, json.RawMessage(item.Config))
+	}
+	return json.Marshal(manifest)
+}
+
+// parseLastAppliedManifest returns the items of the manifest recorded on an application,
+// in order, and their configurations.
+func parseLastAppliedManifest(data []byte) (applicationapi.ItemList, map[applicationapi.Item][]byte, error) {
+	manifest := appliedManifest{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, nil, err
+	}
+	items := applicationapi.ItemList{}
+	configs := map[applicationapi.Item][]byte{}
+	for _, raw := range manifest.Items {
+		object := struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}{}
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil, nil, err
+		}
+		item := applicationapi.Item{Kind: object.Kind, Name: object.Metadata.Name}
+		items = append(items, item)
+		configs[item] = []byte(raw)
+	}
+	return items, configs, nil
+}
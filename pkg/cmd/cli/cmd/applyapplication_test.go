@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+func TestApplyConfiguration(t *testing.T) {
+	config, err := applyConfiguration([]byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"web","creationTimestamp":null,"resourceVersion":"12","labels":{}},"spec":{"clusterIP":"","ports":[{"name":"","port":8080}],"sessionAffinity":"None"},"status":{"loadBalancer":{}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"},"spec":{"ports":[{"port":8080}],"sessionAffinity":"None"}}`
+	if string(config) != expected {
+		t.Errorf("expected %s, got %s", expected, config)
+	}
+}
+
+func TestLastAppliedManifest(t *testing.T) {
+	applied := []manifestItem{
+		{Item: applicationapi.Item{Kind: "DeploymentConfig", Name: "web"}, Config: []byte(`{"kind":"DeploymentConfig","metadata":{"name":"web"}}`)},
+		{Item: applicationapi.Item{Kind: "Service", Name: "web"}, Config: []byte(`{"kind":"Service","metadata":{"name":"web"}}`)},
+	}
+	data, err := lastAppliedManifest(applied)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	items, configs, err := parseLastAppliedManifest(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := applicationapi.ItemList{applied[0].Item, applied[1].Item}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected items %#v, got %#v", expected, items)
+	}
+	for _, item := range applied {
+		if string(configs[item.Item]) != string(item.Config) {
+			t.Errorf("%s %s: expected %s, got %s", item.Item.Kind, item.Item.Name, item.Config, configs[item.Item])
+		}
+	}
+}
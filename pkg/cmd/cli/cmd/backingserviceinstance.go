@@ -1,16 +1,26 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
-	//"github.com/openshift/origin/pkg/client"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
-	latestapi "github.com/openshift/origin/pkg/api/latest"
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	backingserviceinstancereaper "github.com/openshift/origin/pkg/backingserviceinstance/reaper"
 	"github.com/spf13/cobra"
 	"io"
+	"strings"
+	"time"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 	
 	//log "github.com/golang/glog"
 )
@@ -25,6 +35,53 @@ func GetBackingServicePlan(bs *backingserviceapi.BackingService, planId string)
 	return nil
 }
 
+// parseParameters reads the parameters of a broker request from a JSON or YAML file
+// of values, then sets the KEY=VALUE pairs. Values which aren't strings are kept
+// json encoded, the plan schema types them again.
+func parseParameters(pairs []string, file string) (map[string]string, error) {
+	params := map[string]string{}
+
+	if len(file) > 0 {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		values := map[string]interface{}{}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("%s must hold an object of parameters: %v", file, err)
+		}
+		for k, v := range values {
+			if s, ok := v.(string); ok {
+				params[k] = s
+			} else if b, err := json.Marshal(v); err == nil {
+				params[k] = string(b)
+			}
+		}
+	}
+
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("parameter %q must be KEY=VALUE", pair)
+		}
+		params[parts[0]] = parts[1]
+	}
+
+	if len(params) == 0 {
+		return nil, nil
+	}
+	return params, nil
+}
+
+// addParameterFlags adds the flags setting the parameters of a broker request.
+func addParameterFlags(cmd *cobra.Command, pairs *[]string, file *string) {
+	cmd.Flags().StringSliceVarP(pairs, "param", "p", *pairs, "Specify a list of key value pairs (e.g., -p size=2,engine=innodb) of parameters sent to the service broker.")
+	cmd.Flags().StringVar(file, "parameters-file", "", "Path to a JSON or YAML file holding an object of parameters sent to the service broker.")
+}
+
 //====================================================
 // new
 //====================================================
@@ -36,7 +93,10 @@ Create a new BackingServiceInstance
 This command will try to create a backing service instance.
 `
 	newBackingServiceInstanceExample = `# Create a new backingserviceinstance with [name BackingServiceName BackingServicePlanGuid]
-  $ %[1]s mysql_BackingServiceInstance --backingservice_name="BackingServiceName" --planid="BackingServicePlanGuid"`
+  $ %[1]s mysql_BackingServiceInstance --backingservice_name="BackingServiceName" --planid="BackingServicePlanGuid"
+
+  # Create a new backingserviceinstance with parameters the plan accepts
+  $ %[1]s mysql_BackingServiceInstance --backingservice_name="BackingServiceName" --planid="BackingServicePlanGuid" -p size=2 --parameters-file=mysql.yaml`
 )
 
 type NewBackingServiceInstanceOptions struct {
@@ -44,6 +104,10 @@ type NewBackingServiceInstanceOptions struct {
 	
 	BackingServiceName     string
 	BackingServicePlanGuid string
+
+	ParameterPairs []string
+	ParametersFile string
+	Parameters     map[string]string
 }
 
 func NewCmdNewBackingServiceInstance(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
@@ -69,6 +133,7 @@ func NewCmdNewBackingServiceInstance(fullName string, f *clientcmd.Factory, out
 
 	cmd.Flags().StringVar(&options.BackingServiceName, "backingservice_name", "", "BackingService Name")
 	cmd.Flags().StringVar(&options.BackingServicePlanGuid, "planid", "", "BackingService Plan GUID")
+	addParameterFlags(cmd, &options.ParameterPairs, &options.ParametersFile)
 	// todo: dashboard_url
 	
 	return cmd
@@ -83,7 +148,9 @@ func (o *NewBackingServiceInstanceOptions) complete(cmd *cobra.Command, f *clien
 
 	o.Name = args[0]
 
-	return nil
+	var err error
+	o.Parameters, err = parseParameters(o.ParameterPairs, o.ParametersFile)
+	return err
 }
 
 func (o *NewBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.Factory, out io.Writer) error {
@@ -92,8 +159,13 @@ func (o *NewBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.
 		return err
 	}
 	
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
 	//>> todo: maybe better do this is in Create
-	bs, err := client.BackingServices("openshift").Get(o.BackingServiceName)
+	bs, err := client.BackingServices(namespace).Get(o.BackingServiceName)
 	if err != nil {
 		return err
 	}
@@ -104,11 +176,6 @@ func (o *NewBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.
 	}
 	//<<
 	
-	namespace, _, err := f.DefaultNamespace()
-	if err != nil {
-		return err
-	}
-	
 	backingServiceInstance := &backingserviceinstanceapi.BackingServiceInstance{}
 	
 	backingServiceInstance.Name = o.Name
@@ -117,6 +184,7 @@ func (o *NewBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.
 	backingServiceInstance.Spec.BackingServiceName = bs.Name // o.BackingServiceName
 	//backingServiceInstance.Spec.BackingServiceID = bs.Spec.Id
 	backingServiceInstance.Spec.BackingServicePlanGuid = plan.Id // o.BackingServicePlanGuid
+	backingServiceInstance.Spec.Parameters = o.Parameters
 	//backingServiceInstance.Spec.BackingServicePlanName = plan.Name
 	
 	//backingServiceInstance.Status = backingserviceinstanceapi.BackingServiceInstancePhaseCreated
@@ -141,17 +209,24 @@ Edit a BackingServiceInstance
 
 This command will try to edit a backing service instance.
 `
-	editBackingServiceInstanceExample = `# Edit a backingserviceinstance with [name BackingServicePlanGuid]
-  $ %[1]s mysql_BackingServiceInstance --planid="BackingServicePlanGuid"`
+	editBackingServiceInstanceExample = `# Change the plan of a backingserviceinstance with [name BackingServicePlanGuid]
+  $ %[1]s mysql_BackingServiceInstance --plan_guid="BackingServicePlanGuid"
+
+  # Change a parameter of a backingserviceinstance, keeping its plan
+  $ %[1]s mysql_BackingServiceInstance -p size=4`
 )
 
 type EditBackingServiceInstanceOptions struct {
 	Name                   string
 	BackingServicePlanGuid string
+
+	ParameterPairs []string
+	ParametersFile string
+	Parameters     map[string]string
 }
 
 func NewCmdEditBackingServiceInstance(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
-	options := &NewBackingServiceInstanceOptions{}
+	options := &EditBackingServiceInstanceOptions{}
 
 	cmd := &cobra.Command{
 		Use:     "edit-backingserviceinstance NAME --plan_guid=BackingServicePlanGuid",
@@ -171,7 +246,8 @@ func NewCmdEditBackingServiceInstance(fullName string, f *clientcmd.Factory, out
 		},
 	}
 
-	cmd.Flags().StringVar(&options.BackingServicePlanGuid, "planid", "", "BackingService Plan GUID")
+	cmd.Flags().StringVar(&options.BackingServicePlanGuid, "plan_guid", "", "BackingService Plan GUID, the plan is kept if empty")
+	addParameterFlags(cmd, &options.ParameterPairs, &options.ParametersFile)
 	
 	return cmd
 }
@@ -185,6 +261,14 @@ func (o *EditBackingServiceInstanceOptions) complete(cmd *cobra.Command, f *clie
 
 	o.Name = args[0]
 
+	var err error
+	if o.Parameters, err = parseParameters(o.ParameterPairs, o.ParametersFile); err != nil {
+		return err
+	}
+	if len(o.BackingServicePlanGuid) == 0 && len(o.Parameters) == 0 {
+		cmd.Help()
+		return errors.New("must change the plan or the parameters")
+	}
 	return nil
 }
 
@@ -205,26 +289,130 @@ func (o *EditBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd
 	}
 	
 	//>> todo: maybe better do this is in Update
-	bs, err := client.BackingServices("openshift").Get(backingServiceInstance.Spec.BackingServiceName)
+	bs, err := client.BackingServices(namespace).Get(backingServiceInstance.Spec.BackingServiceName)
 	if err != nil {
 		return err
 	}
 	
+	if len(o.BackingServicePlanGuid) == 0 {
+		o.BackingServicePlanGuid = backingServiceInstance.Spec.BackingServicePlanGuid
+	}
 	plan := GetBackingServicePlan(bs, o.BackingServicePlanGuid)
 	if plan == nil {
 		return errors.New("plan not found")
 	}
 	//<<
 	
+	if !bs.Spec.PlanUpdateable && plan.Id != backingServiceInstance.Spec.BackingServicePlanGuid {
+		return fmt.Errorf("backingservice %s doesn't support plan changes", bs.Name)
+	}
+	
 	backingServiceInstance.Spec.BackingServicePlanGuid = o.BackingServicePlanGuid
+	if len(o.Parameters) > 0 {
+		params := backingserviceinstanceapi.ParametersOf(backingServiceInstance)
+		if params == nil {
+			params = map[string]string{}
+		}
+		for k, v := range o.Parameters {
+			params[k] = v
+		}
+		backingServiceInstance.Spec.Parameters = params
+	}
 	
 	_, err = client.BackingServiceInstances(namespace).Update(backingServiceInstance)
 	if err != nil {
 		return err
 	}
 	
-	fmt.Fprintf(out, "Backing Service Instance has been updated.\n")
+	fmt.Fprintf(out, "Backing Service Instance has been updated, the change is being applied by the service broker.\n")
+
+	return nil
+}
+
+//====================================================
+// delete
+//====================================================
+
+const (
+	deleteBackingServiceInstanceLong = `
+Delete a BackingServiceInstance
+
+This command deletes a backing service instance. An instance which is still bound is
+only deleted with --unbind, which unbinds all the bound resources before the instance
+is deprovisioned.
+`
+	deleteBackingServiceInstanceExample = `# Delete a backingserviceinstance which is not bound
+  $ %[1]s mysql_BackingServiceInstance
+
+  # Unbind a backingserviceinstance from all its resources and delete it
+  $ %[1]s mysql_BackingServiceInstance --unbind`
+)
+
+type DeleteBackingServiceInstanceOptions struct {
+	Name   string
+	Unbind bool
+}
+
+func NewCmdDeleteBackingServiceInstance(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &DeleteBackingServiceInstanceOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete-backingserviceinstance NAME [--unbind]",
+		Short:   "Delete a BackingServiceInstance",
+		Long:    deleteBackingServiceInstanceLong,
+		Example: fmt.Sprintf(deleteBackingServiceInstanceExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.complete(cmd, f); err != nil {
+				kcmdutil.CheckErr(err)
+				return
+			}
+
+			if err := options.Run(cmd, f, out); err != nil {
+				kcmdutil.CheckErr(err)
+				return
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Unbind, "unbind", false, "Unbind all the resources bound to the instance before deleting it")
+
+	return cmd
+}
+
+func (o *DeleteBackingServiceInstanceOptions) complete(cmd *cobra.Command, f *clientcmd.Factory) error {
+	args := cmd.Flags().Args()
+	if len(args) != 1 {
+		cmd.Help()
+		return errors.New("must have exactly 1 argument")
+	}
 
+	o.Name = args[0]
+	return nil
+}
+
+func (o *DeleteBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.Factory, out io.Writer) error {
+	client, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	if o.Unbind {
+		if _, err := backingserviceinstancereaper.NewBackingServiceInstanceReaper(client).Stop(namespace, o.Name, 0, nil); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "backingserviceinstance/%s deleted, its bindings are unbound before it is deprovisioned.\n", o.Name)
+		return nil
+	}
+
+	if err := client.BackingServiceInstances(namespace).Delete(o.Name); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "backingserviceinstance/%s deleted\n", o.Name)
 	return nil
 }
 
@@ -237,21 +425,58 @@ const (
 Bind a new BackingServiceInstance
 
 This command will try to bind a backing service instance and a deployment config.
+Other kinds of resources are given as KIND/NAME, where KIND is one of
+dc, rc, podtemplate, job, ds, deployment, bc or route (the credentials of a build
+config are only set in the environment of its builds).
+
+Binding a route forwards its requests through the route service of the backing
+service, when its service broker offers route services. Routes get no credentials.
+
+With --rotate, the credentials of an existing binding are replaced by the ones of a
+new binding at the service broker in a single update of the bound resource. The old
+binding is unbound once a deployment config is deployed with the new credentials.
 `
 	bindBackingServiceInstanceExample = `# Bind a new backingserviceinstance with a deploy config [BackingServiceInstanceName DeploymentConfigName]
-  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig`
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig
+
+  # Bind a backingserviceinstance with a replication controller, or any other KIND/NAME
+  $ %[1]s mysql_BackingServiceInstance rc/helloworld
+
+  # Forward the requests to a route through the route service of a backingserviceinstance
+  $ %[1]s ratelimit_BackingServiceInstance route/helloworld
+
+  # Bind and mount the credentials as files instead of environment variables
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig --inject=Volume --mount-path=/etc/mysql
+
+  # Bind with parameters the plan accepts
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig -p readonly=true
+
+  # Bind and rotate the credentials every 30 days
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig --rotation-period=720h
+
+  # Rotate the credentials of an existing binding now
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig --rotate`
 )
 
 type BindBackingServiceInstanceOptions struct {
 	Name                 string
+	Kind                 string
 	DeploymentConfigName string
+	Injection            string
+	MountPath            string
+	Rotate               bool
+	RotationPeriod       time.Duration
+
+	ParameterPairs []string
+	ParametersFile string
+	Parameters     map[string]string
 }
 
 func NewCmdBindBackingServiceInstance(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	options := &BindBackingServiceInstanceOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "bind BackingServiceInstanceName DeployConfigName",
+		Use:     "bind BackingServiceInstanceName DeployConfigName|KIND/NAME",
 		Short:   "bind a BackingServiceInstance and a DeployConfig",
 		Long:    bindBackingServiceInstanceLong,
 		Example: fmt.Sprintf(bindBackingServiceInstanceExample, fullName),
@@ -267,7 +492,13 @@ func NewCmdBindBackingServiceInstance(fullName string, f *clientcmd.Factory, out
 			}
 		},
 	}
-	
+
+	cmd.Flags().StringVar(&options.Injection, "inject", "", "How the credentials are given to the bound resource: Volume, the default, or Env which writes them into the resource")
+	cmd.Flags().StringVar(&options.MountPath, "mount-path", "", "Where the credentials are mounted with --inject=Volume")
+	cmd.Flags().BoolVar(&options.Rotate, "rotate", false, "Rotate the credentials of the existing binding instead of binding")
+	cmd.Flags().DurationVar(&options.RotationPeriod, "rotation-period", 0, "How often the credentials are rotated, e.g. 720h, never if 0. With --rotate, changes the period of the existing binding.")
+	addParameterFlags(cmd, &options.ParameterPairs, &options.ParametersFile)
+
 	return cmd
 }
 
@@ -278,10 +509,14 @@ func (o *BindBackingServiceInstanceOptions) complete(cmd *cobra.Command, f *clie
 		return errors.New("must have at least 2 arguments")
 	}
 
-	o.Name                 = args[0]
-	o.DeploymentConfigName = args[1]
+	o.Name = args[0]
 
-	return nil
+	var err error
+	if o.Kind, o.DeploymentConfigName, err = parseBindResource(args[1]); err != nil {
+		return err
+	}
+	o.Parameters, err = parseParameters(o.ParameterPairs, o.ParametersFile)
+	return err
 }
 
 func (o *BindBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.Factory, out io.Writer) error {
@@ -295,37 +530,62 @@ func (o *BindBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd
 		return err
 	}
 	
-	//>> todo: maybe better do this is in CreateBinding
-	/*
-	_, err = client.BackingServiceInstances(namespace).Get(o.Name)
+	if o.Rotate {
+		return o.rotate(client, namespace, out)
+	}
+
+	binding := &backingserviceinstanceapi.BackingServiceBinding{
+		ObjectMeta: kapi.ObjectMeta{
+			GenerateName: o.Name + "-",
+		},
+		Spec: backingserviceinstanceapi.BackingServiceBindingSpec{
+			BackingServiceInstanceName: o.Name,
+			BindKind:                   o.Kind,
+			ResourceName:               o.DeploymentConfigName,
+			Injection:                  backingserviceinstanceapi.BindingInjection(o.Injection),
+			MountPath:                  o.MountPath,
+			Parameters:                 o.Parameters,
+		},
+	}
+	if o.RotationPeriod > 0 {
+		binding.Spec.RotationPeriod = &unversioned.Duration{Duration: o.RotationPeriod}
+	}
+
+	binding, err = client.BackingServiceBindings(namespace).Create(binding)
 	if err != nil {
 		return err
 	}
-	
-	_, err = client.DeploymentConfigs(namespace).Get(o.DeploymentConfigName)
+
+	fmt.Fprintf(out, "backingservicebinding/%s created, the instance is bound once it is Bound.\n", binding.Name)
+
+	return nil
+}
+
+// rotate asks the controller to rotate the credentials of the binding of the instance
+// to the resource.
+func (o *BindBackingServiceInstanceOptions) rotate(client client.Interface, namespace string, out io.Writer) error {
+	binding, err := findBinding(client, namespace, o.Name, o.Kind, o.DeploymentConfigName)
 	if err != nil {
 		return err
 	}
-	*/
-	//<<
-	
-	bro := backingserviceinstanceapi.NewBindingRequestOptions(
-		backingserviceinstanceapi.BindKind_DeploymentConfig, 
-		latestapi.Version, 
-		o.DeploymentConfigName)
-	bro.Name = o.Name 
-	bro.Namespace = namespace
-	
-	err = client.BackingServiceInstances(namespace).CreateBinding(o.Name, bro)
-	if err != nil {
+	if binding.Status.Phase != backingserviceinstanceapi.BackingServiceBindingPhaseBound {
+		return fmt.Errorf("backingservicebinding/%s is %s, only Bound bindings can be rotated", binding.Name, binding.Status.Phase)
+	}
+
+	if binding.Annotations == nil {
+		binding.Annotations = map[string]string{}
+	}
+	binding.Annotations[backingserviceinstanceapi.RotateCredentialsAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if o.RotationPeriod > 0 {
+		binding.Spec.RotationPeriod = &unversioned.Duration{Duration: o.RotationPeriod}
+	}
+	if _, err := client.BackingServiceBindings(namespace).Update(binding); err != nil {
 		return err
 	}
-	
-	fmt.Fprintf(out, "Backing Service Instance has been bound.\n")
 
+	fmt.Fprintf(out, "backingservicebinding/%s is being rotated, the old credentials are revoked once the new ones are deployed.\n", binding.Name)
 	return nil
 }
-
 //====================================================
 // unbind
 //====================================================
@@ -337,11 +597,15 @@ Unbind a new BackingServiceInstance
 This command will try to unbind a backing service instance and a deployment config.
 `
 	unbindBackingServiceInstanceExample = `# Unbind a new backingserviceinstance with and deploy config [BackingServiceInstanceName DeploymentConfigName]
-  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig`
+  $ %[1]s mysql_BackingServiceInstance helloworld_DeploymentConfig
+
+  # Unbind a backingserviceinstance and a replication controller
+  $ %[1]s mysql_BackingServiceInstance rc/helloworld`
 )
 
 type UnbindBackingServiceInstanceOptions struct {
 	Name                 string
+	Kind                 string
 	DeploymentConfigName string
 }
 
@@ -349,7 +613,7 @@ func NewCmdUnbindBackingServiceInstance(fullName string, f *clientcmd.Factory, o
 	options := &UnbindBackingServiceInstanceOptions{}
 
 	cmd := &cobra.Command{
-		Use:     "unbind BackingServiceInstanceName DeployConfigName",
+		Use:     "unbind BackingServiceInstanceName DeployConfigName|KIND/NAME",
 		Short:   "unbind a BackingServiceInstance and a DeployConfig",
 		Long:    unbindBackingServiceInstanceLong,
 		Example: fmt.Sprintf(unbindBackingServiceInstanceExample, fullName),
@@ -376,10 +640,11 @@ func (o *UnbindBackingServiceInstanceOptions) complete(cmd *cobra.Command, f *cl
 		return errors.New("must have at least 2 arguments")
 	}
 
-	o.Name                 = args[0]
-	o.DeploymentConfigName = args[1]
+	o.Name = args[0]
 
-	return nil
+	var err error
+	o.Kind, o.DeploymentConfigName, err = parseBindResource(args[1])
+	return err
 }
 
 func (o *UnbindBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientcmd.Factory, out io.Writer) error {
@@ -393,36 +658,68 @@ func (o *UnbindBackingServiceInstanceOptions) Run(cmd *cobra.Command, f *clientc
 		return err
 	}
 	
-	//>> todo: maybe better do this is in DeleteBinding
-	/*
-	_, err = client.BackingServiceInstances(namespace).Get(o.Name)
+	binding, err := findBinding(client, namespace, o.Name, o.Kind, o.DeploymentConfigName)
 	if err != nil {
 		return err
 	}
-	
-	_, err = client.DeploymentConfigs(namespace).Get(o.DeploymentConfigName)
-	if err != nil {
+	if err := client.BackingServiceBindings(namespace).Delete(binding.Name); err != nil {
 		return err
 	}
-	*/
-	//<<
+	fmt.Fprintf(out, "backingservicebinding/%s deleted, the instance is unbound once it is gone.\n", binding.Name)
+	return nil
+}
 
-	bro := backingserviceinstanceapi.NewBindingRequestOptions(
-		backingserviceinstanceapi.BindKind_DeploymentConfig,
-		latestapi.Version,
-		o.DeploymentConfigName)
-	bro.Name = o.Name
-	bro.Namespace = namespace
-	
-	//err = client.BackingServiceInstances(namespace).DeleteBinding(o.Name)
-	err = client.BackingServiceInstances(namespace).UpdateBinding(o.Name,bro)
+// findBinding returns the binding of the instance name to the resource of kind.
+func findBinding(client client.Interface, namespace, name, kind, resourceName string) (*backingserviceinstanceapi.BackingServiceBinding, error) {
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: name})
+	bindings, err := client.BackingServiceBindings(namespace).List(selector, fields.Everything())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	
-	fmt.Fprintf(out, "Backing Service Instance has been unbound.\n")
 
-	return nil
+	key := backingserviceinstanceapi.BindingAnnotationKey(kind, resourceName)
+	for i := range bindings.Items {
+		if backingserviceinstanceapi.BindingAnnotationKey(bindings.Items[i].Spec.BindKind, bindings.Items[i].Spec.ResourceName) == key {
+			return &bindings.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s '%s' not bound to %s yet.", kind, resourceName, name)
 }
 
+// bindKindAliases maps the names the bind commands accept for a kind to the kind.
+var bindKindAliases = map[string]string{
+	"dc":                     backingserviceinstanceapi.BindKind_DeploymentConfig,
+	"deploymentconfig":       backingserviceinstanceapi.BindKind_DeploymentConfig,
+	"deploymentconfigs":      backingserviceinstanceapi.BindKind_DeploymentConfig,
+	"rc":                     backingserviceinstanceapi.BindKind_ReplicationController,
+	"replicationcontroller":  backingserviceinstanceapi.BindKind_ReplicationController,
+	"replicationcontrollers": backingserviceinstanceapi.BindKind_ReplicationController,
+	"podtemplate":            backingserviceinstanceapi.BindKind_PodTemplate,
+	"podtemplates":           backingserviceinstanceapi.BindKind_PodTemplate,
+	"job":                    backingserviceinstanceapi.BindKind_Job,
+	"jobs":                   backingserviceinstanceapi.BindKind_Job,
+	"ds":                     backingserviceinstanceapi.BindKind_DaemonSet,
+	"daemonset":              backingserviceinstanceapi.BindKind_DaemonSet,
+	"daemonsets":             backingserviceinstanceapi.BindKind_DaemonSet,
+	"deployment":             backingserviceinstanceapi.BindKind_Deployment,
+	"deployments":            backingserviceinstanceapi.BindKind_Deployment,
+	"bc":                     backingserviceinstanceapi.BindKind_BuildConfig,
+	"buildconfig":            backingserviceinstanceapi.BindKind_BuildConfig,
+	"buildconfigs":           backingserviceinstanceapi.BindKind_BuildConfig,
+	"route":                  backingserviceinstanceapi.BindKind_Route,
+	"routes":                 backingserviceinstanceapi.BindKind_Route,
+}
 
+// parseBindResource parses the resource argument of bind and unbind, either the
+// name of a DeploymentConfig or KIND/NAME.
+func parseBindResource(arg string) (kind, name string, err error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) == 1 {
+		return backingserviceinstanceapi.BindKind_DeploymentConfig, arg, nil
+	}
+	kind, ok := bindKindAliases[strings.ToLower(parts[0])]
+	if !ok || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("can't bind %q, expected KIND/NAME with KIND one of %s", arg, strings.Join(backingserviceinstanceapi.BindKinds, ", "))
+	}
+	return kind, parts[1], nil
+}
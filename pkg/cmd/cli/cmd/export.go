@@ -34,7 +34,13 @@ The --exact flag will instruct export to not clear fields that might be useful.
 versions.
 
 Another use case for export is to create reusable templates for applications. Pass --as-template
-to generate the API structure for a template to which you can add parameters and object labels.`
+to generate the API structure for a template to which you can add parameters and object labels.
+
+Exporting an application as a template exports its items and the bindings of its backing service
+instances to them, with parameters for the names of the application and of its items, for the
+images of the containers and for the plans of the instances. Any value equal to the name of an
+item is replaced by its parameter, so that the references between the items follow the new names.
+Instantiate it with 'new-application --from-template', the instances are provisioned and bound again.`
 
 	exportExample = `  # export the services and deployment configurations labeled name=test
   %[1]s export svc,dc -l name=test
@@ -42,6 +48,9 @@ to generate the API structure for a template to which you can add parameters and
   # export all services to a template
   %[1]s export service --as-template=test
 
+  # export the application shop and its items to a template
+  %[1]s export application shop --as-template=shop
+
   # export to JSON
   %[1]s export service -o json
 
@@ -117,6 +126,12 @@ func RunExport(f *clientcmd.Factory, exporter Exporter, in io.Reader, out io.Wri
 		return fmt.Errorf("no resources found - nothing to export")
 	}
 
+	if len(asTemplate) > 0 && !raw {
+		if infos, err = expandApplications(f, infos); err != nil {
+			return err
+		}
+	}
+
 	if !raw {
 		newInfos := []*resource.Info{}
 		errs := []error{}
@@ -137,14 +152,16 @@ func RunExport(f *clientcmd.Factory, exporter Exporter, in io.Reader, out io.Wri
 
 	var result runtime.Object
 	if len(asTemplate) > 0 {
+		template := &templateapi.Template{}
+		template.Name = asTemplate
+		if !raw && !exact {
+			parameterizeApplications(template, infos)
+		}
 		objects, err := resource.AsVersionedObjects(infos, outputVersion)
 		if err != nil {
 			return err
 		}
-		template := &templateapi.Template{
-			Objects: objects,
-		}
-		template.Name = asTemplate
+		template.Objects = objects
 		result, err = kapi.Scheme.ConvertToVersion(template, outputVersion)
 		if err != nil {
 			return err
@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/util/stringreplace"
+)
+
+// ApplicationNameParameter is the parameter of the name of the application exported
+// as a template, new-application sets it to the name it is given.
+const ApplicationNameParameter = "APPLICATION_NAME"
+
+// expandApplications replaces the Applications of infos by their items, followed by
+// the bindings of their instances to their other items and by the Applications
+// themselves, so that instantiating the template creates the items first. Items of
+// kinds which are not namespaced can't be instantiated in another project, they are
+// left out.
+func expandApplications(f *clientcmd.Factory, infos []*resource.Info) ([]*resource.Info, error) {
+	var items *applicationutil.ItemClient
+	var osClient client.Interface
+	mapper, typer := f.Object()
+	resourceMapper := &resource.Mapper{ObjectTyper: typer, RESTMapper: mapper, ClientMapper: f.ClientMapperForCommand()}
+
+	expanded := []*resource.Info{}
+	for _, info := range infos {
+		app, ok := info.Object.(*applicationapi.Application)
+		if !ok {
+			expanded = append(expanded, info)
+			continue
+		}
+		if items == nil {
+			oClient, kClient, err := f.Clients()
+			if err != nil {
+				return nil, err
+			}
+			items, osClient = applicationutil.NewItemClient(oClient, kClient), oClient
+		}
+
+		kept := applicationapi.ItemList{}
+		instances := []*backingserviceinstanceapi.BackingServiceInstance{}
+		for _, item := range app.Spec.Items {
+			obj, err := items.Get(app.Namespace, item)
+			if err != nil {
+				return nil, fmt.Errorf("unable to export %s %s of application %s: %v", item.Kind, item.Name, app.Name, err)
+			}
+			itemInfo, err := resourceMapper.InfoForObject(obj)
+			if err != nil {
+				return nil, err
+			}
+			if itemInfo.Mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+				glog.Warningf("%s %s of application %s is not namespaced, it is not exported", item.Kind, item.Name, app.Name)
+				continue
+			}
+			kept = append(kept, applicationapi.Item{Kind: item.Kind, Name: item.Name})
+			expanded = append(expanded, itemInfo)
+			if bsi, ok := obj.(*backingserviceinstanceapi.BackingServiceInstance); ok {
+				instances = append(instances, bsi)
+			}
+		}
+
+		for _, bsi := range instances {
+			bindings, err := applicationutil.ApplicationBindings(osClient, app.Namespace, bsi, kept)
+			if err != nil {
+				return nil, err
+			}
+			for i := range bindings {
+				bindingInfo, err := resourceMapper.InfoForObject(&bindings[i])
+				if err != nil {
+					return nil, err
+				}
+				expanded = append(expanded, bindingInfo)
+			}
+		}
+
+		app.Spec.Items = kept
+		expanded = append(expanded, info)
+	}
+	return expanded, nil
+}
+
+// templateParameters collects the parameters of a template, named uniquely.
+type templateParameters struct {
+	template *templateapi.Template
+	images   map[string]string
+}
+
+// add adds a parameter named name, suffixed if the name is taken, and returns the
+// expression of the parameter.
+func (p *templateParameters) add(name, value, description string) string {
+	unique := name
+	for i := 2; p.exists(unique); i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	p.template.Parameters = append(p.template.Parameters, templateapi.Parameter{
+		Name:        unique,
+		Description: description,
+		Value:       value,
+	})
+	return "${" + unique + "}"
+}
+
+func (p *templateParameters) exists(name string) bool {
+	for _, param := range p.template.Parameters {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// image returns the expression of the parameter of image, the same for all the
+// containers running it.
+func (p *templateParameters) image(name, image string) string {
+	if len(image) == 0 {
+		return image
+	}
+	if expr, ok := p.images[image]; ok {
+		return expr
+	}
+	expr := p.add(templateParameterName(name, "IMAGE"), image, "Image "+image)
+	p.images[image] = expr
+	return expr
+}
+
+func (p *templateParameters) containerImages(containers []kapi.Container) {
+	for i := range containers {
+		containers[i].Image = p.image(containers[i].Name, containers[i].Image)
+	}
+}
+
+// templateParameterName returns the upper cased name of a parameter of value.
+func templateParameterName(value, suffix string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(value))
+	return name + "_" + suffix
+}
+
+// parameterizeApplications adds to template parameters for the names of the Applications
+// of infos and of their items, for the images of the containers and for the plans of
+// the BackingServiceInstances. Any string equal to the name of an item is replaced by
+// its parameter, so that the references between the items, like the service of a route,
+// follow a new name.
+func parameterizeApplications(template *templateapi.Template, infos []*resource.Info) {
+	apps := []*applicationapi.Application{}
+	for _, info := range infos {
+		if app, ok := info.Object.(*applicationapi.Application); ok {
+			apps = append(apps, app)
+		}
+	}
+	if len(apps) == 0 {
+		return
+	}
+
+	params := &templateParameters{template: template, images: map[string]string{}}
+	names := map[string]string{}
+	imageStreams := sets.NewString()
+	for i, app := range apps {
+		name := ApplicationNameParameter
+		if i > 0 {
+			name = templateParameterName(app.Name, ApplicationNameParameter)
+		}
+		if _, ok := names[app.Name]; !ok {
+			names[app.Name] = params.add(name, app.Name, "Name of the application "+app.Name)
+		}
+	}
+	for _, app := range apps {
+		for _, item := range app.Spec.Items {
+			if _, ok := names[item.Name]; !ok {
+				names[item.Name] = params.add(templateParameterName(item.Name, "NAME"), item.Name, fmt.Sprintf("Name of %s %s", item.Kind, item.Name))
+			}
+			if item.Kind == "ImageStream" {
+				imageStreams.Insert(item.Name)
+			}
+		}
+	}
+
+	for _, info := range infos {
+		switch t := info.Object.(type) {
+		case *deployapi.DeploymentConfig:
+			if t.Spec.Template != nil {
+				params.containerImages(t.Spec.Template.Spec.Containers)
+			}
+		case *kapi.ReplicationController:
+			if t.Spec.Template != nil {
+				params.containerImages(t.Spec.Template.Spec.Containers)
+			}
+		case *kapi.Pod:
+			params.containerImages(t.Spec.Containers)
+		case *imageapi.ImageStream:
+			tags := []string{}
+			for tag := range t.Spec.Tags {
+				tags = append(tags, tag)
+			}
+			sort.Strings(tags)
+			for _, tag := range tags {
+				if from := t.Spec.Tags[tag].From; from != nil && from.Kind == "DockerImage" {
+					from.Name = params.image(t.Name+"_"+tag, from.Name)
+				}
+			}
+		case *backingserviceinstanceapi.BackingServiceInstance:
+			if len(t.Spec.BackingServicePlanGuid) > 0 {
+				t.Spec.BackingServicePlanGuid = params.add(templateParameterName(t.Name, "PLAN"), t.Spec.BackingServicePlanGuid,
+					fmt.Sprintf("Plan of the %s backing service of the instance %s", t.Spec.BackingServiceName, t.Name))
+			}
+		}
+	}
+
+	for _, info := range infos {
+		// the backing service of an instance is not an item, even if an item is named after it
+		var service, serviceNamespace string
+		bsi, isInstance := info.Object.(*backingserviceinstanceapi.BackingServiceInstance)
+		if isInstance {
+			service, serviceNamespace = bsi.Spec.BackingServiceName, bsi.Spec.BackingServiceNamespace
+		}
+
+		stringreplace.VisitObjectStrings(info.Object, func(s string) string {
+			if expr, ok := names[s]; ok {
+				return expr
+			}
+			// image stream tags are referred to as NAME:TAG
+			if i := strings.Index(s, ":"); i > 0 && imageStreams.Has(s[:i]) {
+				return names[s[:i]] + s[i:]
+			}
+			return s
+		})
+
+		if isInstance {
+			bsi.Spec.BackingServiceName, bsi.Spec.BackingServiceNamespace = service, serviceNamespace
+		}
+	}
+}
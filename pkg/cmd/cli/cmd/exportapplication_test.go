@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+func TestParameterizeApplications(t *testing.T) {
+	dc := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Labels: map[string]string{"app": "web"}},
+		Spec: deployapi.DeploymentConfigSpec{
+			Triggers: []deployapi.DeploymentTriggerPolicy{{
+				Type:              deployapi.DeploymentTriggerOnImageChange,
+				ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"}},
+			}},
+			Template: &kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{Containers: []kapi.Container{{Name: "web", Image: "library/web:1"}, {Name: "proxy", Image: "library/web:1"}}},
+			},
+		},
+	}
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "web"},
+		Spec:       routeapi.RouteSpec{To: kapi.ObjectReference{Kind: "Service", Name: "web"}},
+	}
+	bsi := &backingserviceinstanceapi.BackingServiceInstance{ObjectMeta: kapi.ObjectMeta{Name: "mysql"}}
+	bsi.Spec.BackingServiceName = "mysql"
+	bsi.Spec.BackingServicePlanGuid = "plan-1"
+	app := &applicationapi.Application{
+		ObjectMeta: kapi.ObjectMeta{Name: "shop"},
+		Spec: applicationapi.ApplicationSpec{Items: applicationapi.ItemList{
+			{Kind: "DeploymentConfig", Name: "web"},
+			{Kind: "ImageStream", Name: "web"},
+			{Kind: "Route", Name: "web"},
+			{Kind: "BackingServiceInstance", Name: "mysql"},
+		}},
+	}
+
+	template := &templateapi.Template{}
+	parameterizeApplications(template, []*resource.Info{{Object: dc}, {Object: route}, {Object: bsi}, {Object: app}})
+
+	expected := map[string]string{
+		ApplicationNameParameter: "shop",
+		"WEB_NAME":               "web",
+		"MYSQL_NAME":             "mysql",
+		"WEB_IMAGE":              "library/web:1",
+		"MYSQL_PLAN":             "plan-1",
+	}
+	if len(template.Parameters) != len(expected) {
+		t.Errorf("unexpected parameters %#v", template.Parameters)
+	}
+	for _, param := range template.Parameters {
+		if value, ok := expected[param.Name]; !ok || value != param.Value {
+			t.Errorf("unexpected parameter %#v", param)
+		}
+	}
+
+	if app.Name != "${APPLICATION_NAME}" || app.Spec.Items[0].Name != "${WEB_NAME}" || app.Spec.Items[3].Name != "${MYSQL_NAME}" {
+		t.Errorf("unexpected application %#v", app)
+	}
+	if dc.Name != "${WEB_NAME}" || dc.Labels["app"] != "${WEB_NAME}" {
+		t.Errorf("unexpected deployment config %#v", dc.ObjectMeta)
+	}
+	if from := dc.Spec.Triggers[0].ImageChangeParams.From.Name; from != "${WEB_NAME}:latest" {
+		t.Errorf("unexpected image stream tag %s", from)
+	}
+	for _, container := range dc.Spec.Template.Spec.Containers {
+		if container.Image != "${WEB_IMAGE}" {
+			t.Errorf("unexpected image %s", container.Image)
+		}
+	}
+	if route.Spec.To.Name != "${WEB_NAME}" {
+		t.Errorf("unexpected route service %s", route.Spec.To.Name)
+	}
+	if bsi.Spec.BackingServiceName != "mysql" || bsi.Spec.BackingServicePlanGuid != "${MYSQL_PLAN}" {
+		t.Errorf("unexpected instance %#v", bsi.Spec.InstanceProvisioning)
+	}
+}
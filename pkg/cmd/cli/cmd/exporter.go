@@ -22,6 +22,8 @@ import (
 	"k8s.io/kubernetes/pkg/registry/serviceaccount"
 	"k8s.io/kubernetes/pkg/runtime"
 
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildrest "github.com/openshift/origin/pkg/build/registry/build"
 	buildconfigrest "github.com/openshift/origin/pkg/build/registry/buildconfig"
@@ -55,6 +57,14 @@ func exportObjectMeta(objMeta *kapi.ObjectMeta, exact bool) {
 	if len(objMeta.GenerateName) > 0 && !exact {
 		objMeta.Name = ""
 	}
+	if !exact {
+		// the labels of the applications an object is an item of are keyed by their namespace
+		for key := range objMeta.Labels {
+			if strings.Contains(key, ".application.") {
+				delete(objMeta.Labels, key)
+			}
+		}
+	}
 }
 
 func (e *defaultExporter) Export(obj runtime.Object, exact bool) error {
@@ -172,6 +182,31 @@ func (e *defaultExporter) Export(obj runtime.Object, exact bool) error {
 			t.Status.Config = &kapi.ObjectReference{Name: t.Status.Config.Name}
 		}
 	case *routeapi.Route:
+		if exact {
+			return nil
+		}
+		// only the binding controller may point a route to a route service.
+		delete(t.Annotations, routeapi.RouteServiceURLAnnotation)
+	case *applicationapi.Application:
+		t.Status = applicationapi.ApplicationStatus{}
+		t.Spec.Destory = false
+		for i := range t.Spec.Items {
+			t.Spec.Items[i].Status = ""
+		}
+	case *backingserviceinstanceapi.BackingServiceInstance:
+		t.Status = backingserviceinstanceapi.BackingServiceInstanceStatus{}
+		if exact {
+			return nil
+		}
+		// the instance is provisioned again, and bound again by the bindings
+		t.Spec.DashboardUrl = ""
+		t.Spec.Parameters = backingserviceinstanceapi.ParametersOf(t)
+		t.Spec.Binding = nil
+		t.Spec.Bound = 0
+		t.Spec.InstanceID = ""
+	case *backingserviceinstanceapi.BackingServiceBinding:
+		t.Status = backingserviceinstanceapi.BackingServiceBindingStatus{}
+		delete(t.Labels, backingserviceinstanceapi.BackingServiceInstanceLabel)
 	case *imageapi.Image:
 	case *imageapi.ImageStream:
 		if exact {
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/fields"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	promoteLong = `
+Promote resources to another project
+
+The promotions are carried out by the server, which records the progress of each resource
+promoted.`
+
+	promoteApplicationLong = `
+Promote an application to another project
+
+The items of the application are copied into the project, or update the items of the same
+names there, then the application of the same name is created or updated. The image streams
+are tagged with the images the tags of the application are at, and the deployment configs
+deploy them. The backing service instances are provisioned again, with the plans mapped by
+--plan or the same plans, and bound again to the items they are bound to. Builds, pods and
+the replication controllers of deployment configs are created again by their owners, they
+are not copied.
+
+The promotion is an ApplicationPromotion, describe it to follow the progress of each item.`
+
+	promoteApplicationExample = `  # Promote the application shop to the project shop-test
+  $ %[1]s shop --to-project=shop-test
+
+  # Promote shop to shop-prod, provisioning its instances of the small plan with the large
+  # plan, and wait for the promotion to complete
+  $ %[1]s shop --to-project=shop-prod --plan=small=large --wait`
+)
+
+type PromoteApplicationOptions struct {
+	Name            string
+	TargetNamespace string
+	Plans           []string
+	Wait            bool
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+// NewCmdPromote returns the command promoting resources to other projects.
+func NewCmdPromote(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Promote resources to another project",
+		Long:  promoteLong,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.AddCommand(NewCmdPromoteApplication(fullName+" application", f, out))
+	return cmd
+}
+
+func NewCmdPromoteApplication(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &PromoteApplicationOptions{}
+	options.Out = out
+
+	cmd := &cobra.Command{
+		Use:     "application NAME --to-project=PROJECT [--plan=FROM=TO...] [--wait]",
+		Short:   "Promote an application to another project",
+		Long:    promoteApplicationLong,
+		Example: fmt.Sprintf(promoteApplicationExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "the name of the application to promote is required"))
+			}
+			options.Name = args[0]
+
+			var err error
+			if options.Client, _, err = f.Clients(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+
+			kcmdutil.CheckErr(options.Run(f))
+		},
+	}
+
+	cmd.Flags().StringVar(&options.TargetNamespace, "to-project", "", "The project to promote the application to.")
+	cmd.Flags().StringSliceVar(&options.Plans, "plan", options.Plans, "Map a plan of the backing service instances, by id or name, to the plan to provision them with: FROM=TO.")
+	cmd.Flags().BoolVar(&options.Wait, "wait", false, "Wait for the promotion to complete, and show the progress of each item.")
+
+	return cmd
+}
+
+func (o *PromoteApplicationOptions) Run(f *clientcmd.Factory) error {
+	if len(o.TargetNamespace) == 0 {
+		return errors.New("the project to promote the application to must be specified with --to-project")
+	}
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	plans, err := parsePlanMappings(o.Plans)
+	if err != nil {
+		return err
+	}
+
+	promotion := &applicationapi.ApplicationPromotion{}
+	promotion.GenerateName = o.Name + "-to-" + o.TargetNamespace + "-"
+	promotion.Spec = applicationapi.ApplicationPromotionSpec{
+		Application:     o.Name,
+		TargetNamespace: o.TargetNamespace,
+		Plans:           plans,
+	}
+	if promotion, err = o.Client.ApplicationPromotions(namespace).Create(promotion); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "applicationpromotion/%s created\n", promotion.Name)
+
+	if !o.Wait {
+		return nil
+	}
+	return o.wait(promotion)
+}
+
+// wait prints the items of promotion as they are promoted, until it completes.
+func (o *PromoteApplicationOptions) wait(promotion *applicationapi.ApplicationPromotion) error {
+	w, err := o.Client.ApplicationPromotions(promotion.Namespace).Watch(labels.Everything(), fields.OneTermEqualSelector("metadata.name", promotion.Name), promotion.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	printed := 0
+	for event := range w.ResultChan() {
+		if event.Type == watch.Deleted {
+			return fmt.Errorf("applicationpromotion %s was deleted", promotion.Name)
+		}
+		promotion, ok := event.Object.(*applicationapi.ApplicationPromotion)
+		if !ok {
+			continue
+		}
+
+		for ; printed < len(promotion.Status.Items); printed++ {
+			item := promotion.Status.Items[printed]
+			if item.Phase == applicationapi.ApplicationPromotionItemPending {
+				break
+			}
+			line := fmt.Sprintf("%s/%s %s", strings.ToLower(item.Kind), item.Name, strings.ToLower(string(item.Phase)))
+			if len(item.Message) > 0 {
+				line += ": " + item.Message
+			}
+			fmt.Fprintln(o.Out, line)
+		}
+
+		switch promotion.Status.Phase {
+		case applicationapi.ApplicationPromotionComplete:
+			fmt.Fprintln(o.Out, promotion.Status.Message)
+			return nil
+		case applicationapi.ApplicationPromotionFailed:
+			return errors.New(promotion.Status.Message)
+		}
+	}
+	return fmt.Errorf("stopped watching applicationpromotion %s before it completed", promotion.Name)
+}
+
+// parsePlanMappings parses the FROM=TO mappings of plans.
+func parsePlanMappings(mappings []string) (map[string]string, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	plans := map[string]string{}
+	for _, mapping := range mappings {
+		kv := strings.SplitN(mapping, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 || len(kv[1]) == 0 {
+			return nil, fmt.Errorf("invalid plan mapping %q: expected FROM=TO", mapping)
+		}
+		plans[kv[0]] = kv[1]
+	}
+	return plans, nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/watch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+func TestParsePlanMappings(t *testing.T) {
+	plans, err := parsePlanMappings([]string{"small=large", "plan-1=plan=2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{"small": "large", "plan-1": "plan=2"}
+	if !reflect.DeepEqual(plans, expected) {
+		t.Errorf("expected %v, got %v", expected, plans)
+	}
+
+	for _, mapping := range []string{"small", "=large", "small="} {
+		if _, err := parsePlanMappings([]string{mapping}); err == nil {
+			t.Errorf("%s: expected an error", mapping)
+		}
+	}
+}
+
+func TestPromoteWait(t *testing.T) {
+	w := watch.NewFake()
+	client := testclient.NewSimpleFake()
+	client.AddWatchReactor("applicationpromotions", func(action ktestclient.Action) (bool, watch.Interface, error) {
+		return true, w, nil
+	})
+
+	promotion := &applicationapi.ApplicationPromotion{ObjectMeta: kapi.ObjectMeta{Namespace: "dev", Name: "shop-to-test-abcde"}}
+	go func() {
+		running := *promotion
+		running.Status = applicationapi.ApplicationPromotionStatus{
+			Phase: applicationapi.ApplicationPromotionRunning,
+			Items: []applicationapi.ApplicationPromotionItemStatus{
+				{Kind: "ImageStream", Name: "shop", Phase: applicationapi.ApplicationPromotionItemCreated},
+				{Kind: "DeploymentConfig", Name: "shop", Phase: applicationapi.ApplicationPromotionItemPending},
+			},
+		}
+		w.Modify(&running)
+
+		complete := running
+		complete.Status.Phase = applicationapi.ApplicationPromotionComplete
+		complete.Status.Message = "promoted 2 items"
+		complete.Status.Items = []applicationapi.ApplicationPromotionItemStatus{
+			running.Status.Items[0],
+			{Kind: "DeploymentConfig", Name: "shop", Phase: applicationapi.ApplicationPromotionItemUpdated},
+		}
+		w.Modify(&complete)
+	}()
+
+	out := &bytes.Buffer{}
+	o := &PromoteApplicationOptions{Client: client, Out: out}
+	if err := o.wait(promotion); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "imagestream/shop created\ndeploymentconfig/shop updated\npromoted 2 items\n"
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
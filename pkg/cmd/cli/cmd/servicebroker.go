@@ -8,6 +8,9 @@ import (
 	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
 	"github.com/spf13/cobra"
 	"io"
+	"io/ioutil"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"net/url"
 	"strings"
@@ -19,7 +22,19 @@ Create a new servicebroker for administrator
 
 `
 	newServiceBrokerExample = `# Create a new servicebroker with [name username password url]
-  $ %[1]s  mysql_servicebroker  --username="username"  --password="password" --url="127.0.0.1:8000"`
+  $ %[1]s  mysql_servicebroker  --username="username"  --password="password" --url="127.0.0.1:8000"
+
+  # Create a new servicebroker whose credentials are in the username and password keys of secret openshift/mysql-broker-auth
+  $ %[1]s  mysql_servicebroker  --auth-secret=mysql-broker-auth --url="127.0.0.1:8000"
+
+  # Create a new servicebroker served over https, trusting the CA in ca.crt
+  $ %[1]s  mysql_servicebroker  --username="username"  --password="password" --url="https://broker.example.com" --ca-file=ca.crt
+
+  # Create a new servicebroker authenticating with the client certificate in the tls.crt and tls.key keys of secret openshift/broker-client
+  $ %[1]s  mysql_servicebroker  --url="https://broker.example.com" --client-cert-secret=openshift/broker-client
+
+  # Create a new servicebroker of the current project, whose services only it sees
+  $ %[1]s  mysql_servicebroker  --username="username"  --password="password" --url="127.0.0.1:8000" --project`
 )
 
 type NewServiceBrokerOptions struct {
@@ -28,7 +43,23 @@ type NewServiceBrokerOptions struct {
 	UserName string
 	Password string
 
-	Client client.Interface
+	AuthSecret string
+
+	CAFile                string
+	CASecret              string
+	InsecureSkipTLSVerify bool
+	ClientCertSecret      string
+
+	CABundle            []byte
+	CASecretRef         *servicebrokerapi.SecretReference
+	ClientCertSecretRef *servicebrokerapi.SecretReference
+
+	// Project creates a ProjectServiceBroker in Namespace.
+	Project   bool
+	Namespace string
+
+	Client     client.Interface
+	KubeClient kclient.Interface
 
 	Out io.Writer
 }
@@ -49,7 +80,7 @@ func NewCmdServiceBroker(fullName string, f *clientcmd.Factory, out io.Writer) *
 				return
 			}
 
-			if options.Client, _, err = f.Clients(); err != nil {
+			if options.Client, options.KubeClient, err = f.Clients(); err != nil {
 				kcmdutil.CheckErr(err)
 			}
 
@@ -65,6 +96,12 @@ func NewCmdServiceBroker(fullName string, f *clientcmd.Factory, out io.Writer) *
 	//	cmd.Flags().StringVar(&options.Name, "name", "", "ServiceBroker Name")
 	cmd.Flags().StringVar(&options.UserName, "username", "", "ServiceBroker username")
 	cmd.Flags().StringVar(&options.Password, "password", "", "ServiceBroker Password")
+	cmd.Flags().StringVar(&options.AuthSecret, "auth-secret", "", "Name of a secret in the "+servicebrokerapi.ServiceBrokerSecretNamespace+" namespace, or the project with --project, holding the ServiceBroker username and password")
+	cmd.Flags().StringVar(&options.CAFile, "ca-file", "", "Path to a PEM encoded CA bundle trusted to serve the ServiceBroker")
+	cmd.Flags().StringVar(&options.CASecret, "ca-secret", "", "NAMESPACE/NAME of a secret holding the CA bundle in its ca.crt key")
+	cmd.Flags().BoolVar(&options.InsecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Don't verify the certificate the ServiceBroker serves")
+	cmd.Flags().StringVar(&options.ClientCertSecret, "client-cert-secret", "", "NAMESPACE/NAME of a secret holding the client certificate and key in its tls.crt and tls.key keys")
+	cmd.Flags().BoolVar(&options.Project, "project", false, "Create a broker of the current project, its secrets are in the project too")
 
 	return cmd
 }
@@ -82,13 +119,43 @@ func (o *NewServiceBrokerOptions) complete(cmd *cobra.Command, f *clientcmd.Fact
 		return errors.New("wrong param url format")
 	}
 
-	o.Url = URL.Host
-
-	if len(o.Url) == 0 {
+	if len(URL.Host) == 0 {
 		cmd.Help()
 		return errors.New("wrong param url format")
 	}
 
+	// keep the scheme if one was given, brokers without one are reached over http.
+	if strings.Contains(o.Url, "://") {
+		o.Url = strings.TrimRight(URL.Scheme+"://"+URL.Host+URL.Path, "/")
+	} else {
+		o.Url = strings.TrimRight(URL.Host+URL.Path, "/")
+	}
+
+	if len(o.AuthSecret) > 0 && (len(o.UserName) > 0 || len(o.Password) > 0) {
+		return errors.New("--auth-secret may not be used together with --username and --password")
+	}
+
+	if len(o.CAFile) > 0 {
+		if o.CABundle, err = ioutil.ReadFile(o.CAFile); err != nil {
+			return err
+		}
+	}
+	if o.CASecretRef, err = parseSecretReference(o.CASecret); err != nil {
+		return err
+	}
+	if o.ClientCertSecretRef, err = parseSecretReference(o.ClientCertSecret); err != nil {
+		return err
+	}
+	if o.InsecureSkipTLSVerify && (len(o.CABundle) > 0 || o.CASecretRef != nil) {
+		return errors.New("--insecure-skip-tls-verify may not be used together with a CA bundle")
+	}
+
+	if o.Project {
+		if o.Namespace, _, err = f.DefaultNamespace(); err != nil {
+			return err
+		}
+	}
+
 	o.Name = args[0]
 
 	return nil
@@ -96,7 +163,12 @@ func (o *NewServiceBrokerOptions) complete(cmd *cobra.Command, f *clientcmd.Fact
 
 func (o *NewServiceBrokerOptions) Run() error {
 
-	_, err := o.Client.ServiceBrokers().Get(o.Name)
+	var err error
+	if o.Project {
+		_, err = o.Client.ProjectServiceBrokers(o.Namespace).Get(o.Name)
+	} else {
+		_, err = o.Client.ServiceBrokers().Get(o.Name)
+	}
 	if err == nil {
 		return errors.New(fmt.Sprintf("servicebroker %s already exists", o.Name))
 	}
@@ -104,19 +176,76 @@ func (o *NewServiceBrokerOptions) Run() error {
 	serviceBroker := &servicebrokerapi.ServiceBroker{}
 	serviceBroker.Spec.Name = o.Name
 	serviceBroker.Spec.Url = o.Url
-	serviceBroker.Spec.UserName = o.UserName
-	serviceBroker.Spec.Password = o.Password
+	if authSecretRef, err := o.authSecretRef(); err != nil {
+		return err
+	} else {
+		serviceBroker.Spec.AuthSecretRef = authSecretRef
+	}
+	serviceBroker.Spec.CABundle = o.CABundle
+	serviceBroker.Spec.CASecretRef = o.CASecretRef
+	serviceBroker.Spec.InsecureSkipTLSVerify = o.InsecureSkipTLSVerify
+	serviceBroker.Spec.ClientCertSecretRef = o.ClientCertSecretRef
 	serviceBroker.Annotations = make(map[string]string)
 	serviceBroker.Name = o.Name
 	serviceBroker.GenerateName = o.Name
 	serviceBroker.Status.Phase = servicebrokerapi.ServiceBrokerNew
 
-	_, err = o.Client.ServiceBrokers().Create(serviceBroker)
+	if o.Project {
+		serviceBroker.Namespace = o.Namespace
+		_, err = o.Client.ProjectServiceBrokers(o.Namespace).Create(servicebrokerapi.ProjectServiceBrokerOf(serviceBroker))
+	} else {
+		_, err = o.Client.ServiceBrokers().Create(serviceBroker)
+	}
+	if err != nil && len(o.AuthSecret) == 0 && serviceBroker.Spec.AuthSecretRef != nil {
+		// the secret was created from --username and --password for this broker only
+		ref := serviceBroker.Spec.AuthSecretRef
+		if deleteErr := o.KubeClient.Secrets(ref.Namespace).Delete(ref.Name); deleteErr != nil {
+			fmt.Fprintf(o.Out, "warning: failed to delete secret %s/%s: %v\n", ref.Namespace, ref.Name, deleteErr)
+		}
+	}
+	return err
+}
+
+// secretNamespace returns the namespace the secrets of the broker are in.
+func (o *NewServiceBrokerOptions) secretNamespace() string {
+	if o.Project {
+		return o.Namespace
+	}
+	return servicebrokerapi.ServiceBrokerSecretNamespace
+}
+
+// authSecretRef returns the secret holding the broker credentials, the secret is
+// created from --username and --password if they are given.
+func (o *NewServiceBrokerOptions) authSecretRef() (*servicebrokerapi.SecretReference, error) {
+	if len(o.AuthSecret) > 0 {
+		return &servicebrokerapi.SecretReference{Namespace: o.secretNamespace(), Name: o.AuthSecret}, nil
+	}
+
+	if len(o.UserName) == 0 && len(o.Password) == 0 {
+		return nil, nil
+	}
+
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			GenerateName: "servicebroker-",
+			Namespace:    o.secretNamespace(),
+			Labels: map[string]string{
+				servicebrokerapi.ServiceBrokerLabel: o.Name,
+			},
+		},
+		Type: kapi.SecretTypeOpaque,
+		Data: map[string][]byte{
+			servicebrokerapi.SecretUsernameKey: []byte(o.UserName),
+			servicebrokerapi.SecretPasswordKey: []byte(o.Password),
+		},
+	}
+
+	secret, err := o.KubeClient.Secrets(secret.Namespace).Create(secret)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &servicebrokerapi.SecretReference{Namespace: secret.Namespace, Name: secret.Name}, nil
 }
 
 func setUrl(url string) string {
@@ -125,4 +254,18 @@ func setUrl(url string) string {
 	}
 
 	return url
-}
\ No newline at end of file
+}
+
+// parseSecretReference parses a NAMESPACE/NAME secret reference.
+func parseSecretReference(ref string) (*servicebrokerapi.SecretReference, error) {
+	if len(ref) == 0 {
+		return nil, nil
+	}
+
+	parts := strings.Split(ref, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, fmt.Errorf("secret reference %q must be NAMESPACE/NAME", ref)
+	}
+
+	return &servicebrokerapi.SecretReference{Namespace: parts[0], Name: parts[1]}, nil
+}
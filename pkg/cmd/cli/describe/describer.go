@@ -21,11 +21,13 @@ import (
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	kctl "k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
@@ -39,11 +41,17 @@ import (
 )
 
 func describerMap(c *client.Client, kclient kclient.Interface, host string) map[string]kctl.Describer {
+	// the kube client is a REST client unless it is faked.
+	kRESTClient, _ := kclient.(resource.RESTClient)
 	m := map[string]kctl.Describer{
-		"Application":            &ApplicationDescriber{c, kclient},
+		"Application":            &ApplicationDescriber{c, applicationutil.NewItemClient(c, kRESTClient)},
+		"ApplicationPromotion":   &ApplicationPromotionDescriber{c},
 		"ServiceBroker":          &ServiceBrokerDescriber{c},
+		"ProjectServiceBroker":   &ProjectServiceBrokerDescriber{c},
 		"BackingService":         &BackingServiceDescriber{c, kclient},
 		"BackingServiceInstance": &BackingServiceInstanceDescriber{c, kclient},
+		"BackingServiceBinding":  &BackingServiceBindingDescriber{c, kclient},
+		"BackingServiceUsage":    &BackingServiceUsageDescriber{c},
 		"Build":                  &BuildDescriber{c, kclient},
 		"BuildConfig":            &BuildConfigDescriber{c, host},
 		"DeploymentConfig":       NewDeploymentConfigDescriber(c, kclient),
@@ -107,17 +115,90 @@ func (d *ServiceBrokerDescriber) Describe(namespace, name string) (string, error
 	return describeServiceBroker(bs)
 }
 
+// ProjectServiceBrokerDescriber generates information about a ProjectServiceBroker
+type ProjectServiceBrokerDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a ProjectServiceBroker
+func (d *ProjectServiceBrokerDescriber) Describe(namespace, name string) (string, error) {
+	psb, err := d.ProjectServiceBrokers(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return describeServiceBroker(servicebrokerapi.ServiceBrokerOf(psb))
+}
+
+// maskedPassword is printed instead of passwords.
+const maskedPassword = "******"
+
 func describeServiceBroker(sb *servicebrokerapi.ServiceBroker) (string, error) {
 	return tabbedString(func(out *tabwriter.Writer) error {
 		formatMeta(out, sb.ObjectMeta)
 		formatString(out, "Url", sb.Spec.Url)
-		formatString(out, "Username", sb.Spec.UserName)
-		formatString(out, "Password", sb.Spec.Password)
+		if ref := sb.Spec.AuthSecretRef; ref != nil {
+			formatString(out, "Auth Secret", ref.Namespace+"/"+ref.Name)
+		}
+		if len(sb.Spec.UserName) > 0 {
+			formatString(out, "Username", sb.Spec.UserName)
+		}
+		if len(sb.Spec.Password) > 0 {
+			formatString(out, "Password", maskedPassword)
+		}
+		if len(sb.Spec.CABundle) > 0 {
+			formatString(out, "CA Bundle", fmt.Sprintf("%d bytes", len(sb.Spec.CABundle)))
+		}
+		if ref := sb.Spec.CASecretRef; ref != nil {
+			formatString(out, "CA Secret", ref.Namespace+"/"+ref.Name)
+		}
+		if sb.Spec.InsecureSkipTLSVerify {
+			formatString(out, "Insecure Skip TLS Verify", sb.Spec.InsecureSkipTLSVerify)
+		}
+		if ref := sb.Spec.ClientCertSecretRef; ref != nil {
+			formatString(out, "Client Cert Secret", ref.Namespace+"/"+ref.Name)
+		}
+		for _, rule := range sb.Spec.Visibility {
+			formatString(out, "Visibility", formatVisibility(rule))
+		}
+		hc := servicebrokerapi.HealthCheckOf(sb)
+		formatString(out, "Health Check", fmt.Sprintf("every %ds, timeout %ds, failed after %d failures", hc.IntervalSeconds, hc.TimeoutSeconds, hc.FailureThreshold))
 		formatString(out, "Status", sb.Status.Phase)
+		for _, condition := range sb.Status.Conditions {
+			formatString(out, string(condition.Type), fmt.Sprintf("%s %s %s", condition.Status, condition.Reason, condition.Message))
+			if !condition.LastProbeTime.IsZero() {
+				formatString(out, "Last Probe", condition.LastProbeTime.String())
+			}
+		}
+		if sb.Status.ConsecutiveFailures > 0 {
+			formatString(out, "Consecutive Failures", sb.Status.ConsecutiveFailures)
+		}
+		if len(sb.Status.CatalogHash) > 0 {
+			formatString(out, "Services", sb.Status.ServiceCount)
+			formatString(out, "Catalog Hash", sb.Status.CatalogHash)
+		}
 		return nil
 	})
 }
 
+// formatVisibility prints a visibility rule as the services and plans it restricts,
+// followed by the projects they are visible to.
+func formatVisibility(rule servicebrokerapi.ServiceVisibility) string {
+	services, plans := "*", "*"
+	if len(rule.Services) > 0 {
+		services = strings.Join(rule.Services, ",")
+	}
+	if len(rule.Plans) > 0 {
+		plans = strings.Join(rule.Plans, ",")
+	}
+
+	visibleTo := append([]string{}, rule.Namespaces...)
+	if len(rule.NamespaceSelector) > 0 {
+		visibleTo = append(visibleTo, "projects labeled "+labels.Set(rule.NamespaceSelector).String())
+	}
+	return fmt.Sprintf("services %s, plans %s: %s", services, plans, strings.Join(visibleTo, ", "))
+}
+
 // BackingServiceDescriber generates information about a Image
 type BackingServiceDescriber struct {
 	osClient   client.Interface
@@ -165,6 +246,9 @@ func describeBackingService(bs *backingserviceapi.BackingService, events *kapi.E
 			formatString(out, "PlanID", plan.Id)
 			formatString(out, "PlanDesc", plan.Description)
 			formatString(out, "PlanFree", plan.Free)
+			if plan.Deprecated {
+				formatString(out, "PlanDeprecated", plan.Deprecated)
+			}
 			fmt.Fprintf(out, "Bullets:\n")
 			for _, bullet := range plan.Metadata.Bullets {
 				fmt.Fprintf(out, "  %s\n", bullet)
@@ -187,8 +271,8 @@ func describeBackingService(bs *backingserviceapi.BackingService, events *kapi.E
 }
 
 type ApplicationDescriber struct {
-	osClient   client.Interface
-	kubeClient kclient.Interface
+	osClient client.Interface
+	items    *applicationutil.ItemClient
 }
 
 func (appDescriber *ApplicationDescriber) Describe(namespace, name string) (string, error) {
@@ -199,91 +283,24 @@ func (appDescriber *ApplicationDescriber) Describe(namespace, name string) (stri
 	}
 
 	itemDescriberStr := "\n"
-	itemDescriberStr += printItem("Object Type", "Name", "Create Time")
+	itemDescriberStr += printItem("Object Type", "Name", "Create Time", "Health")
 
-	var itemCreateTime string
 	for _, item := range application.Spec.Items {
-		switch item.Kind {
-		case "Build":
-			b, _ := appDescriber.osClient.Builds(application.Namespace).Get(item.Name)
-			itemCreateTime = b.CreationTimestamp.String()
-
-		case "BuildConfig":
-			bc, _ := appDescriber.osClient.BuildConfigs(application.Namespace).Get(item.Name)
-
-			itemCreateTime = bc.CreationTimestamp.String()
-
-		case "DeploymentConfig":
-			dc, _ := appDescriber.osClient.DeploymentConfigs(application.Namespace).Get(item.Name)
-
-			itemCreateTime = dc.CreationTimestamp.String()
-
-		case "ImageStream":
-			is, _ := appDescriber.osClient.ImageStreams(application.Namespace).Get(item.Name)
-
-			itemCreateTime = is.CreationTimestamp.String()
-
-		case "ImageStreamTag":
-		//	is := appDescriber.Interface.ImageStreams(application.Namespace).Get(item.Name)
-		//	itemCreateTime = is.CreationTimestamp.String()
-		//	itemStatus = is.Status.Phase
-		//if ist, err := c.Client.ImageStreamTags(app.Namespace).Get(item.Name); ist != nil {
-		//	return err
-		//} else {
-		//	ist.Labels[applicationapi.ApplicationSelector] = app.Name
-		//}
-
-		case "ImageStreamImage":
-		//if isi, err := c.Client.ImageStreamImages(app.Namespace).Get(item.Name); isi != nil {
-		//	return err
-		//} else {
-		//	isi.Labels[applicationapi.ApplicationSelector] = app.Name
-		//}
-
-		case "Event":
-			e, _ := appDescriber.kubeClient.Events(application.Namespace).Get(item.Name)
-			itemCreateTime = e.CreationTimestamp.String()
-
-		case "Node":
-			n, _ := appDescriber.kubeClient.Nodes().Get(item.Name)
-			itemCreateTime = n.CreationTimestamp.String()
-
-		case "Job":
-
-		case "Pod":
-			p, _ := appDescriber.kubeClient.Pods(application.Namespace).Get(item.Name)
-			itemCreateTime = p.CreationTimestamp.String()
-
-		case "ReplicationController":
-			r, _ := appDescriber.kubeClient.ReplicationControllers(application.Namespace).Get(item.Name)
-			itemCreateTime = r.CreationTimestamp.String()
-
-		case "Service":
-			s, _ := appDescriber.kubeClient.Services(application.Namespace).Get(item.Name)
-			itemCreateTime = s.CreationTimestamp.String()
-
-		case "PersistentVolume":
-			pv, _ := appDescriber.kubeClient.PersistentVolumes().Get(item.Name)
-			itemCreateTime = pv.CreationTimestamp.String()
-
-		case "PersistentVolumeClaim":
-			pvc, _ := appDescriber.kubeClient.PersistentVolumeClaims(application.Namespace).Get(item.Name)
-			itemCreateTime = pvc.CreationTimestamp.String()
-
-		case "ServiceBroker":
-			sb, _ := appDescriber.osClient.ServiceBrokers().Get(item.Name)
-			itemCreateTime = sb.CreationTimestamp.String()
-
-		case "BackingService":
-			bs, _ := appDescriber.osClient.BackingServices(namespace).Get(item.Name)
-			itemCreateTime = bs.CreationTimestamp.String()
+		var itemCreateTime string
+		if obj, err := appDescriber.items.Get(application.Namespace, item); err == nil {
+			if objMeta, err := kapi.ObjectMetaFor(obj); err == nil {
+				itemCreateTime = objMeta.CreationTimestamp.String()
+			}
+		}
 
-		case "BackingServiceInstance":
-			bsi, _ := appDescriber.osClient.BackingServiceInstances(application.Namespace).Get(item.Name)
-			itemCreateTime = bsi.CreationTimestamp.String()
+		var itemHealth string
+		for _, status := range application.Status.Items {
+			if status.Kind == item.Kind && status.Name == item.Name {
+				itemHealth = formatItemHealth(status)
+			}
 		}
 
-		itemDescriberStr += printItem(item.Kind, item.Name, itemCreateTime)
+		itemDescriberStr += printItem(item.Kind, item.Name, itemCreateTime, itemHealth)
 	}
 
 	return describeApplication(application, itemDescriberStr)
@@ -300,12 +317,59 @@ func describeApplication(app *applicationapi.Application, itemStr string) (strin
 		//todo 查看 DeletionTimestamp 如何生成
 		formatString(out, "Items", itemStr)
 		formatString(out, "Status", app.Status.Phase)
+		for _, condition := range app.Status.Conditions {
+			formatString(out, string(condition.Type), fmt.Sprintf("%s %s %s", condition.Status, condition.Reason, condition.Message))
+			formatString(out, "Last Transition", condition.LastTransitionTime.String())
+		}
 		formatString(out, "Event", "todo")
 		//todo 查看Event 如何输出
 		return nil
 	})
 }
 
+// ApplicationPromotionDescriber generates information about an ApplicationPromotion
+type ApplicationPromotionDescriber struct {
+	osClient client.Interface
+}
+
+// Describe returns the description of an applicationPromotion, and the progress of its items
+func (d *ApplicationPromotionDescriber) Describe(namespace, name string) (string, error) {
+	promotion, err := d.osClient.ApplicationPromotions(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, promotion.ObjectMeta)
+		formatString(out, "Application", promotion.Spec.Application)
+		formatString(out, "To Project", promotion.Spec.TargetNamespace)
+		if len(promotion.Spec.Plans) > 0 {
+			fmt.Fprintf(out, "Plans:\n")
+			for from, to := range promotion.Spec.Plans {
+				formatString(out, "  "+from, to)
+			}
+		}
+		formatString(out, "Status", promotion.Status.Phase)
+		if len(promotion.Status.Message) > 0 {
+			formatString(out, "Message", promotion.Status.Message)
+		}
+		if promotion.Status.StartTimestamp != nil {
+			formatString(out, "Started", promotion.Status.StartTimestamp.String())
+		}
+		if promotion.Status.CompletionTimestamp != nil {
+			formatString(out, "Completed", promotion.Status.CompletionTimestamp.String())
+		}
+		if len(promotion.Status.Items) > 0 {
+			fmt.Fprintf(out, "Items:\n")
+			fmt.Fprintf(out, "  KIND\tNAME\tSTATUS\tMESSAGE\n")
+			for _, item := range promotion.Status.Items {
+				fmt.Fprintf(out, "  %s\t%s\t%s\t%s\n", item.Kind, item.Name, item.Phase, item.Message)
+			}
+		}
+		return nil
+	})
+}
+
 // BackingServiceInstanceDescriber generates information about a Image
 type BackingServiceInstanceDescriber struct {
 	osClient   client.Interface
@@ -320,15 +384,21 @@ func (d *BackingServiceInstanceDescriber) Describe(namespace, name string) (stri
 		return "", err
 	}
 
+	selector := labels.SelectorFromSet(labels.Set{backingserviceinstanceapi.BackingServiceInstanceLabel: bsi.Name})
+	bindings, err := d.osClient.BackingServiceBindings(namespace).List(selector, fields.Everything())
+	if err != nil {
+		return "", err
+	}
+
 	events, err := d.kubeClient.Events(namespace).Search(bsi)
 	if events == nil {
 		events = &kapi.EventList{}
 	}
 
-	return describeBackingServiceInstance(bsi, events)
+	return describeBackingServiceInstance(bsi, bindings, events)
 }
 
-func describeBackingServiceInstance(bsi *backingserviceinstanceapi.BackingServiceInstance, events *kapi.EventList) (string, error) {
+func describeBackingServiceInstance(bsi *backingserviceinstanceapi.BackingServiceInstance, bindings *backingserviceinstanceapi.BackingServiceBindingList, events *kapi.EventList) (string, error) {
 	return tabbedString(func(out *tabwriter.Writer) error {
 		formatMeta(out, bsi.ObjectMeta)
 		formatString(out, "Status", bsi.Status.Phase)
@@ -341,39 +411,85 @@ func describeBackingServiceInstance(bsi *backingserviceinstanceapi.BackingServic
 			formatString(out, k, v)
 		}
 		formatString(out, "Bound", bsi.Spec.Bound)
-		if bsi.Spec.Bound > 0 {
-			for _, bind := range bsi.Spec.Binding {
-				fmt.Fprintln(out, "────────────────────")
-				formatString(out, "BindUuid", bind.BindUuid)
-				formatString(out, "BindDeploymentConfig", bind.BindDeploymentConfig)
-				formatString(out, "Credentials", " ")
-
-				var keys []string
-				for k := range bind.Credentials {
-					keys = append(keys, k)
-				}
-				sort.Strings(keys)
+		if bsi.Status.Deletion != nil {
+			formatString(out, "Deletion", bsi.Status.Deletion.Message)
+		}
+		for _, binding := range bindings.Items {
+			fmt.Fprintln(out, "────────────────────")
+			formatString(out, "Binding", binding.Name)
+			formatString(out, "BindKind", binding.Spec.BindKind)
+			formatString(out, "ResourceName", binding.Spec.ResourceName)
+			formatString(out, "Status", binding.Status.Phase)
+		}
 
-				for _, key := range keys {
-					formatString(out, key, bind.Credentials[key])
-				}
+		kctl.DescribeEvents(events, out)
+		return nil
+	})
+}
 
-				/*
-					formatString(out, "Uri", bind.Credentials["Uri"])
-					formatString(out, "Host", bind.Credentials["Host"])
-					formatString(out, "Port", bind.Credentials["Port"])
-					formatString(out, "Name", bind.Credentials["Name"])
-					formatString(out, "Vhost", bind.Credentials["Vhost"])
-					formatString(out, "Username", bind.Credentials["Username"])
-					formatString(out, "Password", bind.Credentials["Password"])
-				*/
-				/*
-					for k, v := range bind.Credentials {
-						formatString(out, k, v)
-					}
-				*/
-			}
+// BackingServiceBindingDescriber generates information about a BackingServiceBinding
+type BackingServiceBindingDescriber struct {
+	osClient   client.Interface
+	kubeClient kclient.Interface
+}
+
+// Describe returns the description of a backingServiceBinding
+func (d *BackingServiceBindingDescriber) Describe(namespace, name string) (string, error) {
+	binding, err := d.osClient.BackingServiceBindings(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
 
+	events, err := d.kubeClient.Events(namespace).Search(binding)
+	if events == nil {
+		events = &kapi.EventList{}
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, binding.ObjectMeta)
+		formatString(out, "Status", binding.Status.Phase)
+		formatString(out, "BackingServiceInstance", binding.Spec.BackingServiceInstanceName)
+		formatString(out, "BindKind", binding.Spec.BindKind)
+		formatString(out, "ResourceName", binding.Spec.ResourceName)
+		if len(binding.Spec.Injection) > 0 {
+			formatString(out, "Injection", binding.Spec.Injection)
+		}
+		if len(binding.Spec.MountPath) > 0 {
+			formatString(out, "MountPath", binding.Spec.MountPath)
+		}
+		if len(binding.Spec.Parameters) > 0 {
+			fmt.Fprintf(out, "Parameters:\n")
+			for k, v := range binding.Spec.Parameters {
+				formatString(out, "  "+k, v)
+			}
+		}
+		if len(binding.Status.BindUuid) > 0 {
+			formatString(out, "BindUuid", binding.Status.BindUuid)
+		}
+		if binding.Status.BoundTime != nil {
+			formatString(out, "BoundTime", binding.Status.BoundTime.String())
+		}
+		if len(binding.Status.CredentialsSecret) > 0 {
+			formatString(out, "CredentialsSecret", binding.Status.CredentialsSecret)
+		}
+		if len(binding.Status.RouteServiceURL) > 0 {
+			formatString(out, "RouteServiceURL", binding.Status.RouteServiceURL)
+		}
+		if binding.Spec.RotationPeriod != nil {
+			formatString(out, "RotationPeriod", binding.Spec.RotationPeriod.Duration.String())
+		}
+		if binding.Status.RotatedTime != nil {
+			formatString(out, "RotatedTime", binding.Status.RotatedTime.String())
+		}
+		if rotation := binding.Status.Rotation; rotation != nil {
+			if len(rotation.RetiredBindUuid) > 0 {
+				formatString(out, "Rotation", fmt.Sprintf("started %s, revoking %s once deployed", rotation.StartTime.String(), rotation.RetiredBindUuid))
+			} else {
+				formatString(out, "Rotation", fmt.Sprintf("started %s, binding %s", rotation.StartTime.String(), rotation.BindUuid))
+			}
+		}
+		for _, condition := range binding.Status.Conditions {
+			formatString(out, string(condition.Type), fmt.Sprintf("%s %s %s", condition.Status, condition.Reason, condition.Message))
 		}
 
 		kctl.DescribeEvents(events, out)
@@ -381,6 +497,40 @@ func describeBackingServiceInstance(bsi *backingserviceinstanceapi.BackingServic
 	})
 }
 
+// BackingServiceUsageDescriber generates information about a BackingServiceUsage
+type BackingServiceUsageDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a BackingServiceUsage
+func (d *BackingServiceUsageDescriber) Describe(namespace, name string) (string, error) {
+	usage, err := d.BackingServiceUsages(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, usage.ObjectMeta)
+		formatString(out, "BackingServiceInstance", usage.BackingServiceInstanceName)
+		formatString(out, "BackingServiceName", usage.BackingServiceName)
+		formatString(out, "BackingServicePlanName", usage.BackingServicePlanName)
+		formatString(out, "BackingServicePlanGuid", usage.BackingServicePlanGuid)
+		formatString(out, "Free", usage.Free)
+		for _, cost := range usage.Costs {
+			for currency, amount := range cost.Amount {
+				formatString(out, "Cost", fmt.Sprintf("%v %s %s", amount, currency, cost.Unit))
+			}
+		}
+		formatString(out, "Start", usage.Start.String())
+		if usage.End != nil {
+			formatString(out, "End", usage.End.String())
+		} else {
+			formatString(out, "End", "<none>")
+		}
+		return nil
+	})
+}
+
 // BuildDescriber generates information about a build
 type BuildDescriber struct {
 	osClient   client.Interface
@@ -13,6 +13,7 @@ import (
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	applicationapi "github.com/openshift/origin/pkg/application/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/client"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -222,6 +223,18 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 	}
 }
 
-func printItem(kind, name, ceateTime string) string {
-	return fmt.Sprintf("\t%s\t%s\t%s\t\n", kind, name, ceateTime)
+func printItem(kind, name, ceateTime, health string) string {
+	return fmt.Sprintf("\t%s\t%s\t%s\t%s\t\n", kind, name, ceateTime, health)
+}
+
+// formatItemHealth returns the health of an item of an application with its reason and message.
+func formatItemHealth(status applicationapi.ApplicationItemStatus) string {
+	health := string(status.Health)
+	if len(status.Reason) > 0 {
+		health += " (" + status.Reason + ")"
+	}
+	if len(status.Message) > 0 {
+		health += " " + status.Message
+	}
+	return health
 }
@@ -32,9 +32,12 @@ import (
 
 var (
 	applicationColumns            = []string{"NAME", "NAMESPACE", "LABELS", "CREATE TIME", "STATUS"}
+	applicationPromotionColumns   = []string{"NAME", "APPLICATION", "TO PROJECT", "STATUS", "AGE"}
 	serviceBrokerColumns          = []string{"NAME", "LABELS", "CREATE TIME", "URL", "STATUS"}
 	backingServiceColumns         = []string{"NAME", "LABELS", "BINDABLE", "STATUS"}
 	backingServiceInstanceColumns = []string{"NAME", "SERVICE", "PLAN", "BOUND", "STATUS"}
+	backingServiceBindingColumns  = []string{"NAME", "INSTANCE", "KIND", "RESOURCE", "STATUS"}
+	backingServiceUsageColumns    = []string{"NAME", "INSTANCE", "SERVICE", "PLAN", "START", "END"}
 	buildColumns                  = []string{"NAME", "TYPE", "FROM", "STATUS", "STARTED", "DURATION"}
 	buildConfigColumns            = []string{"NAME", "TYPE", "FROM", "LATEST"}
 	imageColumns                  = []string{"NAME", "DOCKER REF"}
@@ -75,12 +78,20 @@ func NewHumanReadablePrinter(noHeaders, withNamespace, wide bool, showAll bool,
 	p := kctl.NewHumanReadablePrinter(noHeaders, withNamespace, wide, showAll, columnLabels)
 	p.Handler(applicationColumns, printApplication)
 	p.Handler(applicationColumns, printApplicationList)
+	p.Handler(applicationPromotionColumns, printApplicationPromotion)
+	p.Handler(applicationPromotionColumns, printApplicationPromotionList)
 	p.Handler(serviceBrokerColumns, printServiceBroker)
 	p.Handler(serviceBrokerColumns, printServiceBrokerList)
+	p.Handler(serviceBrokerColumns, printProjectServiceBroker)
+	p.Handler(serviceBrokerColumns, printProjectServiceBrokerList)
 	p.Handler(backingServiceColumns, printBackingService)
 	p.Handler(backingServiceColumns, printBackingServiceList)
 	p.Handler(backingServiceInstanceColumns, printBackingServiceInstance)
 	p.Handler(backingServiceInstanceColumns, printBackingServiceInstanceList)
+	p.Handler(backingServiceBindingColumns, printBackingServiceBinding)
+	p.Handler(backingServiceBindingColumns, printBackingServiceBindingList)
+	p.Handler(backingServiceUsageColumns, printBackingServiceUsage)
+	p.Handler(backingServiceUsageColumns, printBackingServiceUsageList)
 	p.Handler(buildColumns, printBuild)
 	p.Handler(buildColumns, printBuildList)
 	p.Handler(buildConfigColumns, printBuildConfig)
@@ -179,6 +190,21 @@ func printApplicationList(applications *applicationapi.ApplicationList, w io.Wri
 	return nil
 }
 
+func printApplicationPromotion(promotion *applicationapi.ApplicationPromotion, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", promotion.Name, promotion.Spec.Application, promotion.Spec.TargetNamespace,
+		promotion.Status.Phase, formatRelativeTime(promotion.CreationTimestamp.Time))
+	return err
+}
+
+func printApplicationPromotionList(promotionList *applicationapi.ApplicationPromotionList, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	for _, promotion := range promotionList.Items {
+		if err := printApplicationPromotion(&promotion, w, withNamespace, wide, showAll, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printBackingService(bs *backingserviceapi.BackingService, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
 	/*
 		var labels []string
@@ -226,6 +252,40 @@ func printBackingServiceInstanceList(bsiList *backingserviceinstanceapi.BackingS
 	return nil
 }
 
+func printBackingServiceBinding(binding *backingserviceinstanceapi.BackingServiceBinding, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", binding.Name, binding.Spec.BackingServiceInstanceName,
+		binding.Spec.BindKind, binding.Spec.ResourceName, binding.Status.Phase)
+	return err
+}
+
+func printBackingServiceBindingList(bindingList *backingserviceinstanceapi.BackingServiceBindingList, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	for _, binding := range bindingList.Items {
+		if err := printBackingServiceBinding(&binding, w, withNamespace, wide, showAll, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printBackingServiceUsage(usage *backingserviceinstanceapi.BackingServiceUsage, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	end := "<none>"
+	if usage.End != nil {
+		end = usage.End.Format(time.RFC3339)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", usage.Name, usage.BackingServiceInstanceName,
+		usage.BackingServiceName, usage.BackingServicePlanName, usage.Start.Format(time.RFC3339), end)
+	return err
+}
+
+func printBackingServiceUsageList(usageList *backingserviceinstanceapi.BackingServiceUsageList, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	for _, usage := range usageList.Items {
+		if err := printBackingServiceUsage(&usage, w, withNamespace, wide, showAll, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PrintTemplateParameters the Template parameters with their default values
 func PrintTemplateParameters(params []templateapi.Parameter, output io.Writer) error {
 	w := tabwriter.NewWriter(output, 20, 5, 3, ' ', 0)
@@ -546,6 +606,19 @@ func printServiceBrokerList(serviceBrokers *servicebrokerapi.ServiceBrokerList,
 	return nil
 }
 
+func printProjectServiceBroker(psb *servicebrokerapi.ProjectServiceBroker, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	return printServiceBroker(servicebrokerapi.ServiceBrokerOf(psb), w, withNamespace, wide, showAll, columnLabels)
+}
+
+func printProjectServiceBrokerList(list *servicebrokerapi.ProjectServiceBrokerList, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
+	for i := range list.Items {
+		if err := printProjectServiceBroker(&list.Items[i], w, withNamespace, wide, showAll, columnLabels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printRoute(route *routeapi.Route, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
 	tlsTerm := ""
 	insecurePolicy := ""
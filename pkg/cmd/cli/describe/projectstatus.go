@@ -22,6 +22,7 @@ import (
 	kubeedges "github.com/openshift/origin/pkg/api/kubegraph"
 	kubeanalysis "github.com/openshift/origin/pkg/api/kubegraph/analysis"
 	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildedges "github.com/openshift/origin/pkg/build/graph"
 	buildanalysis "github.com/openshift/origin/pkg/build/graph/analysis"
@@ -127,6 +128,15 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 		return "", err
 	}
 
+	applications := &applicationapi.ApplicationList{}
+	if list, err := d.C.Applications(namespace).List(labels.Everything(), fields.Everything()); err == nil {
+		applications = list
+	} else if !kapierrors.IsForbidden(err) {
+		if err := errors.TolerateNotFoundError(err); err != nil {
+			return "", err
+		}
+	}
+
 	coveredNodes := graphview.IntSet{}
 
 	services, coveredByServices := graphview.AllServiceGroups(g, coveredNodes)
@@ -200,6 +210,11 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 			printLines(out, indent, 0, describeRCInServiceGroup(standaloneRC.RC)...)
 		}
 
+		for i := range applications.Items {
+			fmt.Fprintln(out)
+			printLines(out, indent, 0, describeApplicationHealth(&applications.Items[i])...)
+		}
+
 		allMarkers := osgraph.Markers{}
 		allMarkers = append(allMarkers, createForbiddenMarkers(forbiddenResources)...)
 		for _, scanner := range getMarkerScanners() {
@@ -281,6 +296,42 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 	})
 }
 
+// describeApplicationHealth returns the rolled up health of app followed by the health
+// of each of its items.
+func describeApplicationHealth(app *applicationapi.Application) []string {
+	ready := 0
+	for _, item := range app.Status.Items {
+		if item.Health == applicationapi.ApplicationItemReady {
+			ready++
+		}
+	}
+
+	health := "unknown"
+	for _, condition := range app.Status.Conditions {
+		if condition.Status != kapi.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case applicationapi.ApplicationDegraded:
+			health = "degraded"
+		case applicationapi.ApplicationProgressing:
+			if health != "degraded" {
+				health = "progressing"
+			}
+		case applicationapi.ApplicationAvailable:
+			if health == "unknown" {
+				health = "available"
+			}
+		}
+	}
+
+	lines := []string{fmt.Sprintf("application/%s is %s (%d/%d items ready)", app.Name, health, ready, len(app.Status.Items))}
+	for _, item := range app.Status.Items {
+		lines = append(lines, fmt.Sprintf("%s/%s %s", strings.ToLower(item.Kind), item.Name, formatItemHealth(item)))
+	}
+	return lines
+}
+
 func createForbiddenMarkers(forbiddenResources sets.String) []osgraph.Marker {
 	markers := []osgraph.Marker{}
 	for forbiddenResource := range forbiddenResources {
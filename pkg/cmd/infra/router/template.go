@@ -1,10 +1,14 @@
 package router
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"os/exec"
 	"strconv"
 
+	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -48,6 +52,8 @@ type TemplateRouter struct {
 	ReloadScript       string
 	DefaultCertificate string
 	RouterService      *ktypes.NamespacedName
+	RouteServiceSecret string
+	RouteServices      bool
 }
 
 func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
@@ -55,6 +61,7 @@ func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.DefaultCertificate, "default-certificate", util.Env("DEFAULT_CERTIFICATE", ""), "A path to default certificate to use for routes that don't expose a TLS server cert; in PEM format")
 	flag.StringVar(&o.TemplateFile, "template", util.Env("TEMPLATE_FILE", ""), "The path to the template file to use")
 	flag.StringVar(&o.ReloadScript, "reload", util.Env("RELOAD_SCRIPT", ""), "The path to the reload script to use")
+	flag.StringVar(&o.RouteServiceSecret, "route-service-secret", util.Env("ROUTE_SERVICE_SECRET", ""), "The secret signing the requests route services forward back to their routes, shared by all the replicas of the router. Defaults to a random secret.")
 }
 
 type RouterStats struct {
@@ -114,6 +121,23 @@ func (o *TemplateRouterOptions) Complete() error {
 		}
 	}
 
+	if len(o.RouteServiceSecret) == 0 {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("unable to generate a route service secret: %v", err)
+		}
+		o.RouteServiceSecret = hex.EncodeToString(secret)
+		glog.Warningf("No route service secret was set, routes forwarded through route services only work if this router has a single replica")
+	}
+
+	// the route services of the template need a recent HAProxy, older ones would refuse
+	// the whole configuration.
+	version, err := exec.Command("haproxy", "-v").Output()
+	o.RouteServices = err == nil && templateplugin.SupportsRouteServices(string(version))
+	if !o.RouteServices {
+		glog.Warningf("HAProxy 2.6 or later was not found, routes forwarded through route services will not be served")
+	}
+
 	if len(o.StatsPortString) > 0 {
 		statsPort, err := strconv.Atoi(o.StatsPortString)
 		if err != nil {
@@ -147,6 +171,8 @@ func (o *TemplateRouterOptions) Run() error {
 		StatsPassword:      o.StatsPassword,
 		PeerService:        o.RouterService,
 		IncludeUDP:         o.RouterSelection.IncludeUDP,
+		RouteServiceSecret: o.RouteServiceSecret,
+		RouteServices:      o.RouteServices,
 	}
 
 	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg)
@@ -220,6 +220,8 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig
+	// ServiceBrokerConfig, if present serve the marketplace as a service broker under /v2
+	ServiceBrokerConfig *ServiceBrokerConfig
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig
@@ -405,6 +407,12 @@ type DNSConfig struct {
 	BindNetwork string
 }
 
+type ServiceBrokerConfig struct {
+	// Namespace is the project the instances requested through the broker are created in.
+	// Callers must be allowed to manage backing service instances in it.
+	Namespace string
+}
+
 type AssetConfig struct {
 	ServingInfo HTTPServingInfo
 
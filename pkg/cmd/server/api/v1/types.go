@@ -168,6 +168,8 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig `json:"assetConfig"`
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig `json:"dnsConfig"`
+	// ServiceBrokerConfig, if present serve the marketplace as a service broker under /v2
+	ServiceBrokerConfig *ServiceBrokerConfig `json:"serviceBrokerConfig"`
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig `json:"serviceAccountConfig"`
@@ -355,6 +357,12 @@ type DNSConfig struct {
 	BindNetwork string `json:"bindNetwork"`
 }
 
+type ServiceBrokerConfig struct {
+	// Namespace is the project the instances requested through the broker are created in.
+	// Callers must be allowed to manage backing service instances in it.
+	Namespace string `json:"namespace"`
+}
+
 type AssetConfig struct {
 	ServingInfo HTTPServingInfo `json:"servingInfo"`
 
@@ -295,6 +295,8 @@ serviceAccountConfig:
   masterCA: ""
   privateKeyFile: ""
   publicKeyFiles: null
+serviceBrokerConfig:
+  namespace: ""
 servingInfo:
   bindAddress: ""
   bindNetwork: ""
@@ -351,7 +353,8 @@ func TestMasterConfig(t *testing.T) {
 		AssetConfig: &internal.AssetConfig{
 			Extensions: []internal.AssetExtensionsConfig{{}},
 		},
-		DNSConfig: &internal.DNSConfig{},
+		DNSConfig:           &internal.DNSConfig{},
+		ServiceBrokerConfig: &internal.ServiceBrokerConfig{},
 	}
 	serializedConfig, err := writeYAML(config)
 	if err != nil {
@@ -101,6 +101,14 @@ func ValidateMasterConfig(config *api.MasterConfig) ValidationResults {
 		// only warn cause they could handle CORS headers themselves in a proxy
 	}
 
+	if config.ServiceBrokerConfig != nil {
+		if len(config.ServiceBrokerConfig.Namespace) == 0 {
+			validationResults.AddErrors(fielderrors.NewFieldRequired("serviceBrokerConfig.namespace"))
+		} else if ok, qualifier := kvalidation.ValidateNamespaceName(config.ServiceBrokerConfig.Namespace, false); !ok {
+			validationResults.AddErrors(fielderrors.NewFieldInvalid("serviceBrokerConfig.namespace", config.ServiceBrokerConfig.Namespace, qualifier))
+		}
+	}
+
 	if config.DNSConfig != nil {
 		validationResults.AddErrors(ValidateHostPort(config.DNSConfig.BindAddress, "bindAddress").Prefix("dnsConfig")...)
 		switch config.DNSConfig.BindNetwork {
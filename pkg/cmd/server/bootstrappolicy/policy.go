@@ -20,7 +20,7 @@ func GetBootstrapOpenshiftRoles(openshiftNamespace string) []authorizationapi.Ro
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("get", "list"),
-					Resources: sets.NewString("backingservices", "templates", authorizationapi.ImageGroupName),
+					Resources: sets.NewString("templates", authorizationapi.ImageGroupName),
 				},
 				{
 					// so anyone can pull from openshift/* image streams
@@ -113,6 +113,12 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("get", "list", "watch"),
 					Resources: sets.NewString(authorizationapi.PolicyOwnerGroupName, authorizationapi.KubeAllGroupName, authorizationapi.OpenshiftStatusGroupName, authorizationapi.KubeStatusGroupName),
 				},
+				{
+					// project admins report the costs of their own project. Reports are computed from the
+					// backingserviceusages they already read and are not stored, so only create is needed.
+					Verbs:     sets.NewString("create"),
+					Resources: sets.NewString("backingserviceusagereports"),
+				},
 				{
 					Verbs: sets.NewString("get", "update"),
 					// this is used by verifyImageStreamAccess in pkg/dockerregistry/server/auth.go
@@ -27,9 +27,13 @@ import (
 	"github.com/openshift/origin/pkg/api/v1beta3"
 
 	application "github.com/openshift/origin/pkg/application/registry/application/etcd"
+	applicationpromotionetcd "github.com/openshift/origin/pkg/application/registry/applicationpromotion/etcd"
 	backingservice "github.com/openshift/origin/pkg/backingservice/registry/backingservice/etcd"
+	backingservicebindingetcd "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingservicebinding/etcd"
 	backingserviceinstanceetcd "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceinstance/etcd"
 	backingserviceinstanceregistry "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceinstance"
+	backingserviceusageetcd "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceusage/etcd"
+	backingserviceusagereport "github.com/openshift/origin/pkg/backingserviceinstance/registry/backingserviceusagereport"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildgenerator "github.com/openshift/origin/pkg/build/generator"
 	buildregistry "github.com/openshift/origin/pkg/build/registry/build"
@@ -66,6 +70,8 @@ import (
 	hostsubnetetcd "github.com/openshift/origin/pkg/sdn/registry/hostsubnet/etcd"
 	netnamespaceetcd "github.com/openshift/origin/pkg/sdn/registry/netnamespace/etcd"
 	"github.com/openshift/origin/pkg/service"
+	servicebrokerfacade "github.com/openshift/origin/pkg/servicebroker/facade"
+	projectservicebroker "github.com/openshift/origin/pkg/servicebroker/registry/projectservicebroker/etcd"
 	servicebroker "github.com/openshift/origin/pkg/servicebroker/registry/servicebroker/etcd"
 	templateregistry "github.com/openshift/origin/pkg/template/registry"
 	templateetcd "github.com/openshift/origin/pkg/template/registry/etcd"
@@ -337,8 +343,9 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		glog.Fatalf("Unable to configure Kubelet client: %v", err)
 	}
 	applicationStorage := application.NewREST(c.EtcdHelper, c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient)
-	serviceBrokerStorage := servicebroker.NewREST(c.EtcdHelper)
-	backingServiceStorage := backingservice.NewREST(c.EtcdHelper)
+	serviceBrokerStorage := servicebroker.NewREST(c.EtcdHelper, c.PrivilegedLoopbackKubernetesClient)
+	projectServiceBrokerStorage := projectservicebroker.NewREST(c.EtcdHelper, c.PrivilegedLoopbackKubernetesClient)
+	backingServiceStorage := backingservice.NewREST(c.EtcdHelper, serviceBrokerStorage, c.PrivilegedLoopbackKubernetesClient.Namespaces())
 	
 	buildStorage, buildDetailsStorage := buildetcd.NewStorage(c.EtcdHelper)
 	buildRegistry := buildregistry.NewRegistry(buildStorage)
@@ -351,7 +358,6 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 	routeAllocator := c.RouteAllocator()
 
-	routeEtcd := routeetcd.NewREST(c.EtcdHelper, routeAllocator)
 	hostSubnetStorage := hostsubnetetcd.NewREST(c.EtcdHelper)
 	netNamespaceStorage := netnamespaceetcd.NewREST(c.EtcdHelper)
 	clusterNetworkStorage := clusternetworketcd.NewREST(c.EtcdHelper)
@@ -379,11 +385,14 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 	subjectAccessReviewStorage := subjectaccessreview.NewREST(c.Authorizer)
 	subjectAccessReviewRegistry := subjectaccessreview.NewRegistry(subjectAccessReviewStorage)
+	routeEtcd := routeetcd.NewREST(c.EtcdHelper, routeAllocator, subjectAccessReviewRegistry)
 	localSubjectAccessReviewStorage := localsubjectaccessreview.NewREST(subjectAccessReviewRegistry)
 	resourceAccessReviewStorage := resourceaccessreview.NewREST(c.Authorizer)
 	resourceAccessReviewRegistry := resourceaccessreview.NewRegistry(resourceAccessReviewStorage)
 	localResourceAccessReviewStorage := localresourceaccessreview.NewREST(resourceAccessReviewRegistry)
 
+	applicationPromotionStorage := applicationpromotionetcd.NewREST(c.EtcdHelper, applicationStorage, subjectAccessReviewRegistry)
+
 	imageStorage := imageetcd.NewREST(c.EtcdHelper)
 	imageRegistry := image.NewRegistry(imageStorage)
 	imageStreamStorage, imageStreamStatusStorage, internalImageStreamStorage := imagestreametcd.NewREST(c.EtcdHelper, imagestream.DefaultRegistryFunc(defaultRegistryFunc), subjectAccessReviewRegistry)
@@ -394,9 +403,12 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	imageStreamImageStorage := imagestreamimage.NewREST(imageRegistry, imageStreamRegistry)
 	imageStreamImageRegistry := imagestreamimage.NewRegistry(imageStreamImageStorage)
 	
-	backingServiceInstanceEtcd := backingserviceinstanceetcd.NewREST(c.EtcdHelper)
+	backingServiceInstanceEtcd := backingserviceinstanceetcd.NewREST(c.EtcdHelper, backingServiceStorage)
 	backingServiceInstanceRegistry := backingserviceinstanceregistry.NewRegistry(backingServiceInstanceEtcd)
-	backingServiceInstanceBindingEtcd := backingserviceinstanceetcd.NewBindingREST(backingServiceInstanceRegistry, deployConfigRegistry)
+	backingServiceBindingEtcd := backingservicebindingetcd.NewREST(c.EtcdHelper, backingServiceInstanceEtcd, backingServiceStorage)
+	backingServiceInstanceBindingEtcd := backingserviceinstanceetcd.NewBindingREST(backingServiceInstanceRegistry, deployConfigRegistry, backingServiceBindingEtcd)
+	backingServiceUsageEtcd := backingserviceusageetcd.NewREST(c.EtcdHelper)
+	backingServiceUsageReportStorage := backingserviceusagereport.NewREST(backingServiceUsageEtcd)
 
 	buildGenerator := &buildgenerator.BuildGenerator{
 		Client: buildgenerator.Client{
@@ -449,11 +461,16 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 	storage := map[string]rest.Storage{
 		"applications":            applicationStorage,
+		"applicationPromotions":   applicationPromotionStorage,
 		"serviceBrokers":          serviceBrokerStorage,
+		"projectServiceBrokers":   projectServiceBrokerStorage,
 		"backingServices":         backingServiceStorage,
 		
 		"backingServiceInstances"        : backingServiceInstanceEtcd,
 		"backingServiceInstances/binding": backingServiceInstanceBindingEtcd,
+		"backingServiceBindings":          backingServiceBindingEtcd,
+		"backingServiceUsages":            backingServiceUsageEtcd,
+		"backingServiceUsageReports":      backingServiceUsageReportStorage,
 		
 		"images":                  imageStorage,
 		"imageStreams":            imageStreamStorage,
@@ -522,7 +539,19 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 }
 
 func (c *MasterConfig) InstallUnprotectedAPI(container *restful.Container) []string {
-	return []string{}
+	messages := []string{}
+
+	// the service broker authenticates and authorizes its callers itself, they send
+	// tokens the way brokers are called.
+	if c.Options.ServiceBrokerConfig != nil {
+		namespace := c.Options.ServiceBrokerConfig.Namespace
+		broker := servicebrokerfacade.NewBroker(c.PrivilegedLoopbackOpenShiftClient, namespace, c.serviceBrokerClientFunc())
+		handler := servicebrokerfacade.NewHandler(broker, namespace, c.Authenticator, c.Authorizer)
+		container.Handle(servicebrokerfacade.Prefix+"/", handler)
+		messages = append(messages, fmt.Sprintf("Started service broker at %%s%s for project %s", servicebrokerfacade.Prefix, namespace))
+	}
+
+	return messages
 }
 
 // initAPIVersionRoute initializes the osapi endpoint to behave similar to the upstream api endpoint
@@ -162,7 +162,7 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 	kubeletClientConfig := configapi.GetKubeletClientConfig(options)
 
 	// in-order list of plug-ins that should intercept admission decisions (origin only intercepts)
-	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy"}
+	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy", "BackingServiceInstanceQuota"}
 
 	admissionClient := admissionControlClient(privilegedLoopbackKubeClient, privilegedLoopbackOpenShiftClient)
 	admissionController := admission.NewFromPlugins(admissionClient, admissionControlPluginNames, "")
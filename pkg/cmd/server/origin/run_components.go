@@ -9,9 +9,13 @@ import (
 	"github.com/golang/glog"
 
 	applicatioincontroller "github.com/openshift/origin/pkg/application/controller"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	backingservicecontroller "github.com/openshift/origin/pkg/backingservice/controller"
 	backingserviceinstancecontroller "github.com/openshift/origin/pkg/backingserviceinstance/controller"
+	"github.com/openshift/origin/pkg/backingserviceinstance/metering"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
 	servicebrokercontroller "github.com/openshift/origin/pkg/servicebroker/controller"
+	"github.com/openshift/origin/pkg/servicebroker/templatebroker"
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/controller/serviceaccount"
@@ -52,6 +56,18 @@ func (c *MasterConfig) RunApplicationController() {
 	factory := applicatioincontroller.ApplicationControllerFactory{
 		Client:     osclient,
 		KubeClient: kclient,
+		Items:      applicationutil.NewItemClient(osclient, kclient),
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunApplicationPromotionController starts the controller promoting Applications to other projects
+func (c *MasterConfig) RunApplicationPromotionController() {
+	osclient, kclient := c.OriginNamespaceControllerClients()
+	factory := applicatioincontroller.ApplicationPromotionControllerFactory{
+		Client: osclient,
+		Items:  applicationutil.NewItemClient(osclient, kclient),
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -61,13 +77,41 @@ func (c *MasterConfig) RunApplicationController() {
 func (c *MasterConfig) RunServiceBrokerController() {
 	osclient, kclient := c.OriginNamespaceControllerClients()
 	factory := servicebrokercontroller.ServiceBrokerControllerFactory{
-		Client:     osclient,
-		KubeClient: kclient,
+		Client:                  osclient,
+		KubeClient:              kclient,
+		ServiceBrokerClientFunc: c.serviceBrokerClientFunc(),
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunProjectServiceBrokerController starts the controller syncing the catalogs of project brokers
+func (c *MasterConfig) RunProjectServiceBrokerController() {
+	osclient, kclient := c.OriginNamespaceControllerClients()
+	factory := servicebrokercontroller.ProjectServiceBrokerControllerFactory{
+		ServiceBrokerControllerFactory: servicebrokercontroller.ServiceBrokerControllerFactory{
+			Client:     osclient,
+			KubeClient: kclient,
+		},
 	}
 	controller := factory.Create()
 	controller.Run()
 }
 
+// RunTemplateServiceBroker registers the template service broker, which is served in process.
+func (c *MasterConfig) RunTemplateServiceBroker() {
+	osclient, _ := c.OriginNamespaceControllerClients()
+	templatebroker.Register(osclient)
+}
+
+// serviceBrokerClientFunc returns the clients of the service brokers, the template
+// service broker is served in process.
+func (c *MasterConfig) serviceBrokerClientFunc() servicebrokerclient.ClientFunc {
+	osclient, kclient := c.OriginNamespaceControllerClients()
+	broker := templatebroker.NewBroker(osclient, kclient, templatebroker.DefaultNamespace)
+	return templatebroker.ClientFunc(broker, servicebrokerclient.NewClientFunc(kclient))
+}
+
 // RunBackingServiceController starts the project authorization cache
 func (c *MasterConfig) RunBackingServiceController() {
 	osclient, kclient := c.OriginNamespaceControllerClients()
@@ -83,13 +127,33 @@ func (c *MasterConfig) RunBackingServiceController() {
 func (c *MasterConfig) RunBackingServiceInstanceController() {
 	osclient, kclient := c.OriginNamespaceControllerClients()
 	factory := backingserviceinstancecontroller.BackingServiceInstanceControllerFactory{
-		Client:     osclient,
-		KubeClient: kclient,
+		Client:                  osclient,
+		KubeClient:              kclient,
+		ServiceBrokerClientFunc: c.serviceBrokerClientFunc(),
 	}
 	controller := factory.Create()
 	controller.Run()
 }
 
+// RunBackingServiceBindingController starts the controller binding and unbinding BackingServiceBindings
+func (c *MasterConfig) RunBackingServiceBindingController() {
+	osclient, kclient := c.OriginNamespaceControllerClients()
+	factory := backingserviceinstancecontroller.BackingServiceBindingControllerFactory{
+		Client:                  osclient,
+		KubeClient:              kclient,
+		ServiceBrokerClientFunc: c.serviceBrokerClientFunc(),
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunBackingServiceMeter starts recording the usage of the plans of backing service instances
+func (c *MasterConfig) RunBackingServiceMeter() {
+	osclient, _ := c.OriginNamespaceControllerClients()
+	meter := &metering.Meter{Client: osclient}
+	meter.Run(metering.DefaultPeriod)
+}
+
 // RunProjectAuthorizationCache starts the project authorization cache
 func (c *MasterConfig) RunProjectAuthorizationCache() {
 	// TODO: look at exposing a configuration option in future to control how often we run this loop
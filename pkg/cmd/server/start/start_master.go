@@ -571,9 +571,14 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	}
 
 	oc.RunApplicationController()
+	oc.RunApplicationPromotionController()
 	oc.RunServiceBrokerController()
+	oc.RunTemplateServiceBroker()
+	oc.RunProjectServiceBrokerController()
 	oc.RunBackingServiceController()
 	oc.RunBackingServiceInstanceController()
+	oc.RunBackingServiceBindingController()
+	oc.RunBackingServiceMeter()
 	oc.RunDeploymentController()
 	oc.RunDeployerPodController()
 	oc.RunDeploymentConfigController()
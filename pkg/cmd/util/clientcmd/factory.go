@@ -453,7 +453,10 @@ func expandResourceShortcut(resource string) string {
 		"pvc":     "persistentVolumeClaims",
 		"bs":      "backingservices",
 		"sb":      "servicebrokers",
+		"psb":     "projectservicebrokers",
 		"bsi":     "backingserviceinstances",
+		"bsb":     "backingservicebindings",
+		"bsu":     "backingserviceusages",
 	}
 	if expanded, ok := shortForms[resource]; ok {
 		return expanded
@@ -96,6 +96,12 @@ type TLSConfig struct {
 	InsecureEdgeTerminationPolicy InsecureEdgeTerminationPolicyType
 }
 
+// RouteServiceURLAnnotation is set on a Route bound to a backing service instance whose
+// broker offers a route service: routers forward the requests of the route through
+// the service at this URL before they reach the endpoints. Only users allowed to
+// update routes/routeservice, the binding controller, may set it.
+const RouteServiceURLAnnotation = "asiainfo.io/route-service-url"
+
 // TLSTerminationType dictates where the secure communication will stop
 // TODO: Reconsider this type in v2
 type TLSTerminationType string
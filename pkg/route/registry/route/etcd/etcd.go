@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
 
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
 	"github.com/openshift/origin/pkg/route"
 	"github.com/openshift/origin/pkg/route/api"
 	rest "github.com/openshift/origin/pkg/route/registry/route"
@@ -24,8 +25,8 @@ type REST struct {
 }
 
 // NewREST returns a RESTStorage object that will work against routes.
-func NewREST(s storage.Interface, allocator route.RouteAllocator) RouteStorage {
-	strategy := rest.NewStrategy(allocator)
+func NewREST(s storage.Interface, allocator route.RouteAllocator, subjectAccessReviewClient subjectaccessreview.Registry) RouteStorage {
+	strategy := rest.NewStrategy(allocator, subjectAccessReviewClient)
 	prefix := "/routes"
 	store := &etcdgeneric.Etcd{
 		NewFunc:     func() runtime.Object { return &api.Route{} },
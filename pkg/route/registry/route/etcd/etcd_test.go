@@ -31,7 +31,7 @@ import (
 
 func newStorage(t *testing.T, allocator *testAllocator) (*REST, *tools.FakeEtcdClient) {
 	etcdStorage, fakeClient := registrytest.NewEtcdStorage(t, "")
-	return NewREST(etcdStorage, allocator).Route, fakeClient
+	return NewREST(etcdStorage, allocator, nil).Route, fakeClient
 }
 
 func validNewRoute(name string) *api.Route {
@@ -11,7 +11,10 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
+	"k8s.io/kubernetes/pkg/util/sets"
 
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
 	"github.com/openshift/origin/pkg/route"
 	"github.com/openshift/origin/pkg/route/api"
 	"github.com/openshift/origin/pkg/route/api/validation"
@@ -24,15 +27,18 @@ type routeStrategy struct {
 	runtime.ObjectTyper
 	kapi.NameGenerator
 	route.RouteAllocator
+	subjectAccessReviewClient subjectaccessreview.Registry
 }
 
 // NewStrategy initializes the default logic that applies when creating and updating
-// Route objects via the REST API.
-func NewStrategy(allocator route.RouteAllocator) routeStrategy {
+// Route objects via the REST API. subjectAccessReviewClient checks who may point a
+// route to a route service.
+func NewStrategy(allocator route.RouteAllocator, subjectAccessReviewClient subjectaccessreview.Registry) routeStrategy {
 	return routeStrategy{
 		kapi.Scheme,
 		kapi.SimpleNameGenerator,
 		allocator,
+		subjectAccessReviewClient,
 	}
 }
 
@@ -65,19 +71,52 @@ func (routeStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	route.Status = oldRoute.Status
 }
 
-func (routeStrategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+func (s routeStrategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
 	route := obj.(*api.Route)
-	return validation.ValidateRoute(route)
+	errs := s.validateRouteService(ctx, route, "")
+	return append(errs, validation.ValidateRoute(route)...)
 }
 
 func (routeStrategy) AllowCreateOnUpdate() bool {
 	return false
 }
 
-func (routeStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+func (s routeStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
 	oldRoute := old.(*api.Route)
 	objRoute := obj.(*api.Route)
-	return validation.ValidateRouteUpdate(objRoute, oldRoute)
+	errs := s.validateRouteService(ctx, objRoute, oldRoute.Annotations[api.RouteServiceURLAnnotation])
+	return append(errs, validation.ValidateRouteUpdate(objRoute, oldRoute)...)
+}
+
+// validateRouteService forbids users who may not update routes/routeservice to point
+// route to another route service than old. Routers sign the requests they forward to
+// route services, so only the binding controller may choose them. Removing the route
+// service is allowed.
+func (s routeStrategy) validateRouteService(ctx kapi.Context, route *api.Route, old string) fielderrors.ValidationErrorList {
+	value := route.Annotations[api.RouteServiceURLAnnotation]
+	if len(value) == 0 || value == old {
+		return nil
+	}
+	field := "metadata.annotations[" + api.RouteServiceURLAnnotation + "]"
+
+	user, ok := kapi.UserFrom(ctx)
+	if !ok || s.subjectAccessReviewClient == nil {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldForbidden(field, value)}
+	}
+	subjectAccessReview := &authorizationapi.SubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:         "update",
+			Resource:     "routes/routeservice",
+			ResourceName: route.Name,
+		},
+		User:   user.GetName(),
+		Groups: sets.NewString(user.GetGroups()...),
+	}
+	resp, err := s.subjectAccessReviewClient.CreateSubjectAccessReview(kapi.WithNamespace(kapi.NewContext(), route.Namespace), subjectAccessReview)
+	if err != nil || resp == nil || !resp.Allowed {
+		return fielderrors.ValidationErrorList{fielderrors.NewFieldForbidden(field, value)}
+	}
+	return nil
 }
 
 func (routeStrategy) AllowUnconditionalUpdate() bool {
@@ -88,12 +127,21 @@ type routeStatusStrategy struct {
 	routeStrategy
 }
 
-var StatusStrategy = routeStatusStrategy{NewStrategy(nil)}
+var StatusStrategy = routeStatusStrategy{NewStrategy(nil, nil)}
 
 func (routeStatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newRoute := obj.(*api.Route)
 	oldRoute := old.(*api.Route)
 	newRoute.Spec = oldRoute.Spec
+	// the route service is only changed through the route itself, see validateRouteService.
+	if value, ok := oldRoute.Annotations[api.RouteServiceURLAnnotation]; ok {
+		if newRoute.Annotations == nil {
+			newRoute.Annotations = map[string]string{}
+		}
+		newRoute.Annotations[api.RouteServiceURLAnnotation] = value
+	} else {
+		delete(newRoute.Annotations, api.RouteServiceURLAnnotation)
+	}
 }
 
 func (routeStatusStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
@@ -0,0 +1,83 @@
+package route
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+	"github.com/openshift/origin/pkg/route/api"
+)
+
+// fakeSubjectAccessReviewRegistry allows the users in allowed to update routes/routeservice.
+type fakeSubjectAccessReviewRegistry struct {
+	allowed map[string]bool
+}
+
+var _ subjectaccessreview.Registry = &fakeSubjectAccessReviewRegistry{}
+
+func (f *fakeSubjectAccessReviewRegistry) CreateSubjectAccessReview(ctx kapi.Context, subjectAccessReview *authorizationapi.SubjectAccessReview) (*authorizationapi.SubjectAccessReviewResponse, error) {
+	allowed := subjectAccessReview.Action.Verb == "update" && subjectAccessReview.Action.Resource == "routes/routeservice" && f.allowed[subjectAccessReview.User]
+	return &authorizationapi.SubjectAccessReviewResponse{Allowed: allowed}, nil
+}
+
+func TestRouteServiceAnnotation(t *testing.T) {
+	strategy := NewStrategy(nil, &fakeSubjectAccessReviewRegistry{allowed: map[string]bool{"system:openshift-master": true}})
+	route := func(url string) *api.Route {
+		r := &api.Route{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "dev", Name: "web", ResourceVersion: "1"},
+			Spec:       api.RouteSpec{Host: "web.example.com", To: kapi.ObjectReference{Name: "web"}},
+		}
+		if len(url) > 0 {
+			r.Annotations = map[string]string{api.RouteServiceURLAnnotation: url}
+		}
+		return r
+	}
+
+	tests := []struct {
+		name string
+		user string
+		old  string
+		url  string
+		errs int
+	}{
+		{name: "tenant without route service", user: "alice"},
+		{name: "tenant sets a route service", user: "alice", url: "https://attacker.example.com", errs: 1},
+		{name: "tenant changes the route service", user: "alice", old: "https://rs.example.com", url: "https://attacker.example.com", errs: 1},
+		{name: "tenant keeps the route service", user: "alice", old: "https://rs.example.com", url: "https://rs.example.com"},
+		{name: "tenant removes the route service", user: "alice", old: "https://rs.example.com"},
+		{name: "controller sets a route service", user: "system:openshift-master", url: "https://rs.example.com"},
+	}
+	for _, test := range tests {
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "dev"), &user.DefaultInfo{Name: test.user})
+
+		var errs []error
+		if len(test.old) == 0 {
+			for _, err := range strategy.Validate(ctx, route(test.url)) {
+				errs = append(errs, err)
+			}
+		}
+		for _, err := range strategy.ValidateUpdate(ctx, route(test.url), route(test.old)) {
+			errs = append(errs, err)
+		}
+		expected := test.errs
+		if len(test.old) == 0 {
+			// both the create and the update are checked.
+			expected *= 2
+		}
+		if len(errs) != expected {
+			t.Errorf("%s: expected %d errors, got %v", test.name, expected, errs)
+		}
+	}
+}
+
+func TestStatusKeepsRouteService(t *testing.T) {
+	old := &api.Route{ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{api.RouteServiceURLAnnotation: "https://rs.example.com"}}}
+	updated := &api.Route{ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{api.RouteServiceURLAnnotation: "https://attacker.example.com"}}}
+	StatusStrategy.PrepareForUpdate(updated, old)
+	if updated.Annotations[api.RouteServiceURLAnnotation] != "https://rs.example.com" {
+		t.Errorf("expected the route service to be kept, got %v", updated.Annotations)
+	}
+}
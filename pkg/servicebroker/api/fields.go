@@ -7,3 +7,9 @@ func ServiceBrokerToSelectableFields(serviceBroker *ServiceBroker) fields.Set {
 		"metadata.name": serviceBroker.Name,
 	}
 }
+
+func ProjectServiceBrokerToSelectableFields(serviceBroker *ProjectServiceBroker) fields.Set {
+	return fields.Set{
+		"metadata.name": serviceBroker.Name,
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// IsVisible returns true if the plan of service is visible to the namespace with the
+// given labels, or the service itself if plan is empty. Services and plans no rule
+// restricts are visible to all namespaces.
+func IsVisible(rules []ServiceVisibility, service, plan, namespace string, namespaceLabels map[string]string) bool {
+	restricted := false
+	for i := range rules {
+		if !rules[i].restricts(service, plan) {
+			continue
+		}
+		restricted = true
+		if rules[i].allows(namespace, namespaceLabels) {
+			return true
+		}
+	}
+	return !restricted
+}
+
+func (v *ServiceVisibility) restricts(service, plan string) bool {
+	if len(v.Services) > 0 && !contains(v.Services, service) {
+		return false
+	}
+	if len(plan) == 0 {
+		// rules restricting some plans leave the service itself visible.
+		return len(v.Plans) == 0
+	}
+	return len(v.Plans) == 0 || contains(v.Plans, plan)
+}
+
+func (v *ServiceVisibility) allows(namespace string, namespaceLabels map[string]string) bool {
+	if contains(v.Namespaces, namespace) {
+		return true
+	}
+	return len(v.NamespaceSelector) > 0 && labels.SelectorFromSet(v.NamespaceSelector).Matches(labels.Set(namespaceLabels))
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// HealthCheckOf returns how sb is probed, with the defaults filled in.
+func HealthCheckOf(sb *ServiceBroker) ServiceBrokerHealthCheck {
+	hc := ServiceBrokerHealthCheck{}
+	if sb.Spec.HealthCheck != nil {
+		hc = *sb.Spec.HealthCheck
+	}
+	if hc.IntervalSeconds == 0 {
+		hc.IntervalSeconds = DefaultProbeIntervalSeconds
+	}
+	if hc.TimeoutSeconds == 0 {
+		hc.TimeoutSeconds = DefaultProbeTimeoutSeconds
+	}
+	if hc.FailureThreshold == 0 {
+		hc.FailureThreshold = DefaultProbeFailureThreshold
+	}
+	return hc
+}
+
+// ServiceBrokerOf returns psb as a ServiceBroker of its namespace, so that project
+// brokers are handled the way cluster brokers are.
+func ServiceBrokerOf(psb *ProjectServiceBroker) *ServiceBroker {
+	return &ServiceBroker{
+		ObjectMeta: psb.ObjectMeta,
+		Spec:       psb.Spec,
+		Status:     psb.Status,
+	}
+}
+
+// ProjectServiceBrokerOf is the reverse of ServiceBrokerOf.
+func ProjectServiceBrokerOf(sb *ServiceBroker) *ProjectServiceBroker {
+	return &ProjectServiceBroker{
+		ObjectMeta: sb.ObjectMeta,
+		Spec:       sb.Spec,
+		Status:     sb.Status,
+	}
+}
@@ -0,0 +1,32 @@
+package api
+
+import "testing"
+
+func TestIsVisible(t *testing.T) {
+	rules := []ServiceVisibility{
+		{Services: []string{"mysql"}, Namespaces: []string{"team-a"}},
+		{Services: []string{"redis"}, Plans: []string{"large"}, NamespaceSelector: map[string]string{"tier": "gold"}},
+	}
+
+	tests := []struct {
+		service, plan, namespace string
+		labels                   map[string]string
+		visible                  bool
+	}{
+		{service: "mysql", namespace: "team-a", visible: true},
+		{service: "mysql", namespace: "team-b", visible: false},
+		{service: "mysql", plan: "small", namespace: "team-b", visible: false},
+		{service: "mysql", plan: "small", namespace: "team-a", visible: true},
+		{service: "redis", namespace: "team-b", visible: true},
+		{service: "redis", plan: "small", namespace: "team-b", visible: true},
+		{service: "redis", plan: "large", namespace: "team-b", visible: false},
+		{service: "redis", plan: "large", namespace: "team-b", labels: map[string]string{"tier": "gold"}, visible: true},
+		{service: "mongodb", namespace: "team-b", visible: true},
+	}
+
+	for _, test := range tests {
+		if visible := IsVisible(rules, test.service, test.plan, test.namespace, test.labels); visible != test.visible {
+			t.Errorf("%s/%s in %s: expected visible=%v, got %v", test.service, test.plan, test.namespace, test.visible, visible)
+		}
+	}
+}
@@ -8,8 +8,12 @@ func init() {
 	api.Scheme.AddKnownTypes("",
 		&ServiceBroker{},
 		&ServiceBrokerList{},
+		&ProjectServiceBroker{},
+		&ProjectServiceBrokerList{},
 	)
 }
 
-func (*ServiceBroker) IsAnAPIObject()     {}
-func (*ServiceBrokerList) IsAnAPIObject() {}
+func (*ServiceBroker) IsAnAPIObject()            {}
+func (*ServiceBrokerList) IsAnAPIObject()        {}
+func (*ProjectServiceBroker) IsAnAPIObject()     {}
+func (*ProjectServiceBrokerList) IsAnAPIObject() {}
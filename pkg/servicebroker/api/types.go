@@ -22,9 +22,11 @@ const (
 	ServiceBrokerDeleting ServiceBrokerPhase = "Deleting"
 
 	// ServiceBrokerLastPingTime indicates that servicebroker last ping time.
+	// Deprecated: probe results are kept in the status, the controller removes it.
 	ServiceBrokerLastPingTime string = "ServiceBroker/LastPing"
 
 	// ServiceBrokerNewRetryTimes indicates that new servicebroker retry times.
+	// Deprecated: probe results are kept in the status, the controller removes it.
 	ServiceBrokerNewRetryTimes string = "ServiceBroker/NewRetryTimes"
 )
 
@@ -49,18 +51,142 @@ type ServiceBrokerList struct {
 }
 
 type ServiceBrokerSpec struct {
-	Url      string
-	Name     string
+	// Url is the address of the broker, either host[:port] (http is assumed) or a full http(s) url.
+	Url  string
+	Name string
+	// UserName and Password are deprecated, the controllers move them into a
+	// Secret referred to by AuthSecretRef.
 	UserName string
 	Password string
+	// AuthSecretRef refers to a Secret in the ServiceBrokerSecretNamespace holding
+	// the basic auth credentials of the broker in its "username" and "password" keys.
+	AuthSecretRef *SecretReference
+
+	// CABundle is a PEM encoded bundle of the certificate authorities trusted to serve the broker.
+	CABundle []byte
+	// CASecretRef refers to a Secret holding the CA bundle in its "ca.crt" key.
+	CASecretRef *SecretReference
+	// InsecureSkipTLSVerify disables verification of the certificate the broker serves.
+	InsecureSkipTLSVerify bool
+	// ClientCertSecretRef refers to a Secret holding the client certificate and key
+	// presented to the broker, in its "tls.crt" and "tls.key" keys.
+	ClientCertSecretRef *SecretReference
+
+	// Visibility restricts the projects some services or plans of the broker are
+	// visible to, services and plans no rule restricts are visible to all projects.
+	// Only cluster brokers have visibility rules, the services of a ProjectServiceBroker
+	// are only visible in its project.
+	Visibility []ServiceVisibility
+
+	// HealthCheck configures how the broker is probed, the defaults apply if it is nil.
+	HealthCheck *ServiceBrokerHealthCheck
 
 	Finalizers []kapi.FinalizerName
 }
 
+// ServiceBrokerHealthCheck configures the probing of a broker, which fetches its catalog.
+type ServiceBrokerHealthCheck struct {
+	// IntervalSeconds is how often a healthy broker is probed, DefaultProbeIntervalSeconds if zero.
+	IntervalSeconds int
+	// TimeoutSeconds bounds a probe, DefaultProbeTimeoutSeconds if zero.
+	TimeoutSeconds int
+	// FailureThreshold is how many probes in a row have to fail for the broker to be
+	// Failed, DefaultProbeFailureThreshold if zero.
+	FailureThreshold int
+}
+
+const (
+	DefaultProbeIntervalSeconds  = 60
+	DefaultProbeTimeoutSeconds   = 30
+	DefaultProbeFailureThreshold = 3
+)
+
+// ServiceVisibility restricts the projects some services or plans of a broker are visible to.
+type ServiceVisibility struct {
+	// Services are the names of the services restricted, all the services of the broker if empty.
+	Services []string
+	// Plans are the names of the plans restricted, all the plans of Services if empty.
+	Plans []string
+	// Namespaces are the projects the services or plans are visible to.
+	Namespaces []string
+	// NamespaceSelector selects by label more projects the services or plans are visible to.
+	NamespaceSelector map[string]string
+}
+
+// SecretReference refers to a Secret in a namespace.
+type SecretReference struct {
+	Namespace string
+	Name      string
+}
+
 type ServiceBrokerStatus struct {
 	Phase ServiceBrokerPhase
+	// Conditions record the result of the last probe of the broker.
+	Conditions []ServiceBrokerCondition
+	// ConsecutiveFailures counts the probes which failed since the last one which succeeded.
+	ConsecutiveFailures int
+	// CatalogHash is a hash of the catalog last fetched, it changes with the catalog.
+	CatalogHash string
+	// ServiceCount is how many services the catalog last fetched offers.
+	ServiceCount int
+}
+
+type ServiceBrokerConditionType string
+
+const (
+	// ServiceBrokerReady is true if the last probe fetched and synced the catalog.
+	ServiceBrokerReady ServiceBrokerConditionType = "Ready"
+)
+
+type ServiceBrokerCondition struct {
+	Type   ServiceBrokerConditionType
+	Status kapi.ConditionStatus
+	// LastProbeTime is when the broker was last probed. It is only written along with
+	// another change of the status.
+	LastProbeTime      unversioned.Time
+	LastTransitionTime unversioned.Time
+	Reason             string
+	// Message is the error of the last probe if it failed.
+	Message string
+}
+
+// ProjectServiceBroker is a service broker registered in a project. Its catalog
+// is imported as BackingServices of that project, only visible there.
+type ProjectServiceBroker struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	Spec   ServiceBrokerSpec
+	Status ServiceBrokerStatus
+}
+
+type ProjectServiceBrokerList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	Items []ProjectServiceBroker
 }
 
 const (
 	ServiceBrokerLabel = "asiainfo.io/servicebroker"
+
+	// InProcessURLScheme is the scheme of the url of brokers served by the master
+	// itself, such as the template service broker. Only cluster brokers may use it.
+	InProcessURLScheme = "inprocess"
+)
+
+const (
+	// SecretCAKey is the key of the CA bundle in the Secret referred to by CASecretRef.
+	SecretCAKey = "ca.crt"
+	// SecretCertKey is the key of the client certificate in the Secret referred to by ClientCertSecretRef.
+	SecretCertKey = "tls.crt"
+	// SecretKeyKey is the key of the client key in the Secret referred to by ClientCertSecretRef.
+	SecretKeyKey = "tls.key"
+	// SecretUsernameKey is the key of the username in the Secret referred to by AuthSecretRef.
+	SecretUsernameKey = "username"
+	// SecretPasswordKey is the key of the password in the Secret referred to by AuthSecretRef.
+	SecretPasswordKey = "password"
+
+	// ServiceBrokerSecretNamespace is the namespace holding the Secrets referred to by AuthSecretRef.
+	ServiceBrokerSecretNamespace = "openshift"
 )
@@ -52,15 +52,82 @@ type ServiceBrokerList struct {
 type ServiceBrokerSpec struct {
 	Url      string `json:"url" description:"url defines the address of a ServiceBroker service"`
 	Name     string `json:"name" description:"name defines the name of a ServiceBroker service"`
-	UserName string `json:"username" description:"username defines the username to access ServiceBroker service"`
-	Password string `json:"password" description:"password defines the password to access ServiceBroker service"`
+	UserName string `json:"username" description:"deprecated, username defines the username to access ServiceBroker service"`
+	Password string `json:"password" description:"deprecated, password defines the password to access ServiceBroker service"`
+
+	AuthSecretRef *SecretReference `json:"authSecretRef,omitempty" description:"secret in the openshift namespace holding the credentials to access ServiceBroker service in its username and password keys"`
+
+	CABundle              []byte           `json:"caBundle,omitempty" description:"PEM encoded bundle of the certificate authorities trusted to serve the ServiceBroker"`
+	CASecretRef           *SecretReference `json:"caSecretRef,omitempty" description:"secret holding the CA bundle in its ca.crt key"`
+	InsecureSkipTLSVerify bool             `json:"insecureSkipTLSVerify,omitempty" description:"skip verification of the certificate the ServiceBroker serves"`
+	ClientCertSecretRef   *SecretReference `json:"clientCertSecretRef,omitempty" description:"secret holding the client certificate and key presented to the ServiceBroker in its tls.crt and tls.key keys"`
+
+	Visibility []ServiceVisibility `json:"visibility,omitempty" description:"rules restricting the projects some services or plans are visible to, the others are visible to all projects"`
+
+	HealthCheck *ServiceBrokerHealthCheck `json:"healthCheck,omitempty" description:"how the ServiceBroker is probed, the defaults apply if not set"`
 	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage
 	Finalizers []kapi.FinalizerName `json:"finalizers,omitempty" description:"an opaque list of values that must be empty to permanently remove object from storage"`
 }
 
+// ServiceBrokerHealthCheck configures the probing of a broker, which fetches its catalog.
+type ServiceBrokerHealthCheck struct {
+	IntervalSeconds  int `json:"intervalSeconds,omitempty" description:"how often a healthy broker is probed, 60 if not set"`
+	TimeoutSeconds   int `json:"timeoutSeconds,omitempty" description:"how long a probe may take, 30 if not set"`
+	FailureThreshold int `json:"failureThreshold,omitempty" description:"how many probes in a row have to fail for the broker to be Failed, 3 if not set"`
+}
+
+// ServiceVisibility restricts the projects some services or plans of a broker are visible to.
+type ServiceVisibility struct {
+	Services          []string          `json:"services,omitempty" description:"names of the services restricted, all the services of the broker if empty"`
+	Plans             []string          `json:"plans,omitempty" description:"names of the plans restricted, all the plans of the services if empty"`
+	Namespaces        []string          `json:"namespaces,omitempty" description:"projects the services or plans are visible to"`
+	NamespaceSelector map[string]string `json:"namespaceSelector,omitempty" description:"label selector of more projects the services or plans are visible to"`
+}
+
+// SecretReference refers to a Secret in a namespace.
+type SecretReference struct {
+	Namespace string `json:"namespace" description:"namespace of the secret"`
+	Name      string `json:"name" description:"name of the secret"`
+}
+
 // ServiceBrokerStatus is information about the current status of a ServiceBroker
 type ServiceBrokerStatus struct {
-	Phase ServiceBrokerPhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the servicebroker"`
+	Phase               ServiceBrokerPhase       `json:"phase,omitempty" description:"phase is the current lifecycle phase of the servicebroker"`
+	Conditions          []ServiceBrokerCondition `json:"conditions,omitempty" description:"result of the last probe of the servicebroker"`
+	ConsecutiveFailures int                      `json:"consecutiveFailures,omitempty" description:"probes which failed since the last one which succeeded"`
+	CatalogHash         string                   `json:"catalogHash,omitempty" description:"hash of the catalog last fetched"`
+	ServiceCount        int                      `json:"serviceCount,omitempty" description:"number of services the catalog last fetched offers"`
+}
+
+type ServiceBrokerConditionType string
+
+const (
+	ServiceBrokerReady ServiceBrokerConditionType = "Ready"
+)
+
+type ServiceBrokerCondition struct {
+	Type               ServiceBrokerConditionType `json:"type" description:"type of the condition"`
+	Status             kapi.ConditionStatus       `json:"status" description:"status of the condition, True, False or Unknown"`
+	LastProbeTime      unversioned.Time           `json:"lastProbeTime,omitempty" description:"last time the broker was probed, only written along with another change of the status"`
+	LastTransitionTime unversioned.Time           `json:"lastTransitionTime,omitempty" description:"last time the condition changed"`
+	Reason             string                     `json:"reason,omitempty" description:"one word CamelCase reason for the last transition"`
+	Message            string                     `json:"message,omitempty" description:"error of the last probe if it failed"`
+}
+
+// ProjectServiceBroker is a service broker registered in a project, whose services are only visible there.
+type ProjectServiceBroker struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec   ServiceBrokerSpec   `json:"spec,omitempty" description:"spec defines the behavior of the ProjectServiceBroker"`
+	Status ServiceBrokerStatus `json:"status,omitempty" description:"status describes the current status of a ProjectServiceBroker; read-only"`
+}
+
+type ProjectServiceBrokerList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	Items []ProjectServiceBroker `json:"items" description:"list of projectservicebrokers"`
 }
 
 const (
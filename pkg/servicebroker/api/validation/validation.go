@@ -1,7 +1,11 @@
 package validation
 
 import (
+	"crypto/x509"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
@@ -28,6 +32,144 @@ func ValidateServiceBrokerName(name string, prefix bool) (bool, string) {
 func ValidateServiceBroker(servicebroker *servicebrokerapi.ServiceBroker) fielderrors.ValidationErrorList {
 	result := fielderrors.ValidationErrorList{}
 	result = append(result, validation.ValidateObjectMeta(&servicebroker.ObjectMeta, false, ValidateServiceBrokerName).Prefix("metadata")...)
+	result = append(result, ValidateServiceBrokerSpec(&servicebroker.Spec).Prefix("spec")...)
+
+	return result
+}
+
+// ValidateServiceBrokerSpec tests the connection settings and the visibility rules of a ServiceBroker.
+func ValidateServiceBrokerSpec(spec *servicebrokerapi.ServiceBrokerSpec) fielderrors.ValidationErrorList {
+	result := validateConnection(spec)
+
+	authErrs := fielderrors.ValidationErrorList{}
+	if ref := spec.AuthSecretRef; ref != nil && len(ref.Namespace) > 0 && ref.Namespace != servicebrokerapi.ServiceBrokerSecretNamespace {
+		authErrs = append(authErrs, fielderrors.NewFieldInvalid("namespace", ref.Namespace, "must be "+servicebrokerapi.ServiceBrokerSecretNamespace))
+	}
+	result = append(result, authErrs.Prefix("authSecretRef")...)
+
+	for i := range spec.Visibility {
+		result = append(result, validateServiceVisibility(&spec.Visibility[i]).PrefixIndex(i).Prefix("visibility")...)
+	}
+
+	return result
+}
+
+// ValidateProjectServiceBroker tests a ServiceBroker registered in a project, which
+// may only refer to the Secrets of its project.
+func ValidateProjectServiceBroker(psb *servicebrokerapi.ProjectServiceBroker) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	result = append(result, validation.ValidateObjectMeta(&psb.ObjectMeta, true, ValidateServiceBrokerName).Prefix("metadata")...)
+
+	specErrs := validateConnection(&psb.Spec)
+	refs := map[string]*servicebrokerapi.SecretReference{
+		"authSecretRef":       psb.Spec.AuthSecretRef,
+		"caSecretRef":         psb.Spec.CASecretRef,
+		"clientCertSecretRef": psb.Spec.ClientCertSecretRef,
+	}
+	for field, ref := range refs {
+		if ref != nil && ref.Namespace != psb.Namespace {
+			specErrs = append(specErrs, fielderrors.NewFieldInvalid(field+".namespace", ref.Namespace, "must be "+psb.Namespace))
+		}
+	}
+	if strings.HasPrefix(psb.Spec.Url, servicebrokerapi.InProcessURLScheme+"://") {
+		specErrs = append(specErrs, fielderrors.NewFieldInvalid("url", psb.Spec.Url, "only cluster brokers are served in process"))
+	}
+	if len(psb.Spec.Visibility) > 0 {
+		specErrs = append(specErrs, fielderrors.NewFieldInvalid("visibility", "", "the services of a project broker are only visible in its project"))
+	}
+	result = append(result, specErrs.Prefix("spec")...)
+
+	return result
+}
+
+// ValidateProjectServiceBrokerUpdate tests to make sure a projectservicebroker update can be applied.
+func ValidateProjectServiceBrokerUpdate(psb *servicebrokerapi.ProjectServiceBroker, older *servicebrokerapi.ProjectServiceBroker) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	result = append(result, validation.ValidateObjectMetaUpdate(&psb.ObjectMeta, &older.ObjectMeta).Prefix("metadata")...)
+	result = append(result, ValidateProjectServiceBroker(psb)...)
+	return result
+}
+
+func validateServiceVisibility(v *servicebrokerapi.ServiceVisibility) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+
+	if len(v.Namespaces) == 0 && len(v.NamespaceSelector) == 0 {
+		result = append(result, fielderrors.NewFieldRequired("namespaces"))
+	}
+	for i, namespace := range v.Namespaces {
+		if ok, msg := validation.ValidateNamespaceName(namespace, false); !ok {
+			result = append(result, fielderrors.NewFieldInvalid("namespaces["+strconv.Itoa(i)+"]", namespace, msg))
+		}
+	}
+	result = append(result, validation.ValidateLabels(v.NamespaceSelector, "namespaceSelector")...)
+
+	return result
+}
+
+// validateConnection tests the settings used to reach a broker.
+func validateConnection(spec *servicebrokerapi.ServiceBrokerSpec) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+
+	if strings.Contains(spec.Url, "://") {
+		if u, err := url.Parse(spec.Url); err != nil {
+			result = append(result, fielderrors.NewFieldInvalid("url", spec.Url, err.Error()))
+		} else if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != servicebrokerapi.InProcessURLScheme {
+			result = append(result, fielderrors.NewFieldValueNotSupported("url", spec.Url, []string{"http", "https", servicebrokerapi.InProcessURLScheme}))
+		} else if len(u.Host) == 0 {
+			result = append(result, fielderrors.NewFieldInvalid("url", spec.Url, "must have a host"))
+		}
+	}
+
+	if len(spec.CABundle) > 0 {
+		if !x509.NewCertPool().AppendCertsFromPEM(spec.CABundle) {
+			result = append(result, fielderrors.NewFieldInvalid("caBundle", "", "no PEM encoded certificates found"))
+		}
+	}
+	if spec.InsecureSkipTLSVerify && (len(spec.CABundle) > 0 || spec.CASecretRef != nil) {
+		result = append(result, fielderrors.NewFieldInvalid("insecureSkipTLSVerify", spec.InsecureSkipTLSVerify, "may not be set together with a CA bundle"))
+	}
+
+	result = append(result, validateSecretReference(spec.AuthSecretRef).Prefix("authSecretRef")...)
+	result = append(result, validateSecretReference(spec.CASecretRef).Prefix("caSecretRef")...)
+	result = append(result, validateSecretReference(spec.ClientCertSecretRef).Prefix("clientCertSecretRef")...)
+	result = append(result, validateHealthCheck(spec.HealthCheck).Prefix("healthCheck")...)
+
+	return result
+}
+
+func validateHealthCheck(hc *servicebrokerapi.ServiceBrokerHealthCheck) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	if hc == nil {
+		return result
+	}
+	if hc.IntervalSeconds < 0 {
+		result = append(result, fielderrors.NewFieldInvalid("intervalSeconds", hc.IntervalSeconds, "must be non-negative"))
+	}
+	if hc.TimeoutSeconds < 0 {
+		result = append(result, fielderrors.NewFieldInvalid("timeoutSeconds", hc.TimeoutSeconds, "must be non-negative"))
+	}
+	if hc.FailureThreshold < 0 {
+		result = append(result, fielderrors.NewFieldInvalid("failureThreshold", hc.FailureThreshold, "must be non-negative"))
+	}
+	return result
+}
+
+func validateSecretReference(ref *servicebrokerapi.SecretReference) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	if ref == nil {
+		return result
+	}
+
+	if len(ref.Name) == 0 {
+		result = append(result, fielderrors.NewFieldRequired("name"))
+	} else if ok, msg := validation.ValidateSecretName(ref.Name, false); !ok {
+		result = append(result, fielderrors.NewFieldInvalid("name", ref.Name, msg))
+	}
+	if len(ref.Namespace) == 0 {
+		result = append(result, fielderrors.NewFieldRequired("namespace"))
+	} else if ok, msg := validation.ValidateNamespaceName(ref.Namespace, false); !ok {
+		result = append(result, fielderrors.NewFieldInvalid("namespace", ref.Namespace, msg))
+	}
 
 	return result
 }
@@ -2,116 +2,313 @@ package client
 
 import (
 	"bytes"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"github.com/golang/glog"
-	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
-type ServiceList struct {
-	Services []backingserviceapi.BackingServiceSpec `json:"services"`
+// Interface is a client of the Service Broker API v2.
+type Interface interface {
+	// Catalog lists the services and plans the broker offers.
+	Catalog(ctx context.Context) (*CatalogResponse, error)
+	// Provision creates an instance of a service.
+	Provision(ctx context.Context, instanceID string, r *ProvisionRequest) (*ProvisionResponse, error)
+	// Update changes the plan or parameters of an instance.
+	Update(ctx context.Context, instanceID string, r *UpdateRequest) (*UpdateResponse, error)
+	// Deprovision deletes an instance. An instance the broker doesn't know is reported as deprovisioned.
+	Deprovision(ctx context.Context, instanceID string, r *DeprovisionRequest) (*DeprovisionResponse, error)
+	// Bind creates a binding to an instance.
+	Bind(ctx context.Context, instanceID, bindingID string, r *BindRequest) (*BindResponse, error)
+	// Unbind deletes a binding. A binding the broker doesn't know is reported as unbound.
+	Unbind(ctx context.Context, instanceID, bindingID string, r *UnbindRequest) error
+	// LastOperation polls the state of an asynchronous operation. IsGone is true for the
+	// returned error if the instance doesn't exist at the broker.
+	LastOperation(ctx context.Context, instanceID string, r *LastOperationRequest) (*LastOperationResponse, error)
 }
 
-type Interface interface {
-	Catalog(Url string, credential ...string) (ServiceList, error)
+// DefaultTimeout bounds every request to a broker unless a Config says otherwise.
+const DefaultTimeout = 60 * time.Second
+
+// Config holds what is needed to talk to a broker.
+type Config struct {
+	// URL is the base url of the broker, http:// is assumed if it has no scheme.
+	URL      string
+	Username string
+	Password string
+	// Timeout bounds every request, DefaultTimeout is used if it is zero.
+	Timeout time.Duration
+
+	// CAData is a PEM encoded bundle of the certificate authorities trusted to serve
+	// the broker, the system roots are used if it is empty.
+	CAData []byte
+	// CertData and KeyData are the PEM encoded client certificate and key presented to the broker.
+	CertData []byte
+	KeyData  []byte
+	// Insecure skips the verification of the certificate the broker serves.
+	Insecure bool
+
+	// Transport is used to make the requests, a transport is built from the TLS settings above if it is nil.
+	Transport http.RoundTripper
 }
 
-func NewServiceBrokerClient() Interface {
-	return &httpClient{
-		Get:  httpGet,
-		Post: httpPostJson,
+// ClientFunc returns a client for a ServiceBroker. Controllers take one so that tests
+// can hand out clients of a fake broker.
+type ClientFunc func(sb *servicebrokerapi.ServiceBroker) (Interface, error)
+
+// NewClientFunc returns a ClientFunc reading the secrets a ServiceBroker refers to with secrets.
+func NewClientFunc(secrets kclient.SecretsNamespacer) ClientFunc {
+	return func(sb *servicebrokerapi.ServiceBroker) (Interface, error) {
+		config, err := ConfigForServiceBroker(sb, secrets)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(config)
+	}
+}
+
+// NewClient returns a client for the broker described by config.
+func NewClient(config *Config) (Interface, error) {
+	baseURL := config.URL
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "http://" + baseURL
+	}
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid servicebroker url %q: %v", config.URL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid servicebroker url %q: no host", config.URL)
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
 	}
+
+	transport := config.Transport
+	if transport == nil {
+		if transport, err = transportFor(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &client{
+		baseURL:  u,
+		username: config.Username,
+		password: config.Password,
+		http: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+	}, nil
 }
 
-type httpClient struct {
-	Get  func(getUrl string, credential ...string) ([]byte, error)
-	Post func(getUrl string, body []byte, credential ...string) ([]byte, error)
+type client struct {
+	baseURL  *url.URL
+	username string
+	password string
+	http     *http.Client
 }
 
-func (c *httpClient) Catalog(Url string, credential ...string) (ServiceList, error) {
-	services := new(ServiceList)
-	b, err := c.Get("http://"+Url+"/v2/catalog", credential...)
+var _ Interface = &client{}
+
+func (c *client) Catalog(ctx context.Context) (*CatalogResponse, error) {
+	catalog := &CatalogResponse{}
+	if _, _, err := c.do(ctx, "GET", "/v2/catalog", nil, nil, catalog, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+func (c *client) Provision(ctx context.Context, instanceID string, r *ProvisionRequest) (*ProvisionResponse, error) {
+	resp := &ProvisionResponse{}
+	code, _, err := c.do(ctx, "PUT", instancePath(instanceID), acceptsIncomplete(r.AcceptsIncomplete), r, resp,
+		http.StatusOK, http.StatusCreated, http.StatusAccepted)
 	if err != nil {
-		fmt.Printf("httpclient catalog err %s", err.Error())
-		return *services, err
+		return nil, err
 	}
+	resp.Async = code == http.StatusAccepted
+	resp.Exists = code == http.StatusOK
+	return resp, nil
+}
 
-	if err := json.Unmarshal(b, services); err != nil {
-		return *services, err
+func (c *client) Update(ctx context.Context, instanceID string, r *UpdateRequest) (*UpdateResponse, error) {
+	resp := &UpdateResponse{}
+	code, _, err := c.do(ctx, "PATCH", instancePath(instanceID), acceptsIncomplete(r.AcceptsIncomplete), r, resp,
+		http.StatusOK, http.StatusAccepted)
+	if err != nil {
+		return nil, err
 	}
+	resp.Async = code == http.StatusAccepted
+	return resp, nil
+}
+
+func (c *client) Deprovision(ctx context.Context, instanceID string, r *DeprovisionRequest) (*DeprovisionResponse, error) {
+	query := acceptsIncomplete(r.AcceptsIncomplete)
+	query.Set("service_id", r.ServiceID)
+	query.Set("plan_id", r.PlanID)
 
-	return *services, nil
+	resp := &DeprovisionResponse{}
+	code, _, err := c.do(ctx, "DELETE", instancePath(instanceID), query, nil, resp,
+		http.StatusOK, http.StatusAccepted, http.StatusGone)
+	if err != nil {
+		return nil, err
+	}
+	resp.Async = code == http.StatusAccepted
+	return resp, nil
 }
 
-//todo 支持多种自定义认证方式
-func httpGet(getUrl string, credential ...string) ([]byte, error) {
-	var resp *http.Response
-	var err error
-	if len(credential) == 2 {
-		req, err := http.NewRequest("GET", getUrl, nil)
-		if err != nil {
-			return nil, fmt.Errorf("[servicebroker http client] err %s, %s\n", getUrl, err)
-		}
+func (c *client) Bind(ctx context.Context, instanceID, bindingID string, r *BindRequest) (*BindResponse, error) {
+	resp := &BindResponse{}
+	code, _, err := c.do(ctx, "PUT", bindingPath(instanceID, bindingID), nil, r, resp,
+		http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	resp.Exists = code == http.StatusOK
+	return resp, nil
+}
 
-		basic := fmt.Sprintf("Basic %s", string(base64Encode([]byte(fmt.Sprintf("%s:%s", credential[0], credential[1])))))
-		req.Header.Set(Authorization, basic)
+func (c *client) Unbind(ctx context.Context, instanceID, bindingID string, r *UnbindRequest) error {
+	query := url.Values{}
+	query.Set("service_id", r.ServiceID)
+	query.Set("plan_id", r.PlanID)
 
-		resp, err = http.DefaultClient.Do(req)
-		if err != nil {
-			fmt.Errorf("http get err:%s", err.Error())
-			return nil, err
-		}
-		if resp.StatusCode != 200 {
-			return nil, fmt.Errorf("[servicebroker http client] status err %s, %d\n", getUrl, resp.StatusCode)
-		}
-	} else {
-		resp, err = http.Get(getUrl)
-		if err != nil {
-			fmt.Errorf("servicebroker http client get err:%s", err.Error())
-			return nil, err
-		}
-		if resp.StatusCode != 200 {
-			return nil, fmt.Errorf("[http get] status err %s, %d\n", getUrl, resp.StatusCode)
-		}
+	_, _, err := c.do(ctx, "DELETE", bindingPath(instanceID, bindingID), query, nil, nil,
+		http.StatusOK, http.StatusGone)
+	return err
+}
+
+func (c *client) LastOperation(ctx context.Context, instanceID string, r *LastOperationRequest) (*LastOperationResponse, error) {
+	query := url.Values{}
+	if r.ServiceID != "" {
+		query.Set("service_id", r.ServiceID)
+	}
+	if r.PlanID != "" {
+		query.Set("plan_id", r.PlanID)
+	}
+	if r.Operation != "" {
+		query.Set("operation", r.Operation)
 	}
 
-	glog.Infof("GET %s returns http code %v", getUrl, resp.StatusCode)
-	return ioutil.ReadAll(resp.Body)
+	resp := &LastOperationResponse{}
+	_, header, err := c.do(ctx, "GET", instancePath(instanceID)+"/last_operation", query, nil, resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+		resp.AsyncPollIntervalSeconds = seconds
+	}
+	return resp, nil
+}
+
+func instancePath(instanceID string) string {
+	return "/v2/service_instances/" + url.PathEscape(instanceID)
+}
+
+func bindingPath(instanceID, bindingID string) string {
+	return instancePath(instanceID) + "/service_bindings/" + url.PathEscape(bindingID)
+}
+
+func acceptsIncomplete(accepts bool) url.Values {
+	query := url.Values{}
+	if accepts {
+		query.Set("accepts_incomplete", "true")
+	}
+	return query
 }
 
-func httpPostJson(postUrl string, body []byte, credential ...string) ([]byte, error) {
-	var resp *http.Response
-	var err error
-	req, err := http.NewRequest("POST", postUrl, bytes.NewBuffer(body))
+// do sends in as json to the broker and decodes the response into out if the
+// broker answers with one of the expected status codes. Any other status code is
+// returned as a *HTTPStatusCodeError.
+func (c *client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}, expected ...int) (int, http.Header, error) {
+	u := *c.baseURL
+	// path is escaped: the ids it holds may contain slashes.
+	u.RawPath = u.EscapedPath() + path
+	u.Path, _ = url.PathUnescape(u.RawPath)
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return 0, nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
-		return nil, fmt.Errorf("[http] err %s, %s\n", postUrl, err)
+		return 0, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
-	req.Header.Set("Content-Type", "application/json")
-	if len(credential) == 2 {
-		basic := fmt.Sprintf("Basic %s", string(base64Encode([]byte(fmt.Sprintf("%s:%s", credential[0], credential[1])))))
-		req.Header.Set(Authorization, basic)
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
 	}
-	resp, err = http.DefaultClient.Do(req)
 
+	resp, err := ctxhttp.Do(ctx, c.http, req)
 	if err != nil {
-		return nil, fmt.Errorf("[http] err %s, %s\n", postUrl, err)
+		return 0, nil, &RequestError{Method: method, URL: redact(&u), Err: err}
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("[http] status err %s, %d\n", postUrl, resp.StatusCode)
-	}
-	b, err := ioutil.ReadAll(resp.Body)
+
+	glog.V(4).Infof("%s %s returns http code %v", method, redact(&u), resp.StatusCode)
+
+	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("[http] read err %s, %s\n", postUrl, err)
+		return 0, nil, &RequestError{Method: method, URL: redact(&u), StatusCode: resp.StatusCode, Err: err}
 	}
-	return b, nil
+
+	if !isExpected(resp.StatusCode, expected) {
+		statusErr := &HTTPStatusCodeError{StatusCode: resp.StatusCode}
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(data, errResp) == nil {
+			statusErr.ErrorMessage = errResp.Error
+			statusErr.Description = errResp.Description
+		}
+		return 0, nil, statusErr
+	}
+
+	if out != nil && resp.StatusCode != http.StatusGone && len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return 0, nil, &RequestError{Method: method, URL: redact(&u), StatusCode: resp.StatusCode, Err: fmt.Errorf("invalid response: %v", err)}
+		}
+	}
+
+	return resp.StatusCode, resp.Header, nil
 }
 
-const Authorization = "Authorization"
+func isExpected(code int, expected []int) bool {
+	for _, e := range expected {
+		if code == e {
+			return true
+		}
+	}
+	return false
+}
 
-func base64Encode(src []byte) []byte {
-	return []byte(base64.StdEncoding.EncodeToString(src))
+// redact strips the query, which may carry ids, from urls written to logs and errors.
+func redact(u *url.URL) string {
+	r := *u
+	r.RawQuery = ""
+	r.User = nil
+	return r.String()
 }
@@ -0,0 +1,268 @@
+package client_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/openshift/origin/pkg/servicebroker/client"
+	"github.com/openshift/origin/pkg/servicebroker/client/fakebroker"
+)
+
+func newBroker(t *testing.T) (*fakebroker.Broker, client.Interface) {
+	broker := fakebroker.New(client.CatalogResponse{
+		Services: []client.Service{{
+			Name:           "mysql",
+			ID:             "service-1",
+			Bindable:       true,
+			PlanUpdateable: true,
+			Metadata:       map[string]interface{}{"displayName": "MySQL", "listed": true},
+			Plans: []client.Plan{
+				{ID: "plan-1", Name: "small"},
+				{ID: "plan-2", Name: "large", Metadata: &client.PlanMetadata{Bullets: []string{"10GB"}}},
+			},
+		}},
+	})
+	broker.Username = "admin"
+	broker.Password = "secret"
+	url := broker.Start()
+
+	c, err := client.NewClient(&client.Config{URL: url, Username: "admin", Password: "secret", Timeout: 5 * time.Second})
+	if err != nil {
+		broker.Close()
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return broker, c
+}
+
+func TestCatalog(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+
+	catalog, err := c.Catalog(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(catalog.Services) != 1 || len(catalog.Services[0].Plans) != 2 {
+		t.Fatalf("unexpected catalog: %#v", catalog)
+	}
+	service := catalog.Services[0]
+	if !service.PlanUpdateable || service.Metadata["listed"] != true {
+		t.Errorf("unexpected service: %#v", service)
+	}
+	if service.Plans[1].Metadata == nil || service.Plans[1].Metadata.Bullets[0] != "10GB" {
+		t.Errorf("unexpected plan: %#v", service.Plans[1])
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	broker, _ := newBroker(t)
+	defer broker.Close()
+
+	c, err := client.NewClient(&client.Config{URL: broker.URL(), Username: "admin", Password: "wrong"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = c.Catalog(context.Background())
+	if e, ok := err.(*client.HTTPStatusCodeError); !ok || e.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %v", err)
+	}
+}
+
+func TestInstanceLifecycle(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+	ctx := context.Background()
+
+	resp, err := c.Provision(ctx, "instance-1", &client.ProvisionRequest{ServiceID: "service-1", PlanID: "plan-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Async || resp.DashboardURL == "" {
+		t.Errorf("unexpected provision response: %#v", resp)
+	}
+
+	if _, err := c.Update(ctx, "instance-1", &client.UpdateRequest{ServiceID: "service-1", PlanID: "plan-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if broker.Instances["instance-1"].PlanID != "plan-2" {
+		t.Errorf("expected plan to be updated, got %#v", broker.Instances["instance-1"])
+	}
+
+	bind, err := c.Bind(ctx, "instance-1", "binding-1", &client.BindRequest{ServiceID: "service-1", PlanID: "plan-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bind.Credentials["username"] != "binding-1" || bind.Credentials["port"] != float64(1234) {
+		t.Errorf("unexpected credentials: %#v", bind.Credentials)
+	}
+
+	if err := c.Unbind(ctx, "instance-1", "binding-1", &client.UnbindRequest{ServiceID: "service-1", PlanID: "plan-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// unbinding twice is not an error.
+	if err := c.Unbind(ctx, "instance-1", "binding-1", &client.UnbindRequest{ServiceID: "service-1", PlanID: "plan-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.Deprovision(ctx, "instance-1", &client.DeprovisionRequest{ServiceID: "service-1", PlanID: "plan-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(broker.Instances) != 0 {
+		t.Errorf("expected instance to be deprovisioned")
+	}
+	// deprovisioning twice is not an error.
+	if _, err := c.Deprovision(ctx, "instance-1", &client.DeprovisionRequest{ServiceID: "service-1", PlanID: "plan-2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEscapedIDs(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+	ctx := context.Background()
+
+	// ids are path segments: spaces are not turned into +, slashes do not add segments.
+	id := "db 1/a+b"
+	if _, err := c.Provision(ctx, id, &client.ProvisionRequest{ServiceID: "service-1", PlanID: "plan-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := broker.Instances[id]; !ok {
+		t.Errorf("expected instance %q to be provisioned, got %#v", id, broker.Instances)
+	}
+	if _, err := c.Bind(ctx, id, id, &client.BindRequest{ServiceID: "service-1", PlanID: "plan-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := broker.Bindings[id]; !ok {
+		t.Errorf("expected binding %q, got %#v", id, broker.Bindings)
+	}
+}
+
+func TestAsyncProvision(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+	broker.Async = true
+	ctx := context.Background()
+
+	resp, err := c.Provision(ctx, "instance-1", &client.ProvisionRequest{ServiceID: "service-1", PlanID: "plan-1", AcceptsIncomplete: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Async || resp.Operation != fakebroker.Operation {
+		t.Fatalf("unexpected provision response: %#v", resp)
+	}
+
+	req := &client.LastOperationRequest{ServiceID: "service-1", PlanID: "plan-1", Operation: resp.Operation}
+	op, err := c.LastOperation(ctx, "instance-1", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if op.State != client.StateInProgress {
+		t.Errorf("expected operation in progress, got %#v", op)
+	}
+
+	broker.Complete("instance-1", client.StateSucceeded)
+	if op, err = c.LastOperation(ctx, "instance-1", req); err != nil || op.State != client.StateSucceeded {
+		t.Errorf("expected operation to succeed, got %#v, %v", op, err)
+	}
+
+	deprovision, err := c.Deprovision(ctx, "instance-1", &client.DeprovisionRequest{ServiceID: "service-1", PlanID: "plan-1", AcceptsIncomplete: true})
+	if err != nil || !deprovision.Async {
+		t.Fatalf("expected asynchronous deprovision, got %#v, %v", deprovision, err)
+	}
+	broker.Complete("instance-1", client.StateSucceeded)
+	if _, err = c.LastOperation(ctx, "instance-1", req); !client.IsGone(err) {
+		t.Errorf("expected instance to be gone, got %v", err)
+	}
+}
+
+func TestBrokerError(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+
+	broker.Errors["PUT /v2/service_instances/instance-1"] = fakebroker.Error{
+		StatusCode:    422,
+		ErrorResponse: client.ErrorResponse{Error: "AsyncRequired", Description: "This service plan requires client support for asynchronous service operations."},
+	}
+
+	_, err := c.Provision(context.Background(), "instance-1", &client.ProvisionRequest{ServiceID: "service-1", PlanID: "plan-1"})
+	if !client.IsAsyncRequired(err) {
+		t.Fatalf("expected AsyncRequired, got %v", err)
+	}
+	if e := err.(*client.HTTPStatusCodeError); e.Description == "" {
+		t.Errorf("expected the broker description to be decoded, got %#v", e)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	time.Sleep(time.Millisecond)
+
+	_, err := c.Catalog(ctx)
+	if err == nil {
+		t.Fatalf("expected the request to be canceled")
+	}
+	if !client.IsAmbiguous(err) {
+		t.Errorf("expected a timeout to be ambiguous, got %v", err)
+	}
+}
+
+func TestAmbiguousErrors(t *testing.T) {
+	broker, c := newBroker(t)
+	defer broker.Close()
+
+	broker.Errors["PUT /v2/service_instances/instance-1"] = fakebroker.Error{StatusCode: 503}
+	broker.Errors["PUT /v2/service_instances/instance-2"] = fakebroker.Error{StatusCode: 409}
+
+	req := &client.ProvisionRequest{ServiceID: "service-1", PlanID: "plan-1"}
+	if _, err := c.Provision(context.Background(), "instance-1", req); !client.IsAmbiguous(err) {
+		t.Errorf("expected 503 to be ambiguous, got %v", err)
+	}
+	if _, err := c.Provision(context.Background(), "instance-2", req); err == nil || client.IsAmbiguous(err) || !client.IsRejected(err) {
+		t.Errorf("expected 409 to be rejected, got %v", err)
+	}
+}
+
+func TestTLS(t *testing.T) {
+	broker := fakebroker.New(client.CatalogResponse{})
+	url, cert := broker.StartTLS()
+	defer broker.Close()
+
+	tests := map[string]struct {
+		config  client.Config
+		success bool
+	}{
+		"untrusted": {
+			config: client.Config{URL: url},
+		},
+		"trusted ca": {
+			config:  client.Config{URL: url, CAData: cert},
+			success: true,
+		},
+		"insecure": {
+			config:  client.Config{URL: url, Insecure: true},
+			success: true,
+		},
+	}
+
+	for name, test := range tests {
+		c, err := client.NewClient(&test.config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		_, err = c.Catalog(context.Background())
+		if test.success && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !test.success && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// ConfigForServiceBroker returns the Config to talk to sb, reading the secrets
+// it refers to with secrets. Secrets are read on every call, so that credentials
+// can be rotated without editing the ServiceBroker.
+func ConfigForServiceBroker(sb *servicebrokerapi.ServiceBroker, secrets kclient.SecretsNamespacer) (*Config, error) {
+	config := &Config{
+		URL:      sb.Spec.Url,
+		Username: sb.Spec.UserName,
+		Password: sb.Spec.Password,
+		CAData:   sb.Spec.CABundle,
+		Insecure: sb.Spec.InsecureSkipTLSVerify,
+	}
+
+	if ref := sb.Spec.AuthSecretRef; ref != nil {
+		username, err := optionalSecretData(secrets, ref, servicebrokerapi.SecretUsernameKey)
+		if err != nil {
+			return nil, err
+		}
+		password, err := secretData(secrets, ref, servicebrokerapi.SecretPasswordKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Username, config.Password = string(username), string(password)
+	}
+
+	if ref := sb.Spec.CASecretRef; ref != nil {
+		data, err := secretData(secrets, ref, servicebrokerapi.SecretCAKey)
+		if err != nil {
+			return nil, err
+		}
+		config.CAData = append(append([]byte{}, config.CAData...), data...)
+	}
+
+	if ref := sb.Spec.ClientCertSecretRef; ref != nil {
+		var err error
+		if config.CertData, err = secretData(secrets, ref, servicebrokerapi.SecretCertKey); err != nil {
+			return nil, err
+		}
+		if config.KeyData, err = secretData(secrets, ref, servicebrokerapi.SecretKeyKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+func secretData(secrets kclient.SecretsNamespacer, ref *servicebrokerapi.SecretReference, key string) ([]byte, error) {
+	data, err := optionalSecretData(secrets, ref, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no %q key", ref.Namespace, ref.Name, key)
+	}
+	return data, nil
+}
+
+func optionalSecretData(secrets kclient.SecretsNamespacer, ref *servicebrokerapi.SecretReference, key string) ([]byte, error) {
+	if secrets == nil {
+		return nil, fmt.Errorf("no client to read secret %s/%s", ref.Namespace, ref.Name)
+	}
+	secret, err := secrets.Secrets(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data[key], nil
+}
+
+// transportFor returns a transport honouring the TLS settings of config.
+func transportFor(config *Config) (http.RoundTripper, error) {
+	if len(config.CAData) == 0 && len(config.CertData) == 0 && !config.Insecure {
+		return http.DefaultTransport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		// Can't use SSLv3 because of POODLE and BEAST
+		MinVersion:         tls.VersionTLS10,
+		InsecureSkipVerify: config.Insecure,
+	}
+
+	if len(config.CAData) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CAData) {
+			return nil, fmt.Errorf("no certificates found in the servicebroker CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(config.CertData) > 0 || len(config.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(config.CertData, config.KeyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid servicebroker client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return util.SetTransportDefaults(&http.Transport{TLSClientConfig: tlsConfig}), nil
+}
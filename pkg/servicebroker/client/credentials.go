@@ -0,0 +1,81 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+var invalidSecretNameChars = regexp.MustCompile("[^a-z0-9.-]+")
+
+// AuthSecretName returns the name of the Secret the inline credentials of the broker name are moved to.
+func AuthSecretName(name string) string {
+	return "servicebroker-" + strings.Trim(invalidSecretNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-.")
+}
+
+// HasInlineCredentials returns true when spec carries its credentials instead of referring to a Secret.
+func HasInlineCredentials(spec *servicebrokerapi.ServiceBrokerSpec) bool {
+	return spec.AuthSecretRef == nil && (len(spec.UserName) > 0 || len(spec.Password) > 0)
+}
+
+// MoveCredentials stores the inline credentials of spec in the Secret
+// AuthSecretName(name) in namespace and makes spec refer to it. An existing
+// Secret is only updated when it is labeled for the broker name, so that a
+// broker can never take over someone else's Secret. Callers that fail to save
+// spec call undo, which deletes a created Secret or restores the previous
+// credentials of an updated one.
+func MoveCredentials(secrets kclient.SecretsNamespacer, namespace, name string, spec *servicebrokerapi.ServiceBrokerSpec) (undo func() error, err error) {
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      AuthSecretName(name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				servicebrokerapi.ServiceBrokerLabel: name,
+			},
+		},
+		Type: kapi.SecretTypeOpaque,
+		Data: map[string][]byte{
+			servicebrokerapi.SecretUsernameKey: []byte(spec.UserName),
+			servicebrokerapi.SecretPasswordKey: []byte(spec.Password),
+		},
+	}
+
+	client := secrets.Secrets(namespace)
+	existing, err := client.Get(secret.Name)
+	switch {
+	case err == nil:
+		if existing.Labels[servicebrokerapi.ServiceBrokerLabel] != name {
+			return nil, fmt.Errorf("secret %s/%s already exists and does not belong to servicebroker %s", namespace, secret.Name, name)
+		}
+		previous := existing.Data
+		existing.Data = secret.Data
+		updated, err := client.Update(existing)
+		if err != nil {
+			return nil, err
+		}
+		undo = func() error {
+			updated.Data = previous
+			_, err := client.Update(updated)
+			return err
+		}
+	case errors.IsNotFound(err):
+		if _, err := client.Create(secret); err != nil {
+			return nil, err
+		}
+		undo = func() error {
+			return client.Delete(secret.Name)
+		}
+	default:
+		return nil, err
+	}
+
+	spec.AuthSecretRef = &servicebrokerapi.SecretReference{Namespace: namespace, Name: secret.Name}
+	spec.UserName = ""
+	spec.Password = ""
+	return undo, nil
+}
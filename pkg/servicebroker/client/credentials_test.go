@@ -0,0 +1,88 @@
+package client
+
+import (
+	"testing"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestMoveCredentials(t *testing.T) {
+	secret := func(owner string) *kapi.Secret {
+		return &kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      "servicebroker-mysql",
+				Namespace: "openshift",
+				Labels:    map[string]string{servicebrokerapi.ServiceBrokerLabel: owner},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		existing []runtime.Object
+		action   string
+		undo     string
+		err      bool
+	}{
+		{name: "no secret", action: "create", undo: "delete"},
+		{name: "secret of the broker", existing: []runtime.Object{secret("mysql")}, action: "update", undo: "update"},
+		{name: "secret of another broker", existing: []runtime.Object{secret("other")}, err: true},
+		{name: "unlabeled secret", existing: []runtime.Object{secret("")}, err: true},
+	}
+
+	for _, test := range tests {
+		client := testclient.NewSimpleFake(test.existing...)
+		client.PrependReactor("create", "secrets", func(action testclient.Action) (bool, runtime.Object, error) {
+			return true, action.(testclient.CreateAction).GetObject(), nil
+		})
+		client.PrependReactor("delete", "secrets", func(action testclient.Action) (bool, runtime.Object, error) {
+			return true, nil, nil
+		})
+		spec := &servicebrokerapi.ServiceBrokerSpec{UserName: "admin", Password: "secret"}
+
+		undo, err := MoveCredentials(client, "openshift", "mysql", spec)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			if spec.AuthSecretRef != nil || spec.Password != "secret" {
+				t.Errorf("%s: spec changed on error: %#v", test.name, spec)
+			}
+			for _, action := range client.Actions() {
+				if action.GetVerb() != "get" {
+					t.Errorf("%s: unexpected %s of the secret", test.name, action.GetVerb())
+				}
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if ref := spec.AuthSecretRef; ref == nil || ref.Namespace != "openshift" || ref.Name != "servicebroker-mysql" {
+			t.Errorf("%s: unexpected secret reference %#v", test.name, ref)
+		}
+		if len(spec.UserName) > 0 || len(spec.Password) > 0 {
+			t.Errorf("%s: credentials left in the spec", test.name)
+		}
+		actions := client.Actions()
+		if len(actions) != 2 || actions[1].GetVerb() != test.action {
+			t.Errorf("%s: expected get and %s, got %#v", test.name, test.action, actions)
+		}
+
+		if err := undo(); err != nil {
+			t.Errorf("%s: unexpected undo error: %v", test.name, err)
+		}
+		actions = client.Actions()
+		if len(actions) != 3 || actions[2].GetVerb() != test.undo {
+			t.Errorf("%s: expected undo to %s the secret, got %#v", test.name, test.undo, actions)
+			continue
+		}
+		if update, ok := actions[2].(testclient.UpdateAction); ok && update.GetObject().(*kapi.Secret).Data != nil {
+			t.Errorf("%s: expected the previous credentials back, got %v", test.name, update.GetObject())
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// HTTPStatusCodeError is returned when a broker answers with an unexpected status code.
+type HTTPStatusCodeError struct {
+	// StatusCode is the status code the broker answered with.
+	StatusCode int
+	// ErrorMessage is the machine readable "error" field of the broker response, if any.
+	ErrorMessage string
+	// Description is the human readable "description" field of the broker response, if any.
+	Description string
+}
+
+func (e *HTTPStatusCodeError) Error() string {
+	switch {
+	case e.Description != "" && e.ErrorMessage != "":
+		return fmt.Sprintf("servicebroker returned %d (%s): %s", e.StatusCode, e.ErrorMessage, e.Description)
+	case e.Description != "":
+		return fmt.Sprintf("servicebroker returned %d: %s", e.StatusCode, e.Description)
+	case e.ErrorMessage != "":
+		return fmt.Sprintf("servicebroker returned %d (%s)", e.StatusCode, e.ErrorMessage)
+	}
+	return fmt.Sprintf("servicebroker returned %d", e.StatusCode)
+}
+
+// RequestError is returned when a request got no answer from a broker, or an answer
+// which couldn't be read. The broker may or may not have carried the request out.
+type RequestError struct {
+	Method string
+	URL    string
+	// StatusCode is the status code the broker answered with, zero if it didn't answer.
+	StatusCode int
+	Err        error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Method, e.URL, e.Err)
+}
+
+func statusCode(err error) int {
+	if e, ok := err.(*HTTPStatusCodeError); ok {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// IsGone returns true if the broker answered 410 Gone.
+func IsGone(err error) bool {
+	return statusCode(err) == http.StatusGone
+}
+
+// IsConflict returns true if the broker answered 409 Conflict.
+func IsConflict(err error) bool {
+	return statusCode(err) == http.StatusConflict
+}
+
+// IsAsyncRequired returns true if the broker refused a synchronous request.
+func IsAsyncRequired(err error) bool {
+	e, ok := err.(*HTTPStatusCodeError)
+	return ok && e.StatusCode == 422 && e.ErrorMessage == "AsyncRequired"
+}
+
+// IsConcurrencyError returns true if the broker refused a request because another
+// operation on the same instance is in progress.
+func IsConcurrencyError(err error) bool {
+	e, ok := err.(*HTTPStatusCodeError)
+	return ok && e.StatusCode == 422 && e.ErrorMessage == "ConcurrencyError"
+}
+
+// IsRejected returns true if the broker definitely refused a request, answering with a
+// 4xx status code. A request refused for a concurrent operation is not rejected, it
+// may be sent again once the operation completes.
+func IsRejected(err error) bool {
+	code := statusCode(err)
+	return code >= 400 && code < 500 && !IsConcurrencyError(err)
+}
+
+// IsAmbiguous returns true if a request failed in a way which leaves it unknown whether
+// the broker carried it out: the request timed out or got no answer, the broker
+// answered with a 5xx status code, or with a success it couldn't describe. Such
+// provision and bind requests call for orphan mitigation. A 200 OK which can't be read
+// is not ambiguous, the resource existed before the request.
+func IsAmbiguous(err error) bool {
+	if e, ok := err.(*RequestError); ok {
+		return e.StatusCode != http.StatusOK
+	}
+	return IsServerError(err)
+}
+
+// IsServerError returns true if the broker answered with a 5xx status code.
+func IsServerError(err error) bool {
+	code := statusCode(err)
+	return code >= 500 && code < 600
+}
@@ -0,0 +1,268 @@
+// Package fakebroker is an in-process Service Broker API v2 implementation for tests.
+package fakebroker
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+// Broker is a fake broker serving its catalog and keeping instances and bindings in memory.
+type Broker struct {
+	// Catalog is served on GET /v2/catalog.
+	Catalog client.CatalogResponse
+	// Username and Password are required with basic auth if set.
+	Username string
+	Password string
+	// Async makes provision, update and deprovision answer 202 Accepted if the
+	// client accepts incomplete operations. The operation is reported in progress
+	// until Complete is called.
+	Async bool
+	// Errors maps "METHOD /path" to a status code and error answered instead.
+	Errors map[string]Error
+
+	lock       sync.Mutex
+	server     *httptest.Server
+	Instances  map[string]*Instance
+	Bindings   map[string]*client.BindRequest
+	Operations map[string]string
+	// Requests records "METHOD /path" of every request served.
+	Requests []string
+}
+
+// Instance is an instance provisioned by the fake broker.
+type Instance struct {
+	ServiceID  string
+	PlanID     string
+	Parameters map[string]interface{}
+	// Deprovisioning is true while an asynchronous deprovision is in progress.
+	Deprovisioning bool
+}
+
+// Error is an error answered by the fake broker.
+type Error struct {
+	StatusCode int
+	client.ErrorResponse
+}
+
+// Operation is the operation token the fake broker hands out with asynchronous operations.
+const Operation = "fake-operation"
+
+// New returns a fake broker serving catalog.
+func New(catalog client.CatalogResponse) *Broker {
+	return &Broker{
+		Catalog:    catalog,
+		Errors:     map[string]Error{},
+		Instances:  map[string]*Instance{},
+		Bindings:   map[string]*client.BindRequest{},
+		Operations: map[string]string{},
+	}
+}
+
+// Start starts serving the broker on a local port, and returns its url.
+func (b *Broker) Start() string {
+	b.server = httptest.NewServer(b)
+	return b.server.URL
+}
+
+// StartTLS starts serving the broker over https on a local port, and returns its
+// url and the PEM encoded certificate it serves.
+func (b *Broker) StartTLS() (string, []byte) {
+	b.server = httptest.NewTLSServer(b)
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b.server.TLS.Certificates[0].Certificate[0]})
+	return b.server.URL, cert
+}
+
+// URL returns the url the broker is served on.
+func (b *Broker) URL() string {
+	return b.server.URL
+}
+
+// Close stops serving the broker.
+func (b *Broker) Close() {
+	if b.server != nil {
+		b.server.Close()
+	}
+}
+
+// Complete finishes the in progress operation on an instance with state.
+func (b *Broker) Complete(instanceID, state string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if instance, ok := b.Instances[instanceID]; ok && instance.Deprovisioning && state == client.StateSucceeded {
+		delete(b.Instances, instanceID)
+	}
+	b.Operations[instanceID] = state
+}
+
+func (b *Broker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	key := r.Method + " " + r.URL.Path
+	b.Requests = append(b.Requests, key)
+
+	if b.Username != "" || b.Password != "" {
+		if username, password, ok := r.BasicAuth(); !ok || username != b.Username || password != b.Password {
+			reply(w, http.StatusUnauthorized, struct{}{})
+			return
+		}
+	}
+
+	if e, ok := b.Errors[key]; ok {
+		reply(w, e.StatusCode, e.ErrorResponse)
+		return
+	}
+
+	// ids are path escaped and may contain slashes.
+	parts := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	for i := range parts {
+		parts[i], _ = url.PathUnescape(parts[i])
+	}
+	switch {
+	case len(parts) == 2 && parts[1] == "catalog" && r.Method == "GET":
+		reply(w, http.StatusOK, b.Catalog)
+	case len(parts) == 3 && parts[1] == "service_instances":
+		b.serveInstance(w, r, parts[2])
+	case len(parts) == 4 && parts[1] == "service_instances" && parts[3] == "last_operation" && r.Method == "GET":
+		b.serveLastOperation(w, r, parts[2])
+	case len(parts) == 5 && parts[1] == "service_instances" && parts[3] == "service_bindings":
+		b.serveBinding(w, r, parts[2], parts[4])
+	default:
+		reply(w, http.StatusNotFound, struct{}{})
+	}
+}
+
+func (b *Broker) async(r *http.Request) bool {
+	return b.Async && r.URL.Query().Get("accepts_incomplete") == "true"
+}
+
+func (b *Broker) serveInstance(w http.ResponseWriter, r *http.Request, id string) {
+	instance, exists := b.Instances[id]
+
+	switch r.Method {
+	case "PUT":
+		req := &client.ProvisionRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			reply(w, http.StatusBadRequest, client.ErrorResponse{Description: err.Error()})
+			return
+		}
+		if exists {
+			if instance.ServiceID == req.ServiceID && instance.PlanID == req.PlanID {
+				reply(w, http.StatusOK, struct{}{})
+			} else {
+				reply(w, http.StatusConflict, struct{}{})
+			}
+			return
+		}
+		b.Instances[id] = &Instance{ServiceID: req.ServiceID, PlanID: req.PlanID, Parameters: req.Parameters}
+		if b.async(r) {
+			b.Operations[id] = client.StateInProgress
+			reply(w, http.StatusAccepted, client.ProvisionResponse{Operation: Operation})
+			return
+		}
+		reply(w, http.StatusCreated, client.ProvisionResponse{DashboardURL: "http://dashboard/" + id})
+	case "PATCH":
+		req := &client.UpdateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			reply(w, http.StatusBadRequest, client.ErrorResponse{Description: err.Error()})
+			return
+		}
+		if !exists {
+			reply(w, http.StatusNotFound, struct{}{})
+			return
+		}
+		if req.PlanID != "" {
+			instance.PlanID = req.PlanID
+		}
+		if b.async(r) {
+			b.Operations[id] = client.StateInProgress
+			reply(w, http.StatusAccepted, client.UpdateResponse{Operation: Operation})
+			return
+		}
+		reply(w, http.StatusOK, struct{}{})
+	case "DELETE":
+		if !exists {
+			reply(w, http.StatusGone, struct{}{})
+			return
+		}
+		if b.async(r) {
+			instance.Deprovisioning = true
+			b.Operations[id] = client.StateInProgress
+			reply(w, http.StatusAccepted, client.DeprovisionResponse{Operation: Operation})
+			return
+		}
+		delete(b.Instances, id)
+		reply(w, http.StatusOK, struct{}{})
+	default:
+		reply(w, http.StatusMethodNotAllowed, struct{}{})
+	}
+}
+
+func (b *Broker) serveLastOperation(w http.ResponseWriter, r *http.Request, id string) {
+	state, ok := b.Operations[id]
+	if !ok {
+		if _, exists := b.Instances[id]; !exists {
+			reply(w, http.StatusGone, struct{}{})
+			return
+		}
+		state = client.StateSucceeded
+	}
+	if _, exists := b.Instances[id]; !exists && state == client.StateSucceeded {
+		reply(w, http.StatusGone, struct{}{})
+		return
+	}
+	reply(w, http.StatusOK, client.LastOperationResponse{State: state})
+}
+
+func (b *Broker) serveBinding(w http.ResponseWriter, r *http.Request, instanceID, bindingID string) {
+	if _, exists := b.Instances[instanceID]; !exists {
+		reply(w, http.StatusNotFound, struct{}{})
+		return
+	}
+
+	switch r.Method {
+	case "PUT":
+		req := &client.BindRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			reply(w, http.StatusBadRequest, client.ErrorResponse{Description: err.Error()})
+			return
+		}
+		b.Bindings[bindingID] = req
+		reply(w, http.StatusCreated, client.BindResponse{
+			Credentials: map[string]interface{}{
+				"uri":      "fake://" + bindingID + "@" + instanceID,
+				"name":     instanceID,
+				"username": bindingID,
+				"password": "password",
+				"host":     "fake.host",
+				"port":     1234,
+				"tls": map[string]interface{}{
+					"ca": "fake-ca",
+				},
+			},
+		})
+	case "DELETE":
+		if _, exists := b.Bindings[bindingID]; !exists {
+			reply(w, http.StatusGone, struct{}{})
+			return
+		}
+		delete(b.Bindings, bindingID)
+		reply(w, http.StatusOK, struct{}{})
+	default:
+		reply(w, http.StatusMethodNotAllowed, struct{}{})
+	}
+}
+
+func reply(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
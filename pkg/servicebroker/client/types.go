@@ -0,0 +1,196 @@
+package client
+
+import "encoding/json"
+
+// CatalogResponse is the body returned by GET /v2/catalog.
+type CatalogResponse struct {
+	Services []Service `json:"services"`
+}
+
+// Service is a service offered by a broker.
+type Service struct {
+	Name            string                 `json:"name"`
+	ID              string                 `json:"id"`
+	Description     string                 `json:"description"`
+	Tags            []string               `json:"tags,omitempty"`
+	Requires        []string               `json:"requires,omitempty"`
+	Bindable        bool                   `json:"bindable"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	DashboardClient *DashboardClient       `json:"dashboard_client,omitempty"`
+	PlanUpdateable  bool                   `json:"plan_updateable,omitempty"`
+	Plans           []Plan                 `json:"plans"`
+}
+
+// DashboardClient holds the OAuth client the broker wants for its service dashboards.
+type DashboardClient struct {
+	ID          string `json:"id"`
+	Secret      string `json:"secret"`
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// Plan is a plan of a Service.
+type Plan struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Metadata    *PlanMetadata `json:"metadata,omitempty"`
+	// Free defaults to true when the broker leaves it out.
+	Free    *bool        `json:"free,omitempty"`
+	Schemas *PlanSchemas `json:"schemas,omitempty"`
+}
+
+// PlanSchemas are the JSON schemas of the parameters a plan accepts.
+type PlanSchemas struct {
+	ServiceInstance *ServiceInstanceSchema `json:"service_instance,omitempty"`
+	ServiceBinding  *ServiceBindingSchema  `json:"service_binding,omitempty"`
+}
+
+// ServiceInstanceSchema holds the schemas of the parameters of provision and update requests.
+type ServiceInstanceSchema struct {
+	Create *InputParameters `json:"create,omitempty"`
+	Update *InputParameters `json:"update,omitempty"`
+}
+
+// ServiceBindingSchema holds the schema of the parameters of bind requests.
+type ServiceBindingSchema struct {
+	Create *InputParameters `json:"create,omitempty"`
+}
+
+// InputParameters holds a JSON schema.
+type InputParameters struct {
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// PlanMetadata holds the plan metadata fields the platform understands.
+type PlanMetadata struct {
+	Bullets     []string   `json:"bullets,omitempty"`
+	Costs       []PlanCost `json:"costs,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+}
+
+// PlanCost is the cost of a plan per unit.
+type PlanCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+// ProvisionRequest is the body of PUT /v2/service_instances/:instance_id.
+type ProvisionRequest struct {
+	ServiceID        string                 `json:"service_id"`
+	PlanID           string                 `json:"plan_id"`
+	OrganizationGUID string                 `json:"organization_guid"`
+	SpaceGUID        string                 `json:"space_guid"`
+	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+
+	// AcceptsIncomplete is sent as a query parameter, brokers may then provision asynchronously.
+	AcceptsIncomplete bool `json:"-"`
+}
+
+// ProvisionResponse is returned by a successful provision.
+type ProvisionResponse struct {
+	DashboardURL string `json:"dashboard_url,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+	// LastOperation is returned inline by brokers implementing the older asynchronous api.
+	LastOperation *LastOperationResponse `json:"last_operation,omitempty"`
+
+	// Async is true if the broker answered 202 Accepted.
+	Async bool `json:"-"`
+	// Exists is true if the broker answered 200 OK, the instance was already
+	// provisioned the same way.
+	Exists bool `json:"-"`
+}
+
+// UpdateRequest is the body of PATCH /v2/service_instances/:instance_id.
+type UpdateRequest struct {
+	ServiceID      string                 `json:"service_id"`
+	PlanID         string                 `json:"plan_id,omitempty"`
+	Parameters     map[string]interface{} `json:"parameters,omitempty"`
+	PreviousValues *PreviousValues        `json:"previous_values,omitempty"`
+
+	AcceptsIncomplete bool `json:"-"`
+}
+
+// PreviousValues describes an instance before an update.
+type PreviousValues struct {
+	ServiceID      string `json:"service_id"`
+	PlanID         string `json:"plan_id"`
+	OrganizationID string `json:"organization_id"`
+	SpaceID        string `json:"space_id"`
+}
+
+// UpdateResponse is returned by a successful update.
+type UpdateResponse struct {
+	Operation string `json:"operation,omitempty"`
+
+	Async bool `json:"-"`
+}
+
+// DeprovisionRequest holds the query parameters of DELETE /v2/service_instances/:instance_id.
+type DeprovisionRequest struct {
+	ServiceID         string
+	PlanID            string
+	AcceptsIncomplete bool
+}
+
+// DeprovisionResponse is returned by a successful deprovision.
+type DeprovisionResponse struct {
+	Operation string `json:"operation,omitempty"`
+
+	Async bool `json:"-"`
+}
+
+// BindRequest is the body of PUT /v2/service_instances/:instance_id/service_bindings/:binding_id.
+type BindRequest struct {
+	ServiceID    string                 `json:"service_id"`
+	PlanID       string                 `json:"plan_id"`
+	AppGUID      string                 `json:"app_guid,omitempty"`
+	BindResource map[string]string      `json:"bind_resource,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// BindResponse is returned by a successful bind.
+type BindResponse struct {
+	// Credentials is free-form, values may be strings, numbers or nested objects.
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainURL  string                 `json:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `json:"route_service_url,omitempty"`
+
+	// Exists is true if the broker answered 200 OK, the binding was already made
+	// the same way.
+	Exists bool `json:"-"`
+}
+
+// UnbindRequest holds the query parameters of DELETE /v2/service_instances/:instance_id/service_bindings/:binding_id.
+type UnbindRequest struct {
+	ServiceID string
+	PlanID    string
+}
+
+// LastOperationRequest holds the query parameters of GET /v2/service_instances/:instance_id/last_operation.
+type LastOperationRequest struct {
+	ServiceID string
+	PlanID    string
+	// Operation is the token the broker returned when accepting the operation.
+	Operation string
+}
+
+// LastOperationResponse is the state of an asynchronous operation.
+type LastOperationResponse struct {
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+	// AsyncPollIntervalSeconds is suggested either in the body or with a Retry-After header.
+	AsyncPollIntervalSeconds int `json:"async_poll_interval_seconds,omitempty"`
+}
+
+// LastOperation states.
+const (
+	StateInProgress = "in progress"
+	StateSucceeded  = "succeeded"
+	StateFailed     = "failed"
+)
+
+// ErrorResponse is the body brokers return with an error status code.
+type ErrorResponse struct {
+	Error       string `json:"error,omitempty"`
+	Description string `json:"description,omitempty"`
+}
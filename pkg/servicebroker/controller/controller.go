@@ -1,16 +1,17 @@
 package controller
 
 import (
+	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
-	"fmt"
+	"github.com/golang/glog"
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
 	osclient "github.com/openshift/origin/pkg/client"
 	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
 	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	"golang.org/x/net/context"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
-	"strconv"
 	"time"
 )
 
@@ -21,11 +22,16 @@ type ServiceBrokerController struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
-	//ServiceBrokerClient is a ServiceBroker client
-	ServiceBrokerClient servicebrokerclient.Interface
+	// ServiceBrokerClientFunc returns a client of a ServiceBroker.
+	ServiceBrokerClientFunc servicebrokerclient.ClientFunc
+	recorder                record.EventRecorder
+
+	// nextProbe records when each broker should be probed next.
+	nextProbe map[string]time.Time
 }
 
-const BSNS = "openshift"
+// BSNS is the namespace the BackingServices of cluster brokers are kept in.
+const BSNS = backingserviceapi.BackingServiceSharedNamespace
 
 type fatalError string
 
@@ -33,169 +39,107 @@ func (e fatalError) Error() string {
 	return "fatal error handling ServiceBrokerController: " + string(e)
 }
 
-// Handle processes a namespace and deletes content in origin if its terminating
+// Handle probes a broker when it is due, and syncs the BackingServices of its catalog.
 func (c *ServiceBrokerController) Handle(sb *servicebrokerapi.ServiceBroker) (err error) {
 
 	if sb.Spec.Url == "" {
 		return nil
 	}
 
-	switch sb.Status.Phase {
-	case servicebrokerapi.ServiceBrokerNew:
-		if getRetryTime(sb) <= 3 {
-			if Ping(sb, 10) {
-				setRetryTime(sb)
-
-				services, err := c.ServiceBrokerClient.Catalog(sb.Spec.Url, sb.Spec.UserName, sb.Spec.Password)
-				if err != nil {
-					c.Client.ServiceBrokers().Update(sb)
-					return err
-				}
-
-				errs := []error{}
-				for _, v := range services.Services {
-
-					if err := backingServiceHandler(c.Client, newBackingService(sb.Name, v)); err != nil {
-						errs = append(errs, err)
-					}
-				}
-				if len(errs) == 0 {
-					removeRetryTime(sb)
-					sb.Status.Phase = servicebrokerapi.ServiceBrokerActive
-				}
-
-				c.Client.ServiceBrokers().Update(sb)
-				return nil
-			}
-		} else {
-			sb.Status.Phase = servicebrokerapi.ServiceBrokerFailed
-			c.Client.ServiceBrokers().Update(sb)
+	if hasInlineCredentials(sb) && sb.Status.Phase != servicebrokerapi.ServiceBrokerDeleting {
+		// the update brings sb back with its credentials in a secret.
+		return c.migrateCredentials(sb)
+	}
 
-			c.inActiveBackingService(sb.Name)
-			return nil
+	if sb.Status.Phase == servicebrokerapi.ServiceBrokerDeleting {
+		delete(c.nextProbe, probeKey(sb))
+		c.inActiveBackingService(sb)
+		if err := c.deleteDashboardClients(sb); err != nil {
+			return err
 		}
+		c.deleteBroker(sb)
+		return nil
+	}
 
-	case servicebrokerapi.ServiceBrokerDeleting:
-		c.inActiveBackingService(sb.Name)
-		c.Client.ServiceBrokers().Delete(sb.Name)
+	if next, ok := c.nextProbe[probeKey(sb)]; ok && time.Now().Before(next) {
 		return nil
-	case servicebrokerapi.ServiceBrokerActive:
-		if Ping(sb, 60) {
-			_, err := c.ServiceBrokerClient.Catalog(sb.Spec.Url, sb.Spec.UserName, sb.Spec.Password)
-			if err != nil {
-				sb.Status.Phase = servicebrokerapi.ServiceBrokerFailed
-				c.Client.ServiceBrokers().Update(sb)
-
-				c.inActiveBackingService(sb.Name)
-				return err
-			}
+	}
 
-			c.Client.ServiceBrokers().Update(sb)
-			return nil
+	old := copyStatus(sb.Status)
+	err = c.probe(sb)
+	if updateErr := c.updateStatus(sb, old); updateErr != nil {
+		glog.Errorf("failed to update the status of servicebroker %s: %v", sb.Name, updateErr)
+		if err == nil {
+			err = updateErr
 		}
-	case servicebrokerapi.ServiceBrokerFailed:
-		if Ping(sb, 60) {
-			_, err := c.ServiceBrokerClient.Catalog(sb.Spec.Url, sb.Spec.UserName, sb.Spec.Password)
-			if err != nil {
-				c.Client.ServiceBrokers().Update(sb)
-				return err
-			}
+	}
+	return err
+}
+
+// catalog fetches the catalog of sb, giving up after timeout.
+func (c *ServiceBrokerController) catalog(sb *servicebrokerapi.ServiceBroker, timeout time.Duration) (*servicebrokerclient.CatalogResponse, error) {
+	client, err := c.ServiceBrokerClientFunc(sb)
+	if err != nil {
+		return nil, err
+	}
 
-			sb.Status.Phase = servicebrokerapi.ServiceBrokerActive
-			c.Client.ServiceBrokers().Update(sb)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-			c.ActiveBackingService(sb.Name)
-			return nil
-		}
+	return client.Catalog(ctx)
+}
+
+// updateBroker writes sb back as the kind it was read as.
+func (c *ServiceBrokerController) updateBroker(sb *servicebrokerapi.ServiceBroker) error {
+	if len(sb.Namespace) > 0 {
+		_, err := c.Client.ProjectServiceBrokers(sb.Namespace).Update(servicebrokerapi.ProjectServiceBrokerOf(sb))
+		return err
+	}
+	_, err := c.Client.ServiceBrokers().Update(sb)
+	return err
+}
 
+func (c *ServiceBrokerController) deleteBroker(sb *servicebrokerapi.ServiceBroker) error {
+	if len(sb.Namespace) > 0 {
+		return c.Client.ProjectServiceBrokers(sb.Namespace).Delete(sb.Name)
 	}
+	return c.Client.ServiceBrokers().Delete(sb.Name)
+}
 
-	return nil
+// catalogNamespace returns the namespace the BackingServices of sb are kept in: the
+// namespace of a project broker, the shared one for cluster brokers.
+func catalogNamespace(sb *servicebrokerapi.ServiceBroker) string {
+	if len(sb.Namespace) > 0 {
+		return sb.Namespace
+	}
+	return BSNS
 }
 
-func (c *ServiceBrokerController) inActiveBackingService(serviceBrokerName string) {
-	selector, _ := labels.Parse(servicebrokerapi.ServiceBrokerLabel + "=" + serviceBrokerName)
+func (c *ServiceBrokerController) inActiveBackingService(sb *servicebrokerapi.ServiceBroker) {
+	selector, _ := labels.Parse(servicebrokerapi.ServiceBrokerLabel + "=" + sb.Name)
 
-	bsList, err := c.Client.BackingServices(BSNS).List(selector, fields.Everything())
+	bsList, err := c.Client.BackingServices(catalogNamespace(sb)).List(selector, fields.Everything())
 	if err == nil {
 		for _, bsvc := range bsList.Items {
-			if bsvc.Status.Phase != backingserviceapi.BackingServicePhaseInactive {
+			if bsvc.Status.Phase == backingserviceapi.BackingServicePhaseActive {
 				bsvc.Status.Phase = backingserviceapi.BackingServicePhaseInactive
-				c.Client.BackingServices(BSNS).Update(&bsvc)
+				c.Client.BackingServices(bsvc.Namespace).Update(&bsvc)
 			}
 		}
 	}
 }
 
-func (c *ServiceBrokerController) ActiveBackingService(serviceBrokerName string) {
-	selector, _ := labels.Parse(servicebrokerapi.ServiceBrokerLabel + "=" + serviceBrokerName)
+func (c *ServiceBrokerController) ActiveBackingService(sb *servicebrokerapi.ServiceBroker) {
+	selector, _ := labels.Parse(servicebrokerapi.ServiceBrokerLabel + "=" + sb.Name)
 
-	bsList, err := c.Client.BackingServices(BSNS).List(selector, fields.Everything())
+	bsList, err := c.Client.BackingServices(catalogNamespace(sb)).List(selector, fields.Everything())
 	if err == nil {
 		for _, bsvc := range bsList.Items {
-			if bsvc.Status.Phase != backingserviceapi.BackingServicePhaseActive {
+			// deprecated services stay so until the catalog offers them again.
+			if bsvc.Status.Phase == backingserviceapi.BackingServicePhaseInactive {
 				bsvc.Status.Phase = backingserviceapi.BackingServicePhaseActive
-				c.Client.BackingServices(BSNS).Update(&bsvc)
+				c.Client.BackingServices(bsvc.Namespace).Update(&bsvc)
 			}
 		}
 	}
 }
-
-func Ping(sb *servicebrokerapi.ServiceBroker, pingSecond int64) bool {
-	if sb.Annotations == nil {
-		sb.Annotations = map[string]string{}
-	}
-
-	lastTimeStr := sb.Annotations[servicebrokerapi.ServiceBrokerLastPingTime]
-	if len(lastTimeStr) == 0 {
-		sb.Annotations[servicebrokerapi.ServiceBrokerLastPingTime] = fmt.Sprintf("%d", time.Now().UnixNano())
-		return true
-	}
-
-	lastPing, err := strconv.Atoi(lastTimeStr)
-	if err != nil {
-		sb.Annotations[servicebrokerapi.ServiceBrokerLastPingTime] = fmt.Sprintf("%d", time.Now().UnixNano())
-		return false
-	}
-
-	if (time.Now().UnixNano()-int64(lastPing))/1e9 < pingSecond {
-		return false
-	}
-
-	sb.Annotations[servicebrokerapi.ServiceBrokerLastPingTime] = fmt.Sprintf("%d", time.Now().UnixNano())
-	return true
-}
-
-func getRetryTime(sb *servicebrokerapi.ServiceBroker) int {
-	retries := sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes]
-	if len(retries) == 0 {
-		return 0
-	}
-
-	i, err := strconv.Atoi(retries)
-	if err != nil {
-		return 0
-	}
-
-	return i
-}
-
-func setRetryTime(sb *servicebrokerapi.ServiceBroker) {
-	if len(sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes]) == 0 {
-		sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes] = fmt.Sprintf("%d", 0)
-	}
-
-	i, err := strconv.Atoi(sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes])
-	if err != nil {
-		sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes] = fmt.Sprintf("%d", 1)
-		return
-	}
-
-	sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes] = fmt.Sprintf("%d", i+1)
-
-	return
-}
-
-func removeRetryTime(sb *servicebrokerapi.ServiceBroker) {
-	delete(sb.Annotations, servicebrokerapi.ServiceBrokerNewRetryTimes)
-}
@@ -1,16 +1,18 @@
 package controller
 
 import (
-	"github.com/golang/glog"
+	"encoding/json"
+
 	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
-	osclient "github.com/openshift/origin/pkg/client"
 	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
-	"k8s.io/kubernetes/pkg/api/errors"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
 )
 
-func newBackingService(name string, spec backingserviceapi.BackingServiceSpec) *backingserviceapi.BackingService {
+func newBackingService(name string, service servicebrokerclient.Service) *backingserviceapi.BackingService {
+	spec := backingServiceSpec(service)
+
 	bs := new(backingserviceapi.BackingService)
-	bs.Spec = backingserviceapi.BackingServiceSpec(spec)
+	bs.Spec = spec
 	bs.Annotations = make(map[string]string)
 	bs.Name = spec.Name
 	bs.GenerateName = name
@@ -21,21 +23,74 @@ func newBackingService(name string, spec backingserviceapi.BackingServiceSpec) *
 	return bs
 }
 
-func backingServiceHandler(client osclient.Interface, backingService *backingserviceapi.BackingService) error {
-	_, err := client.BackingServices(BSNS).Get(backingService.Name)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			if _, err := client.BackingServices(BSNS).Create(backingService); err != nil {
-				glog.Errorln("servicebroker create backingservice err ", err)
-				return err
+// backingServiceSpec converts a service of a broker catalog into a BackingServiceSpec.
+// Metadata values which are not strings are kept json encoded.
+func backingServiceSpec(service servicebrokerclient.Service) backingserviceapi.BackingServiceSpec {
+	spec := backingserviceapi.BackingServiceSpec{
+		Name:           service.Name,
+		Id:             service.ID,
+		Description:    service.Description,
+		Bindable:       service.Bindable,
+		PlanUpdateable: service.PlanUpdateable,
+		Tags:           service.Tags,
+		Requires:       service.Requires,
+	}
+
+	if len(service.Metadata) > 0 {
+		spec.Metadata = make(map[string]string, len(service.Metadata))
+		for k, v := range service.Metadata {
+			if str, ok := v.(string); ok {
+				spec.Metadata[k] = str
+			} else if b, err := json.Marshal(v); err == nil {
+				spec.Metadata[k] = string(b)
 			}
 		}
-	} else {
-		if _, err := client.BackingServices(BSNS).Update(backingService); err != nil {
-			glog.Errorln("servicebroker update backingservice err ", err)
-			return err
+	}
+
+	if dc := service.DashboardClient; dc != nil {
+		spec.DashboardClient = map[string]string{
+			dashboardClientID:          dc.ID,
+			dashboardClientSecret:      dc.Secret,
+			dashboardClientRedirectURI: dc.RedirectURI,
 		}
 	}
 
-	return nil
+	for _, p := range service.Plans {
+		plan := backingserviceapi.ServicePlan{
+			Name:        p.Name,
+			Id:          p.ID,
+			Description: p.Description,
+			Free:        p.Free == nil || *p.Free,
+		}
+		if s := p.Schemas; s != nil {
+			if s.ServiceInstance != nil {
+				plan.Schemas.InstanceCreate = schemaOf(s.ServiceInstance.Create)
+				plan.Schemas.InstanceUpdate = schemaOf(s.ServiceInstance.Update)
+			}
+			if s.ServiceBinding != nil {
+				plan.Schemas.BindingCreate = schemaOf(s.ServiceBinding.Create)
+			}
+		}
+		if p.Metadata != nil {
+			plan.Metadata.Bullets = p.Metadata.Bullets
+			plan.Metadata.DisplayName = p.Metadata.DisplayName
+			for _, cost := range p.Metadata.Costs {
+				plan.Metadata.Costs = append(plan.Metadata.Costs, backingserviceapi.ServicePlanCost{
+					Amount: cost.Amount,
+					Unit:   cost.Unit,
+				})
+			}
+		}
+		spec.Plans = append(spec.Plans, plan)
+	}
+
+	return spec
+}
+
+// schemaOf returns the json encoded schema of input, empty if there is none.
+func schemaOf(input *servicebrokerclient.InputParameters) string {
+	if input == nil || len(input.Parameters) == 0 || string(input.Parameters) == "null" {
+		return ""
+	}
+	return string(input.Parameters)
 }
@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// usedPlans maps the namespace/name of the BackingServices instances are provisioned
+// from to the plans they use.
+type usedPlans map[string]map[string]bool
+
+// syncCatalog brings the BackingServices of sb in line with its catalog. Services and
+// plans the broker dropped are deprecated, and deleted once no instance uses them.
+func (c *ServiceBrokerController) syncCatalog(sb *servicebrokerapi.ServiceBroker, catalog *servicebrokerclient.CatalogResponse) error {
+	selector := labels.SelectorFromSet(labels.Set{servicebrokerapi.ServiceBrokerLabel: sb.Name})
+	namespace := catalogNamespace(sb)
+	bsList, err := c.Client.BackingServices(namespace).List(selector, fields.Everything())
+	if err != nil {
+		return err
+	}
+
+	used, err := c.usedPlans()
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	offered := map[string]bool{}
+	for _, service := range catalog.Services {
+		bs := newBackingService(sb.Name, service)
+		bs.Namespace = namespace
+		offered[bs.Name] = true
+		if err := c.syncBackingService(sb, bs, used[usedPlansKey(namespace, bs.Name)]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for i := range bsList.Items {
+		bs := &bsList.Items[i]
+		if offered[bs.Name] {
+			continue
+		}
+		if err := c.retireBackingService(bs, used[usedPlansKey(namespace, bs.Name)]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := c.syncDashboardClients(sb); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// usedPlans returns the plans used by the instances of all namespaces.
+func (c *ServiceBrokerController) usedPlans() (usedPlans, error) {
+	bsiList, err := c.Client.BackingServiceInstances(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	used := usedPlans{}
+	for i := range bsiList.Items {
+		bsi := &bsiList.Items[i]
+		key := usedPlansKey(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi), bsi.Spec.BackingServiceName)
+		plans := used[key]
+		if plans == nil {
+			plans = map[string]bool{}
+			used[key] = plans
+		}
+		plans[bsi.Spec.BackingServicePlanGuid] = true
+		if len(bsi.Status.AppliedPlanGuid) > 0 {
+			plans[bsi.Status.AppliedPlanGuid] = true
+		}
+	}
+	return used, nil
+}
+
+func usedPlansKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// syncBackingService creates the BackingService of a service in the catalog of sb,
+// or writes its spec back if the catalog changed.
+func (c *ServiceBrokerController) syncBackingService(sb *servicebrokerapi.ServiceBroker, bs *backingserviceapi.BackingService, used map[string]bool) error {
+	old, err := c.Client.BackingServices(bs.Namespace).Get(bs.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		created, err := c.Client.BackingServices(bs.Namespace).Create(bs)
+		if err != nil {
+			glog.Errorln("servicebroker create backingservice err ", err)
+			return err
+		}
+		c.recorder.Eventf(created, "Created", "service %s offered by broker %s", created.Name, sb.Name)
+		return nil
+	}
+
+	if owner := old.Labels[servicebrokerapi.ServiceBrokerLabel]; owner != sb.Name {
+		c.recorder.Eventf(old, "Conflict", "service %s of broker %s is also offered by broker %s", old.Name, owner, sb.Name)
+		return fmt.Errorf("backingservice %s belongs to broker %s", old.Name, owner)
+	}
+
+	plans, deprecated, removed := mergePlans(old.Spec.Plans, bs.Spec.Plans, used)
+	bs.Spec.Plans = plans
+
+	phase := old.Status.Phase
+	if phase == backingserviceapi.BackingServicePhaseDeprecated {
+		phase = backingserviceapi.BackingServicePhaseActive
+	}
+
+	if kapi.Semantic.DeepEqual(old.Spec, bs.Spec) && phase == old.Status.Phase {
+		return nil
+	}
+
+	reactivated := phase != old.Status.Phase
+	old.Spec = bs.Spec
+	old.Status.Phase = phase
+	updated, err := c.Client.BackingServices(old.Namespace).Update(old)
+	if err != nil {
+		glog.Errorln("servicebroker update backingservice err ", err)
+		return err
+	}
+
+	if reactivated {
+		c.recorder.Eventf(updated, "Reactivated", "service %s is offered by broker %s again", updated.Name, sb.Name)
+	}
+	for _, name := range deprecated {
+		c.recorder.Eventf(updated, "PlanDeprecated", "plan %s is no longer offered by broker %s but still in use", name, sb.Name)
+	}
+	for _, name := range removed {
+		c.recorder.Eventf(updated, "PlanRemoved", "plan %s is no longer offered by broker %s", name, sb.Name)
+	}
+	c.recorder.Eventf(updated, "Updated", "service %s updated from the catalog of broker %s", updated.Name, sb.Name)
+	return nil
+}
+
+// mergePlans returns the plans offered, plus the old plans no longer offered but
+// still used, marked deprecated. It also returns the names of the plans newly
+// deprecated and of the ones removed. Schemas the catalog leaves out are kept, an
+// administrator may have set them.
+func mergePlans(old, offered []backingserviceapi.ServicePlan, used map[string]bool) (plans []backingserviceapi.ServicePlan, deprecated, removed []string) {
+	oldPlans := make(map[string]*backingserviceapi.ServicePlan, len(old))
+	for i := range old {
+		oldPlans[old[i].Id] = &old[i]
+	}
+	ids := make(map[string]bool, len(offered))
+	for i := range offered {
+		ids[offered[i].Id] = true
+		if oldPlan, ok := oldPlans[offered[i].Id]; ok {
+			keepSchemas(&offered[i].Schemas, oldPlan.Schemas)
+		}
+	}
+	plans = offered
+
+	for _, plan := range old {
+		if ids[plan.Id] {
+			continue
+		}
+		if !used[plan.Id] {
+			removed = append(removed, plan.Name)
+			continue
+		}
+		if !plan.Deprecated {
+			plan.Deprecated = true
+			deprecated = append(deprecated, plan.Name)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, deprecated, removed
+}
+
+func keepSchemas(schemas *backingserviceapi.ServicePlanSchemas, old backingserviceapi.ServicePlanSchemas) {
+	if len(schemas.InstanceCreate) == 0 {
+		schemas.InstanceCreate = old.InstanceCreate
+	}
+	if len(schemas.InstanceUpdate) == 0 {
+		schemas.InstanceUpdate = old.InstanceUpdate
+	}
+	if len(schemas.BindingCreate) == 0 {
+		schemas.BindingCreate = old.BindingCreate
+	}
+}
+
+// retireBackingService deprecates a service dropped from the catalog of its broker,
+// or deletes it if no instance uses it.
+func (c *ServiceBrokerController) retireBackingService(bs *backingserviceapi.BackingService, used map[string]bool) error {
+	if len(used) == 0 {
+		if err := c.Client.BackingServices(bs.Namespace).Delete(bs.Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		c.recorder.Eventf(bs, "Deleted", "service %s is no longer offered and not in use", bs.Name)
+		return nil
+	}
+
+	if bs.Status.Phase == backingserviceapi.BackingServicePhaseDeprecated {
+		return nil
+	}
+
+	bs.Status.Phase = backingserviceapi.BackingServicePhaseDeprecated
+	if _, err := c.Client.BackingServices(bs.Namespace).Update(bs); err != nil {
+		return err
+	}
+	c.recorder.Eventf(bs, "Deprecated", "service %s is no longer offered but still in use", bs.Name)
+	return nil
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"github.com/golang/glog"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+// hasInlineCredentials returns true for brokers created before credentials were kept in Secrets.
+func hasInlineCredentials(sb *servicebrokerapi.ServiceBroker) bool {
+	return servicebrokerclient.HasInlineCredentials(&sb.Spec)
+}
+
+// migrateCredentials moves the inline credentials of sb into a Secret in the
+// ServiceBrokerSecretNamespace, or the namespace of a project broker, and makes
+// sb refer to it.
+func (c *ServiceBrokerController) migrateCredentials(sb *servicebrokerapi.ServiceBroker) error {
+	namespace := servicebrokerapi.ServiceBrokerSecretNamespace
+	if len(sb.Namespace) > 0 {
+		namespace = sb.Namespace
+	}
+
+	if _, err := servicebrokerclient.MoveCredentials(c.KubeClient, namespace, sb.Name, &sb.Spec); err != nil {
+		c.recorder.Eventf(sb, "CredentialsFailed", "failed to move the credentials to a secret: %v", err)
+		return err
+	}
+
+	glog.Infof("moved the credentials of servicebroker %s to secret %s/%s", sb.Name, sb.Spec.AuthSecretRef.Namespace, sb.Spec.AuthSecretRef.Name)
+
+	return c.updateBroker(sb)
+}
@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+)
+
+// The keys of BackingServiceSpec.DashboardClient.
+const (
+	dashboardClientID          = "id"
+	dashboardClientSecret      = "secret"
+	dashboardClientRedirectURI = "redirect_uri"
+)
+
+// DashboardClientServiceAnnotation is set on the OAuthClients of dashboards to the
+// name of the BackingService declaring them.
+const DashboardClientServiceAnnotation = "asiainfo.io/backingservice"
+
+// syncDashboardClients maintains an OAuthClient for the dashboard client of each
+// BackingService of sb, so that the dashboards of its instances can log users in
+// through the OAuth server. Clients no BackingService declares anymore are deleted.
+// Dashboards should only ask for the user:info scope, but tokens aren't restricted to
+// their scopes yet, so only cluster brokers get OAuth clients: registering one lets a
+// broker receive the tokens of the users who grant it access.
+func (c *ServiceBrokerController) syncDashboardClients(sb *servicebrokerapi.ServiceBroker) error {
+	if len(sb.Namespace) > 0 {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{servicebrokerapi.ServiceBrokerLabel: sb.Name})
+	bsList, err := c.Client.BackingServices(BSNS).List(selector, fields.Everything())
+	if err != nil {
+		return err
+	}
+	existing, err := c.Client.OAuthClients().List(selector, fields.Everything())
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]*oauthapi.OAuthClient{}
+	for i := range bsList.Items {
+		client := dashboardClientFor(sb, &bsList.Items[i])
+		if client == nil {
+			continue
+		}
+		if other, ok := wanted[client.Name]; ok {
+			c.recorder.Eventf(sb, "DashboardClientConflict", "services %s and %s declare the same dashboard client %s", other.Annotations[DashboardClientServiceAnnotation], bsList.Items[i].Name, client.Name)
+			continue
+		}
+		wanted[client.Name] = client
+	}
+
+	errs := []error{}
+	for i := range existing.Items {
+		old := &existing.Items[i]
+		client, ok := wanted[old.Name]
+		if !ok {
+			if err := c.Client.OAuthClients().Delete(old.Name); err != nil && !errors.IsNotFound(err) {
+				errs = append(errs, err)
+				continue
+			}
+			c.recorder.Eventf(sb, "DashboardClientDeleted", "deleted the oauth client %s no service declares anymore", old.Name)
+			continue
+		}
+		delete(wanted, old.Name)
+
+		if old.Secret == client.Secret && kapi.Semantic.DeepEqual(old.RedirectURIs, client.RedirectURIs) && kapi.Semantic.DeepEqual(old.Annotations, client.Annotations) {
+			continue
+		}
+		old.Secret = client.Secret
+		old.RedirectURIs = client.RedirectURIs
+		old.Annotations = client.Annotations
+		if _, err := c.Client.OAuthClients().Update(old); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, client := range wanted {
+		if err := c.createDashboardClient(sb, client); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// createDashboardClient creates client, unless an OAuthClient of the same name not
+// belonging to sb exists.
+func (c *ServiceBrokerController) createDashboardClient(sb *servicebrokerapi.ServiceBroker, client *oauthapi.OAuthClient) error {
+	if _, err := c.Client.OAuthClients().Create(client); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			c.recorder.Eventf(sb, "DashboardClientFailed", "failed to create the oauth client %s: %v", client.Name, err)
+			return err
+		}
+		c.recorder.Eventf(sb, "DashboardClientConflict", "oauth client %s of service %s already exists and does not belong to the broker", client.Name, client.Annotations[DashboardClientServiceAnnotation])
+		return fmt.Errorf("oauthclient %s already exists", client.Name)
+	}
+	c.recorder.Eventf(sb, "DashboardClientCreated", "created the oauth client %s of the dashboard of service %s", client.Name, client.Annotations[DashboardClientServiceAnnotation])
+	return nil
+}
+
+// deleteDashboardClients deletes the OAuthClients of the dashboards of sb.
+func (c *ServiceBrokerController) deleteDashboardClients(sb *servicebrokerapi.ServiceBroker) error {
+	if len(sb.Namespace) > 0 {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{servicebrokerapi.ServiceBrokerLabel: sb.Name})
+	existing, err := c.Client.OAuthClients().List(selector, fields.Everything())
+	if err != nil {
+		return err
+	}
+	errs := []error{}
+	for i := range existing.Items {
+		if err := c.Client.OAuthClients().Delete(existing.Items[i].Name); err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// dashboardClientFor returns the OAuthClient of the dashboard client of bs, nil if
+// bs declares none. Its redirect uri is the only one allowed.
+func dashboardClientFor(sb *servicebrokerapi.ServiceBroker, bs *backingserviceapi.BackingService) *oauthapi.OAuthClient {
+	id := bs.Spec.DashboardClient[dashboardClientID]
+	if len(id) == 0 {
+		return nil
+	}
+
+	client := &oauthapi.OAuthClient{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        id,
+			Labels:      map[string]string{servicebrokerapi.ServiceBrokerLabel: sb.Name},
+			Annotations: map[string]string{DashboardClientServiceAnnotation: bs.Name},
+		},
+		Secret: bs.Spec.DashboardClient[dashboardClientSecret],
+	}
+	if uri := bs.Spec.DashboardClient[dashboardClientRedirectURI]; len(uri) > 0 {
+		client.RedirectURIs = []string{uri}
+	}
+	return client
+}
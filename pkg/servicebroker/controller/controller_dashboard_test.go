@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+func TestDashboardClientFor(t *testing.T) {
+	sb := &servicebrokerapi.ServiceBroker{ObjectMeta: kapi.ObjectMeta{Name: "broker"}}
+	bs := newBackingService(sb.Name, servicebrokerclient.Service{
+		Name:            "mysql",
+		DashboardClient: &servicebrokerclient.DashboardClient{ID: "mysql-dashboard", Secret: "s3cr3t", RedirectURI: "https://dashboard.example.com/callback"},
+	})
+
+	client := dashboardClientFor(sb, bs)
+	if client == nil {
+		t.Fatalf("expected an oauth client")
+	}
+	if client.Name != "mysql-dashboard" || client.Secret != "s3cr3t" || !reflect.DeepEqual(client.RedirectURIs, []string{"https://dashboard.example.com/callback"}) {
+		t.Errorf("unexpected oauth client %#v", client)
+	}
+	if client.Labels[servicebrokerapi.ServiceBrokerLabel] != "broker" || client.Annotations[DashboardClientServiceAnnotation] != "mysql" {
+		t.Errorf("expected the client to be labeled with its broker and annotated with its service, got %v %v", client.Labels, client.Annotations)
+	}
+
+	if client := dashboardClientFor(sb, &backingserviceapi.BackingService{}); client != nil {
+		t.Errorf("expected no oauth client for a service without dashboard client, got %#v", client)
+	}
+}
@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+const (
+	// initialProbeBackoff is how long the first retry of a failed probe waits, the
+	// wait doubles with every failure.
+	initialProbeBackoff = 10 * time.Second
+	// maxProbeBackoff bounds the wait between the probes of a failing broker.
+	maxProbeBackoff = 10 * time.Minute
+)
+
+// probe fetches the catalog of sb and syncs its BackingServices, recording the result
+// in the status of sb. A broker becomes Failed after FailureThreshold failed probes
+// in a row, and Active again once a probe succeeds.
+func (c *ServiceBrokerController) probe(sb *servicebrokerapi.ServiceBroker) error {
+	hc := servicebrokerapi.HealthCheckOf(sb)
+	now := unversioned.Now()
+
+	catalog, err := c.catalog(sb, time.Duration(hc.TimeoutSeconds)*time.Second)
+	if err != nil {
+		sb.Status.ConsecutiveFailures++
+		setReadyCondition(&sb.Status, kapi.ConditionFalse, "ProbeFailed", err.Error(), now)
+		c.scheduleProbe(sb, probeBackoff(hc, sb.Status.ConsecutiveFailures))
+
+		if sb.Status.Phase != servicebrokerapi.ServiceBrokerFailed && sb.Status.ConsecutiveFailures >= hc.FailureThreshold {
+			sb.Status.Phase = servicebrokerapi.ServiceBrokerFailed
+			c.recorder.Eventf(sb, "Failed", "%d probes failed in a row: %v", sb.Status.ConsecutiveFailures, err)
+			c.inActiveBackingService(sb)
+		}
+		return err
+	}
+
+	sb.Status.ConsecutiveFailures = 0
+	sb.Status.CatalogHash = catalogHash(catalog)
+	sb.Status.ServiceCount = len(catalog.Services)
+	c.scheduleProbe(sb, time.Duration(hc.IntervalSeconds)*time.Second)
+
+	if err := c.syncCatalog(sb, catalog); err != nil {
+		glog.Errorln("servicebroker sync catalog err ", err)
+		setReadyCondition(&sb.Status, kapi.ConditionFalse, "SyncFailed", err.Error(), now)
+		return err
+	}
+	setReadyCondition(&sb.Status, kapi.ConditionTrue, "CatalogSynced", "", now)
+
+	switch sb.Status.Phase {
+	case servicebrokerapi.ServiceBrokerFailed:
+		c.ActiveBackingService(sb)
+		c.recorder.Eventf(sb, "Recovered", "catalog of %d services fetched again", sb.Status.ServiceCount)
+	case servicebrokerapi.ServiceBrokerNew:
+		c.recorder.Eventf(sb, "Active", "catalog of %d services fetched", sb.Status.ServiceCount)
+	}
+	sb.Status.Phase = servicebrokerapi.ServiceBrokerActive
+	return nil
+}
+
+// probeBackoff returns how long to wait before probing again a broker whose last
+// failures probes failed. Brokers probed more often than initialProbeBackoff start
+// with their interval.
+func probeBackoff(hc servicebrokerapi.ServiceBrokerHealthCheck, failures int) time.Duration {
+	backoff := initialProbeBackoff
+	if interval := time.Duration(hc.IntervalSeconds) * time.Second; interval < backoff {
+		backoff = interval
+	}
+	for i := 1; i < failures && backoff < maxProbeBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxProbeBackoff {
+		backoff = maxProbeBackoff
+	}
+	return backoff
+}
+
+func (c *ServiceBrokerController) scheduleProbe(sb *servicebrokerapi.ServiceBroker, after time.Duration) {
+	if c.nextProbe == nil {
+		c.nextProbe = map[string]time.Time{}
+	}
+	c.nextProbe[probeKey(sb)] = time.Now().Add(after)
+}
+
+func probeKey(sb *servicebrokerapi.ServiceBroker) string {
+	return sb.Namespace + "/" + sb.Name
+}
+
+// catalogHash returns a hash of catalog, which changes with its services and plans.
+func catalogHash(catalog *servicebrokerclient.CatalogResponse) string {
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// setReadyCondition records the result of a probe in the Ready condition of status.
+func setReadyCondition(status *servicebrokerapi.ServiceBrokerStatus, conditionStatus kapi.ConditionStatus, reason, message string, now unversioned.Time) {
+	condition := servicebrokerapi.ServiceBrokerCondition{
+		Type:               servicebrokerapi.ServiceBrokerReady,
+		Status:             conditionStatus,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+	for i := range status.Conditions {
+		if status.Conditions[i].Type != servicebrokerapi.ServiceBrokerReady {
+			continue
+		}
+		if status.Conditions[i].Status == conditionStatus {
+			condition.LastTransitionTime = status.Conditions[i].LastTransitionTime
+		}
+		status.Conditions[i] = condition
+		return
+	}
+	status.Conditions = append(status.Conditions, condition)
+}
+
+func copyStatus(status servicebrokerapi.ServiceBrokerStatus) servicebrokerapi.ServiceBrokerStatus {
+	status.Conditions = append([]servicebrokerapi.ServiceBrokerCondition(nil), status.Conditions...)
+	return status
+}
+
+// statusChanged compares two statuses of a broker regardless of when it was probed.
+func statusChanged(old, status servicebrokerapi.ServiceBrokerStatus) bool {
+	old, status = copyStatus(old), copyStatus(status)
+	for i := range old.Conditions {
+		old.Conditions[i].LastProbeTime = unversioned.Time{}
+	}
+	for i := range status.Conditions {
+		status.Conditions[i].LastProbeTime = unversioned.Time{}
+	}
+	return !kapi.Semantic.DeepEqual(old, status)
+}
+
+// updateStatus writes sb back if its status changed since old, dropping the annotations
+// probes were tracked in before.
+func (c *ServiceBrokerController) updateStatus(sb *servicebrokerapi.ServiceBroker, old servicebrokerapi.ServiceBrokerStatus) error {
+	_, pinged := sb.Annotations[servicebrokerapi.ServiceBrokerLastPingTime]
+	_, retried := sb.Annotations[servicebrokerapi.ServiceBrokerNewRetryTimes]
+	if !pinged && !retried && !statusChanged(old, sb.Status) {
+		return nil
+	}
+
+	delete(sb.Annotations, servicebrokerapi.ServiceBrokerLastPingTime)
+	delete(sb.Annotations, servicebrokerapi.ServiceBrokerNewRetryTimes)
+	return c.updateBroker(sb)
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+func TestProbeBackoff(t *testing.T) {
+	hc := servicebrokerapi.HealthCheckOf(&servicebrokerapi.ServiceBroker{})
+
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 1, expected: 10 * time.Second},
+		{failures: 2, expected: 20 * time.Second},
+		{failures: 3, expected: 40 * time.Second},
+		{failures: 20, expected: maxProbeBackoff},
+	}
+	for _, test := range tests {
+		if backoff := probeBackoff(hc, test.failures); backoff != test.expected {
+			t.Errorf("%d failures: expected %v, got %v", test.failures, test.expected, backoff)
+		}
+	}
+
+	hc.IntervalSeconds = 2
+	if backoff := probeBackoff(hc, 1); backoff != 2*time.Second {
+		t.Errorf("expected the backoff to start with the interval, got %v", backoff)
+	}
+}
+
+func TestStatusChanged(t *testing.T) {
+	first := unversioned.NewTime(time.Unix(1000, 0))
+	second := unversioned.NewTime(time.Unix(2000, 0))
+
+	status := servicebrokerapi.ServiceBrokerStatus{Phase: servicebrokerapi.ServiceBrokerActive}
+	setReadyCondition(&status, kapi.ConditionTrue, "CatalogSynced", "", first)
+
+	old := copyStatus(status)
+	setReadyCondition(&status, kapi.ConditionTrue, "CatalogSynced", "", second)
+	if statusChanged(old, status) {
+		t.Errorf("expected a probe with the same result not to change the status")
+	}
+	if status.Conditions[0].LastTransitionTime != first {
+		t.Errorf("expected the transition time to be kept, got %v", status.Conditions[0].LastTransitionTime)
+	}
+
+	setReadyCondition(&status, kapi.ConditionFalse, "ProbeFailed", "connection refused", second)
+	if !statusChanged(old, status) {
+		t.Errorf("expected a failed probe to change the status")
+	}
+	if status.Conditions[0].LastTransitionTime != second {
+		t.Errorf("expected the transition time to be updated, got %v", status.Conditions[0].LastTransitionTime)
+	}
+}
@@ -1,7 +1,9 @@
 package controller
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -21,6 +23,9 @@ type ServiceBrokerControllerFactory struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
+	// ServiceBrokerClientFunc returns the clients of the brokers, clients reading the
+	// secrets of the brokers with KubeClient are used if it is nil.
+	ServiceBrokerClientFunc servicebrokerclient.ClientFunc
 }
 
 // Create creates a ServiceBrokerControllerFactory.
@@ -39,14 +44,64 @@ func (factory *ServiceBrokerControllerFactory) Create() controller.RunnableContr
 		},
 	}
 	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
-	cache.NewReflector(servicebrokerLW, &servicebrokerapi.ServiceBroker{}, queue, 10 * time.Second).Run()
+	cache.NewReflector(servicebrokerLW, &servicebrokerapi.ServiceBroker{}, queue, 10*time.Second).Run()
 
-	servicebrokerController := &ServiceBrokerController{
-		Client:              factory.Client,
-		KubeClient:          factory.KubeClient,
-		ServiceBrokerClient: servicebrokerclient.NewServiceBrokerClient(),
+	servicebrokerController := factory.newController()
+
+	return newRetryController(queue, func(obj interface{}) error {
+		servicebroker := obj.(*servicebrokerapi.ServiceBroker)
+		return servicebrokerController.Handle(servicebroker)
+	})
+}
+
+func (factory *ServiceBrokerControllerFactory) newController() *ServiceBrokerController {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	return &ServiceBrokerController{
+		Client:                  factory.Client,
+		KubeClient:              factory.KubeClient,
+		ServiceBrokerClientFunc: factory.serviceBrokerClientFunc(),
+		recorder:                eventBroadcaster.NewRecorder(kapi.EventSource{Component: "sb"}),
+		nextProbe:               map[string]time.Time{},
 	}
+}
 
+func (factory *ServiceBrokerControllerFactory) serviceBrokerClientFunc() servicebrokerclient.ClientFunc {
+	if factory.ServiceBrokerClientFunc != nil {
+		return factory.ServiceBrokerClientFunc
+	}
+	return servicebrokerclient.NewClientFunc(factory.KubeClient)
+}
+
+// ProjectServiceBrokerControllerFactory creates a controller handling the
+// ProjectServiceBrokers of all namespaces as the ServiceBrokers of their namespace.
+type ProjectServiceBrokerControllerFactory struct {
+	ServiceBrokerControllerFactory
+}
+
+// Create creates a ProjectServiceBroker controller.
+func (factory *ProjectServiceBrokerControllerFactory) Create() controller.RunnableController {
+	projectServiceBrokerLW := &cache.ListWatch{
+		ListFunc: func() (runtime.Object, error) {
+			return factory.Client.ProjectServiceBrokers(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return factory.Client.ProjectServiceBrokers(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(projectServiceBrokerLW, &servicebrokerapi.ProjectServiceBroker{}, queue, 10*time.Second).Run()
+
+	servicebrokerController := factory.newController()
+
+	return newRetryController(queue, func(obj interface{}) error {
+		psb := obj.(*servicebrokerapi.ProjectServiceBroker)
+		return servicebrokerController.Handle(servicebrokerapi.ServiceBrokerOf(psb))
+	})
+}
+
+func newRetryController(queue *cache.FIFO, handle func(obj interface{}) error) *controller.RetryController {
 	return &controller.RetryController{
 		Queue: queue,
 		RetryManager: controller.NewQueueRetryManager(
@@ -64,10 +119,7 @@ func (factory *ServiceBrokerControllerFactory) Create() controller.RunnableContr
 			},
 			kutil.NewTokenBucketRateLimiter(10, 1),
 		),
-		Handle: func(obj interface{}) error {
-			servicebroker := obj.(*servicebrokerapi.ServiceBroker)
-			return servicebrokerController.Handle(servicebroker)
-		},
+		Handle: handle,
 	}
 }
 
@@ -0,0 +1,582 @@
+// Package facade serves the marketplace of the cluster as a service broker, so that
+// other platforms can consume the backing services it aggregates. Instances are
+// BackingServiceInstances of a single project, bindings are recorded on their instance
+// and forwarded to the broker offering the service of the instance.
+package facade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	kuval "k8s.io/kubernetes/pkg/util/validation"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+const (
+	// InstanceIDAnnotation holds the id the caller gave the instance, it marks the
+	// BackingServiceInstances created through the broker.
+	InstanceIDAnnotation = "asiainfo.io/servicebroker-instance-id"
+	// instanceNamePrefix prefixes the names of the BackingServiceInstances created
+	// through the broker.
+	instanceNamePrefix = "osb-"
+)
+
+// The operations reported while BackingServiceInstances are being changed.
+const (
+	OperationProvision   = "provision"
+	OperationUpdate      = "update"
+	OperationDeprovision = "deprovision"
+)
+
+// Broker is the service broker façade of the marketplace.
+type Broker struct {
+	client osclient.Interface
+	// namespace holds the BackingServiceInstances created through the broker.
+	namespace string
+	// clientFunc returns the clients of the brokers bindings are forwarded to.
+	clientFunc servicebrokerclient.ClientFunc
+}
+
+// NewBroker returns the façade keeping its instances in namespace.
+func NewBroker(client osclient.Interface, namespace string, clientFunc servicebrokerclient.ClientFunc) *Broker {
+	return &Broker{client: client, namespace: namespace, clientFunc: clientFunc}
+}
+
+var _ servicebrokerclient.Interface = &Broker{}
+
+// Catalog offers the active BackingServices visible to the namespace of the broker,
+// without their deprecated plans.
+func (b *Broker) Catalog(ctx context.Context) (*servicebrokerclient.CatalogResponse, error) {
+	bsList, err := b.client.BackingServices(b.namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &servicebrokerclient.CatalogResponse{Services: []servicebrokerclient.Service{}}
+	for i := range bsList.Items {
+		bs := &bsList.Items[i]
+		if bs.Status.Phase != backingserviceapi.BackingServicePhaseActive {
+			continue
+		}
+		catalog.Services = append(catalog.Services, serviceFor(bs))
+	}
+	return catalog, nil
+}
+
+// Provision creates the BackingServiceInstance of an instance. Provisioning is
+// always asynchronous, the instance is provisioned by the controller.
+func (b *Broker) Provision(ctx context.Context, instanceID string, r *servicebrokerclient.ProvisionRequest) (*servicebrokerclient.ProvisionResponse, error) {
+	name, err := instanceName(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	bs, plan, err := b.plan(r.ServiceID, r.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := b.client.BackingServiceInstances(b.namespace).Get(name)
+	switch {
+	case err == nil:
+		if existing.Annotations[InstanceIDAnnotation] != instanceID || existing.Spec.BackingServiceName != bs.Name || existing.Spec.BackingServicePlanGuid != plan.Id {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusConflict, Description: "instance " + instanceID + " already exists"}
+		}
+		if existing.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning {
+			return &servicebrokerclient.ProvisionResponse{Operation: OperationProvision, Async: true}, nil
+		}
+		return &servicebrokerclient.ProvisionResponse{DashboardURL: existing.Spec.DashboardUrl, Exists: true}, nil
+	case !kerrors.IsNotFound(err):
+		return nil, err
+	}
+
+	if !r.AcceptsIncomplete {
+		return nil, asyncRequired()
+	}
+	params, err := stringParameters(r.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	bsi := &backingserviceinstanceapi.BackingServiceInstance{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{InstanceIDAnnotation: instanceID},
+		},
+		Spec: backingserviceinstanceapi.BackingServiceInstanceSpec{
+			InstanceProvisioning: backingserviceinstanceapi.InstanceProvisioning{
+				BackingServiceName:     bs.Name,
+				BackingServicePlanGuid: plan.Id,
+				BackingServicePlanName: plan.Name,
+				Parameters:             params,
+			},
+		},
+	}
+	if _, err := b.client.BackingServiceInstances(b.namespace).Create(bsi); err != nil {
+		return nil, statusError(err)
+	}
+	return &servicebrokerclient.ProvisionResponse{Operation: OperationProvision, Async: true}, nil
+}
+
+// Update changes the plan of the BackingServiceInstance of an instance, and merges
+// the parameters given into its own.
+func (b *Broker) Update(ctx context.Context, instanceID string, r *servicebrokerclient.UpdateRequest) (*servicebrokerclient.UpdateResponse, error) {
+	bsi, err := b.instance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !r.AcceptsIncomplete {
+		return nil, asyncRequired()
+	}
+
+	if len(r.PlanID) > 0 && r.PlanID != bsi.Spec.BackingServicePlanGuid {
+		bs, plan, err := b.plan(r.ServiceID, r.PlanID)
+		if err != nil {
+			return nil, err
+		}
+		if bs.Name != bsi.Spec.BackingServiceName {
+			return nil, badRequest("service %s is not the service of instance %s", r.ServiceID, instanceID)
+		}
+		if !bs.Spec.PlanUpdateable {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, Description: "the plan of service " + bs.Name + " can't be changed"}
+		}
+		bsi.Spec.BackingServicePlanGuid = plan.Id
+		bsi.Spec.BackingServicePlanName = plan.Name
+	}
+
+	params, err := stringParameters(r.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	if len(params) > 0 {
+		if bsi.Spec.Parameters == nil {
+			bsi.Spec.Parameters = map[string]string{}
+		}
+		for k, v := range params {
+			bsi.Spec.Parameters[k] = v
+		}
+	}
+
+	if _, err := b.client.BackingServiceInstances(b.namespace).Update(bsi); err != nil {
+		return nil, statusError(err)
+	}
+	return &servicebrokerclient.UpdateResponse{Operation: OperationUpdate, Async: true}, nil
+}
+
+// Deprovision deletes the BackingServiceInstance of an instance, the controller
+// deprovisions it.
+func (b *Broker) Deprovision(ctx context.Context, instanceID string, r *servicebrokerclient.DeprovisionRequest) (*servicebrokerclient.DeprovisionResponse, error) {
+	bsi, err := b.instance(instanceID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusGone}
+		}
+		return nil, err
+	}
+	if !r.AcceptsIncomplete {
+		return nil, asyncRequired()
+	}
+	if bsi.Spec.Bound > 0 || len(backingserviceinstanceapi.ExternalBindings(bsi)) > 0 {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, Description: fmt.Sprintf("instance %s still has bindings, unbind them first", instanceID)}
+	}
+
+	if err := b.client.BackingServiceInstances(b.namespace).Delete(bsi.Name); err != nil && !kerrors.IsNotFound(err) {
+		return nil, statusError(err)
+	}
+	return &servicebrokerclient.DeprovisionResponse{Operation: OperationDeprovision, Async: true}, nil
+}
+
+// Bind records the binding in the ExternalBindingsAnnotation of the instance, so that
+// it counts as bound, and forwards the bind request to the broker of the instance.
+// Binding again the same way returns the credentials of the binding again.
+func (b *Broker) Bind(ctx context.Context, instanceID, bindingID string, r *servicebrokerclient.BindRequest) (*servicebrokerclient.BindResponse, error) {
+	bsi, err := b.instance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if !isProvisioned(bsi) {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, Description: "instance " + instanceID + " is not provisioned"}
+	}
+
+	digest, err := bindDigest(r)
+	if err != nil {
+		return nil, err
+	}
+	recorded, exists := backingserviceinstanceapi.ExternalBindings(bsi)[bindingID]
+	if exists && recorded != digest {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusConflict, Description: "binding " + bindingID + " already exists"}
+	}
+
+	bs, sbclient, err := b.brokerOf(bsi)
+	if err != nil {
+		return nil, err
+	}
+	// the binding is recorded first, the instance can't be deprovisioned while it is bound.
+	if !exists {
+		if err := b.recordBinding(bsi.Name, bindingID, digest); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := sbclient.Bind(ctx, bsi.Spec.InstanceID, bindingID, &servicebrokerclient.BindRequest{
+		ServiceID:    bs.Spec.Id,
+		PlanID:       bsi.Spec.BackingServicePlanGuid,
+		AppGUID:      r.AppGUID,
+		BindResource: r.BindResource,
+		Parameters:   r.Parameters,
+	})
+	if err != nil {
+		if !exists {
+			if err := b.forgetBinding(bsi.Name, bindingID); err != nil {
+				glog.Errorf("failed to forget binding %s of instance %s: %v", bindingID, instanceID, err)
+			}
+		}
+		return nil, err
+	}
+	resp.Exists = exists
+	return resp, nil
+}
+
+// Unbind forwards the unbind request to the broker of the instance, and removes the
+// binding from the ExternalBindingsAnnotation of the instance.
+func (b *Broker) Unbind(ctx context.Context, instanceID, bindingID string, r *servicebrokerclient.UnbindRequest) error {
+	bsi, err := b.instance(instanceID)
+	if err != nil {
+		if isNotFound(err) {
+			return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusGone}
+		}
+		return err
+	}
+	if _, ok := backingserviceinstanceapi.ExternalBindings(bsi)[bindingID]; !ok || len(bsi.Spec.InstanceID) == 0 {
+		return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusGone}
+	}
+
+	bs, sbclient, err := b.brokerOf(bsi)
+	if err != nil {
+		return err
+	}
+	if err := sbclient.Unbind(ctx, bsi.Spec.InstanceID, bindingID, &servicebrokerclient.UnbindRequest{
+		ServiceID: bs.Spec.Id,
+		PlanID:    bsi.Spec.BackingServicePlanGuid,
+	}); err != nil {
+		return err
+	}
+	return b.forgetBinding(bsi.Name, bindingID)
+}
+
+// recordBinding adds bindingID to the external bindings of the instance name.
+func (b *Broker) recordBinding(name, bindingID, digest string) error {
+	return b.updateBindings(name, func(bindings map[string]string) {
+		bindings[bindingID] = digest
+	})
+}
+
+// forgetBinding removes bindingID from the external bindings of the instance name.
+func (b *Broker) forgetBinding(name, bindingID string) error {
+	return b.updateBindings(name, func(bindings map[string]string) {
+		delete(bindings, bindingID)
+	})
+}
+
+// updateBindings changes the external bindings of the instance name with fn, and
+// counts them in its Bound the way the controller does.
+func (b *Broker) updateBindings(name string, fn func(map[string]string)) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		bsi, err := b.client.BackingServiceInstances(b.namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		bindings := backingserviceinstanceapi.ExternalBindings(bsi)
+		before := len(bindings)
+		fn(bindings)
+		backingserviceinstanceapi.SetExternalBindings(bsi, bindings)
+
+		bsi.Spec.Bound += len(bindings) - before
+		switch {
+		case bsi.Spec.Bound > 0 && bsi.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseUnbound:
+			bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseBound
+		case bsi.Spec.Bound <= 0 && bsi.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+			bsi.Spec.Bound = 0
+			bsi.Status.Phase = backingserviceinstanceapi.BackingServiceInstancePhaseUnbound
+		}
+		_, err = b.client.BackingServiceInstances(b.namespace).Update(bsi)
+		return err
+	})
+}
+
+// bindDigest returns the digest of r that tells whether a binding is made again the
+// same way.
+func bindDigest(r *servicebrokerclient.BindRequest) (string, error) {
+	data, err := json.Marshal(struct {
+		AppGUID      string                 `json:"app_guid"`
+		BindResource map[string]string      `json:"bind_resource"`
+		Parameters   map[string]interface{} `json:"parameters"`
+	}{r.AppGUID, r.BindResource, r.Parameters})
+	if err != nil {
+		return "", badRequest("invalid bind request: %v", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// LastOperation reports the state of the BackingServiceInstance of an instance.
+// Deprovisioned instances are gone.
+func (b *Broker) LastOperation(ctx context.Context, instanceID string, r *servicebrokerclient.LastOperationRequest) (*servicebrokerclient.LastOperationResponse, error) {
+	bsi, err := b.instance(instanceID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusGone}
+		}
+		return nil, err
+	}
+	return lastOperationOf(bsi), nil
+}
+
+// lastOperationOf returns the state of the operation in progress on bsi, or of the
+// last one.
+func lastOperationOf(bsi *backingserviceinstanceapi.BackingServiceInstance) *servicebrokerclient.LastOperationResponse {
+	resp := &servicebrokerclient.LastOperationResponse{State: servicebrokerclient.StateInProgress}
+	failed := bsi.Status.LastOperation != nil && bsi.Status.LastOperation.State == backingserviceinstanceapi.LastOperationStateFailed
+	if failed {
+		resp.Description = bsi.Status.LastOperation.Description
+	}
+
+	switch {
+	case !bsi.DeletionTimestamp.IsZero() || bsi.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseDeprovisioning:
+	case !isProvisioned(bsi):
+		if failed {
+			resp.State = servicebrokerclient.StateFailed
+		}
+	case bsi.Status.AppliedPlanGuid != bsi.Spec.BackingServicePlanGuid ||
+		!backingserviceinstanceapi.ParametersEqual(bsi.Status.AppliedParameters, backingserviceinstanceapi.ParametersOf(bsi)):
+		if failed {
+			resp.State = servicebrokerclient.StateFailed
+		}
+	default:
+		resp.State = servicebrokerclient.StateSucceeded
+	}
+	return resp
+}
+
+func isProvisioned(bsi *backingserviceinstanceapi.BackingServiceInstance) bool {
+	switch bsi.Status.Phase {
+	case backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, backingserviceinstanceapi.BackingServiceInstancePhaseBound:
+		return bsi.DeletionTimestamp.IsZero()
+	}
+	return false
+}
+
+// instance returns the BackingServiceInstance created for instanceID.
+func (b *Broker) instance(instanceID string) (*backingserviceinstanceapi.BackingServiceInstance, error) {
+	name, err := instanceName(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	bsi, err := b.client.BackingServiceInstances(b.namespace).Get(name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusNotFound, Description: "instance " + instanceID + " does not exist"}
+		}
+		return nil, err
+	}
+	if bsi.Annotations[InstanceIDAnnotation] != instanceID {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusNotFound, Description: "instance " + instanceID + " does not exist"}
+	}
+	return bsi, nil
+}
+
+// plan returns the BackingService offered as serviceID, and its plan planID.
+func (b *Broker) plan(serviceID, planID string) (*backingserviceapi.BackingService, *backingserviceapi.ServicePlan, error) {
+	bsList, err := b.client.BackingServices(b.namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range bsList.Items {
+		bs := &bsList.Items[i]
+		if string(bs.UID) != serviceID {
+			continue
+		}
+		for j := range bs.Spec.Plans {
+			if plan := &bs.Spec.Plans[j]; plan.Id == planID && !plan.Deprecated {
+				return bs, plan, nil
+			}
+		}
+		return nil, nil, badRequest("service %s has no plan %s", serviceID, planID)
+	}
+	return nil, nil, badRequest("service %s is not offered", serviceID)
+}
+
+// brokerOf returns the BackingService of bsi and a client of the broker offering it.
+func (b *Broker) brokerOf(bsi *backingserviceinstanceapi.BackingServiceInstance) (*backingserviceapi.BackingService, servicebrokerclient.Interface, error) {
+	bs, err := b.client.BackingServices(backingserviceinstanceapi.BackingServiceNamespaceOf(bsi)).Get(bsi.Spec.BackingServiceName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sb *servicebrokerapi.ServiceBroker
+	if bs.Namespace != backingserviceapi.BackingServiceSharedNamespace {
+		psb, err := b.client.ProjectServiceBrokers(bs.Namespace).Get(bs.GenerateName)
+		if err != nil {
+			return nil, nil, err
+		}
+		sb = servicebrokerapi.ServiceBrokerOf(psb)
+	} else if sb, err = b.client.ServiceBrokers().Get(bs.GenerateName); err != nil {
+		return nil, nil, err
+	}
+
+	sbclient, err := b.clientFunc(sb)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bs, sbclient, nil
+}
+
+// instanceName returns the name of the BackingServiceInstance of instanceID.
+func instanceName(instanceID string) (string, error) {
+	name := instanceNamePrefix + strings.ToLower(instanceID)
+	if !kuval.IsDNS1123Label(name) {
+		return "", badRequest("instance id %q must be a DNS label of at most %d characters", instanceID, kuval.DNS1123LabelMaxLength-len(instanceNamePrefix))
+	}
+	return name, nil
+}
+
+// stringParameters returns params as the string parameters of BackingServiceInstances,
+// values which aren't strings are json encoded.
+func stringParameters(params map[string]interface{}) (map[string]string, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(params))
+	for name, value := range params {
+		switch v := value.(type) {
+		case string:
+			values[name] = v
+		case bool:
+			values[name] = strconv.FormatBool(v)
+		case float64:
+			values[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, badRequest("parameter %s: %v", name, err)
+			}
+			values[name] = string(data)
+		}
+	}
+	return values, nil
+}
+
+// serviceFor returns the service bs is offered as.
+func serviceFor(bs *backingserviceapi.BackingService) servicebrokerclient.Service {
+	service := servicebrokerclient.Service{
+		Name:           bs.Name,
+		ID:             string(bs.UID),
+		Description:    bs.Spec.Description,
+		Tags:           bs.Spec.Tags,
+		Requires:       bs.Spec.Requires,
+		Bindable:       bs.Spec.Bindable,
+		PlanUpdateable: bs.Spec.PlanUpdateable,
+		Plans:          []servicebrokerclient.Plan{},
+	}
+	if len(bs.Spec.Metadata) > 0 {
+		service.Metadata = make(map[string]interface{}, len(bs.Spec.Metadata))
+		for k, v := range bs.Spec.Metadata {
+			service.Metadata[k] = v
+		}
+	}
+
+	for i := range bs.Spec.Plans {
+		plan := &bs.Spec.Plans[i]
+		if plan.Deprecated {
+			continue
+		}
+		free := plan.Free
+		service.Plans = append(service.Plans, servicebrokerclient.Plan{
+			ID:          plan.Id,
+			Name:        plan.Name,
+			Description: plan.Description,
+			Free:        &free,
+			Metadata:    planMetadataFor(plan.Metadata),
+			Schemas:     planSchemasFor(plan.Schemas),
+		})
+	}
+	return service
+}
+
+func planMetadataFor(metadata backingserviceapi.ServicePlanMetadata) *servicebrokerclient.PlanMetadata {
+	if len(metadata.Bullets) == 0 && len(metadata.Costs) == 0 && len(metadata.DisplayName) == 0 {
+		return nil
+	}
+	result := &servicebrokerclient.PlanMetadata{Bullets: metadata.Bullets, DisplayName: metadata.DisplayName}
+	for _, cost := range metadata.Costs {
+		result.Costs = append(result.Costs, servicebrokerclient.PlanCost{Amount: cost.Amount, Unit: cost.Unit})
+	}
+	return result
+}
+
+func planSchemasFor(schemas backingserviceapi.ServicePlanSchemas) *servicebrokerclient.PlanSchemas {
+	if len(schemas.InstanceCreate) == 0 && len(schemas.InstanceUpdate) == 0 && len(schemas.BindingCreate) == 0 {
+		return nil
+	}
+	result := &servicebrokerclient.PlanSchemas{}
+	if len(schemas.InstanceCreate) > 0 || len(schemas.InstanceUpdate) > 0 {
+		result.ServiceInstance = &servicebrokerclient.ServiceInstanceSchema{
+			Create: inputParameters(schemas.InstanceCreate),
+			Update: inputParameters(schemas.InstanceUpdate),
+		}
+	}
+	if len(schemas.BindingCreate) > 0 {
+		result.ServiceBinding = &servicebrokerclient.ServiceBindingSchema{Create: inputParameters(schemas.BindingCreate)}
+	}
+	return result
+}
+
+func inputParameters(schema string) *servicebrokerclient.InputParameters {
+	if len(schema) == 0 {
+		return nil
+	}
+	return &servicebrokerclient.InputParameters{Parameters: json.RawMessage(schema)}
+}
+
+// statusError returns the broker error matching an error of the api server.
+func statusError(err error) error {
+	switch {
+	case kerrors.IsInvalid(err), kerrors.IsBadRequest(err):
+		return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusBadRequest, Description: err.Error()}
+	case kerrors.IsAlreadyExists(err), kerrors.IsConflict(err):
+		return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusConflict, Description: err.Error()}
+	}
+	return err
+}
+
+func isNotFound(err error) bool {
+	e, ok := err.(*servicebrokerclient.HTTPStatusCodeError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusBadRequest, Description: fmt.Sprintf(format, args...)}
+}
+
+func asyncRequired() error {
+	return &servicebrokerclient.HTTPStatusCodeError{
+		StatusCode:   http.StatusUnprocessableEntity,
+		ErrorMessage: "AsyncRequired",
+		Description:  "instances are provisioned asynchronously, accepts_incomplete=true is required",
+	}
+}
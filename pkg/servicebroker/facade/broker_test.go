@@ -0,0 +1,207 @@
+package facade
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	backingserviceapi "github.com/openshift/origin/pkg/backingservice/api"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	"github.com/openshift/origin/pkg/servicebroker/client/fakebroker"
+)
+
+func TestServiceFor(t *testing.T) {
+	bs := &backingserviceapi.BackingService{
+		ObjectMeta: kapi.ObjectMeta{Name: "mysql", UID: "1234"},
+		Spec: backingserviceapi.BackingServiceSpec{
+			Id:       "upstream-id",
+			Bindable: true,
+			Metadata: map[string]string{"displayName": "MySQL"},
+			Plans: []backingserviceapi.ServicePlan{
+				{Id: "small", Name: "small", Free: true, Schemas: backingserviceapi.ServicePlanSchemas{InstanceCreate: `{"type":"object"}`}},
+				{Id: "old", Name: "old", Deprecated: true},
+			},
+		},
+	}
+
+	service := serviceFor(bs)
+	if service.ID != "1234" || service.Name != "mysql" || !service.Bindable || service.Metadata["displayName"] != "MySQL" {
+		t.Errorf("unexpected service %#v", service)
+	}
+	if len(service.Plans) != 1 || service.Plans[0].ID != "small" || !*service.Plans[0].Free {
+		t.Fatalf("expected only the plans not deprecated, got %#v", service.Plans)
+	}
+	schemas := service.Plans[0].Schemas
+	if schemas == nil || schemas.ServiceInstance.Create == nil || string(schemas.ServiceInstance.Create.Parameters) != `{"type":"object"}` || schemas.ServiceInstance.Update != nil || schemas.ServiceBinding != nil {
+		t.Errorf("unexpected schemas %#v", schemas)
+	}
+}
+
+func TestStringParameters(t *testing.T) {
+	params, err := stringParameters(map[string]interface{}{
+		"name":    "db",
+		"size":    float64(10),
+		"backups": true,
+		"tags":    []interface{}{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"name": "db", "size": "10", "backups": "true", "tags": `["a","b"]`}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("expected %v, got %v", expected, params)
+	}
+}
+
+func TestInstanceName(t *testing.T) {
+	if name, err := instanceName("6F0B2B1E-0F6B-4E0A-9B0C-2F8C6A1D2E3F"); err != nil || name != "osb-6f0b2b1e-0f6b-4e0a-9b0c-2f8c6a1d2e3f" {
+		t.Errorf("unexpected name %q, error %v", name, err)
+	}
+	if _, err := instanceName("not/a label"); err == nil {
+		t.Errorf("expected an error for an id which isn't a DNS label")
+	}
+}
+
+func TestLastOperationOf(t *testing.T) {
+	now := unversioned.Now()
+	failed := &backingserviceinstanceapi.LastOperation{State: backingserviceinstanceapi.LastOperationStateFailed, Description: "out of quota"}
+
+	tests := []struct {
+		name  string
+		bsi   backingserviceinstanceapi.BackingServiceInstance
+		state string
+	}{
+		{
+			name:  "provisioning",
+			bsi:   newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning, "small", nil),
+			state: servicebrokerclient.StateInProgress,
+		},
+		{
+			name:  "provisioning failed",
+			bsi:   newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning, "small", failed),
+			state: servicebrokerclient.StateFailed,
+		},
+		{
+			name:  "provisioned",
+			bsi:   newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, "small", nil),
+			state: servicebrokerclient.StateSucceeded,
+		},
+		{
+			name:  "plan change pending",
+			bsi:   newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseBound, "large", nil),
+			state: servicebrokerclient.StateInProgress,
+		},
+		{
+			name:  "plan change failed",
+			bsi:   newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseBound, "large", failed),
+			state: servicebrokerclient.StateFailed,
+		},
+		{
+			name: "deleted",
+			bsi: func() backingserviceinstanceapi.BackingServiceInstance {
+				bsi := newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, "small", nil)
+				bsi.DeletionTimestamp = &now
+				return bsi
+			}(),
+			state: servicebrokerclient.StateInProgress,
+		},
+	}
+
+	for _, test := range tests {
+		if resp := lastOperationOf(&test.bsi); resp.State != test.state {
+			t.Errorf("%s: expected state %q, got %q", test.name, test.state, resp.State)
+		}
+	}
+}
+
+func newInstance(phase backingserviceinstanceapi.BackingServiceInstancePhase, plan string, lastOperation *backingserviceinstanceapi.LastOperation) backingserviceinstanceapi.BackingServiceInstance {
+	bsi := backingserviceinstanceapi.BackingServiceInstance{}
+	bsi.Spec.BackingServicePlanGuid = plan
+	bsi.Status.Phase = phase
+	bsi.Status.AppliedPlanGuid = "small"
+	bsi.Status.LastOperation = lastOperation
+	return bsi
+}
+
+func TestExternalBindings(t *testing.T) {
+	upstream := fakebroker.New(servicebrokerclient.CatalogResponse{})
+	upstream.Start()
+	defer upstream.Close()
+	upstream.Instances["upstream-id"] = &fakebroker.Instance{ServiceID: "mysql-id", PlanID: "small"}
+
+	bsi := newInstance(backingserviceinstanceapi.BackingServiceInstancePhaseUnbound, "small", nil)
+	bsi.ObjectMeta = kapi.ObjectMeta{Namespace: "market", Name: "osb-db", Annotations: map[string]string{InstanceIDAnnotation: "db"}}
+	bsi.Spec.BackingServiceName = "mysql"
+	bsi.Spec.InstanceID = "upstream-id"
+	client := testclient.NewSimpleFake(
+		&backingserviceapi.BackingService{
+			ObjectMeta: kapi.ObjectMeta{Namespace: backingserviceapi.BackingServiceSharedNamespace, Name: "mysql", GenerateName: "upstream"},
+			Spec:       backingserviceapi.BackingServiceSpec{Id: "mysql-id"},
+		},
+		&servicebrokerapi.ServiceBroker{ObjectMeta: kapi.ObjectMeta{Name: "upstream"}},
+	)
+	// the instance is kept here, the fake client doesn't store updates.
+	client.PrependReactor("get", "backingserviceinstances", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, kapi.Scheme.CopyOrDie(&bsi), nil
+	})
+	client.PrependReactor("update", "backingserviceinstances", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		bsi = *action.(ktestclient.UpdateAction).GetObject().(*backingserviceinstanceapi.BackingServiceInstance)
+		return true, &bsi, nil
+	})
+	broker := NewBroker(client, "market", func(sb *servicebrokerapi.ServiceBroker) (servicebrokerclient.Interface, error) {
+		return servicebrokerclient.NewClient(&servicebrokerclient.Config{URL: upstream.URL()})
+	})
+	ctx := context.Background()
+	instance := func() *backingserviceinstanceapi.BackingServiceInstance {
+		bsi, err := client.BackingServiceInstances("market").Get("osb-db")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return bsi
+	}
+	statusCode := func(err error) int {
+		if e, ok := err.(*servicebrokerclient.HTTPStatusCodeError); ok {
+			return e.StatusCode
+		}
+		return 0
+	}
+
+	resp, err := broker.Bind(ctx, "db", "app-1", &servicebrokerclient.BindRequest{AppGUID: "app"})
+	if err != nil || resp.Exists || len(resp.Credentials) == 0 {
+		t.Fatalf("expected a new binding, got %#v and %v", resp, err)
+	}
+	if bsi := instance(); bsi.Spec.Bound != 1 || bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseBound {
+		t.Errorf("expected the binding to count as bound, got %d bound and phase %s", bsi.Spec.Bound, bsi.Status.Phase)
+	}
+
+	// binding again the same way finds the binding, another way conflicts.
+	if resp, err := broker.Bind(ctx, "db", "app-1", &servicebrokerclient.BindRequest{AppGUID: "app"}); err != nil || !resp.Exists {
+		t.Errorf("expected the existing binding, got %#v and %v", resp, err)
+	}
+	if _, err := broker.Bind(ctx, "db", "app-1", &servicebrokerclient.BindRequest{AppGUID: "other"}); statusCode(err) != http.StatusConflict {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+
+	if _, err := broker.Deprovision(ctx, "db", &servicebrokerclient.DeprovisionRequest{AcceptsIncomplete: true}); statusCode(err) != http.StatusUnprocessableEntity {
+		t.Errorf("expected a bound instance not to be deprovisioned, got %v", err)
+	}
+
+	if err := broker.Unbind(ctx, "db", "app-1", &servicebrokerclient.UnbindRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bsi := instance(); bsi.Spec.Bound != 0 || bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseUnbound || len(upstream.Bindings) != 0 {
+		t.Errorf("expected the binding to be gone, got %d bound, phase %s and %d upstream bindings", bsi.Spec.Bound, bsi.Status.Phase, len(upstream.Bindings))
+	}
+	if err := broker.Unbind(ctx, "db", "app-1", &servicebrokerclient.UnbindRequest{}); statusCode(err) != http.StatusGone {
+		t.Errorf("expected an unknown binding to be gone, got %v", err)
+	}
+}
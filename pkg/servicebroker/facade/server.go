@@ -0,0 +1,242 @@
+package facade
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+)
+
+// Prefix is the path the broker is served under.
+const Prefix = "/v2"
+
+// brokerRequestTimeout bounds the requests forwarded to other brokers.
+const brokerRequestTimeout = servicebrokerclient.DefaultTimeout
+
+// Handler serves a broker over the Service Broker API v2 to the callers allowed to
+// manage the backing service instances of its namespace. Callers present an OAuth
+// token, or the token of a service account, either as a bearer token or as the
+// password of basic auth, which is what most platforms send to brokers.
+type Handler struct {
+	broker        servicebrokerclient.Interface
+	namespace     string
+	authenticator authenticator.Request
+	authorizer    authorizer.Authorizer
+}
+
+// NewHandler returns a Handler serving broker, authorizing callers in namespace.
+func NewHandler(broker servicebrokerclient.Interface, namespace string, authenticator authenticator.Request, authorizer authorizer.Authorizer) *Handler {
+	return &Handler{
+		broker:        broker,
+		namespace:     namespace,
+		authenticator: authenticator,
+		authorizer:    authorizer,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, Prefix), "/"), "/")
+
+	var verb, resource string
+	var serve func(ctx context.Context) (int, interface{}, error)
+	switch {
+	case len(parts) == 1 && parts[0] == "catalog" && req.Method == "GET":
+		verb, resource = "list", "backingservices"
+		serve = func(ctx context.Context) (int, interface{}, error) {
+			resp, err := h.broker.Catalog(ctx)
+			return http.StatusOK, resp, err
+		}
+	case len(parts) == 2 && parts[0] == "service_instances":
+		verb, resource, serve = h.instance(req, parts[1])
+	case len(parts) == 3 && parts[0] == "service_instances" && parts[2] == "last_operation" && req.Method == "GET":
+		verb, resource = "get", "backingserviceinstances"
+		serve = func(ctx context.Context) (int, interface{}, error) {
+			query := req.URL.Query()
+			resp, err := h.broker.LastOperation(ctx, parts[1], &servicebrokerclient.LastOperationRequest{
+				ServiceID: query.Get("service_id"),
+				PlanID:    query.Get("plan_id"),
+				Operation: query.Get("operation"),
+			})
+			return http.StatusOK, resp, err
+		}
+	case len(parts) == 4 && parts[0] == "service_instances" && parts[2] == "service_bindings":
+		verb, resource, serve = h.binding(req, parts[1], parts[3])
+	}
+	if serve == nil {
+		reply(w, http.StatusNotFound, servicebrokerclient.ErrorResponse{Description: req.Method + " " + req.URL.Path + " is not served"})
+		return
+	}
+
+	if code, reason := h.authorize(req, verb, resource); code != http.StatusOK {
+		if code == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Basic realm="openshift"`)
+		}
+		reply(w, code, servicebrokerclient.ErrorResponse{Description: reason})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerRequestTimeout)
+	defer cancel()
+	code, body, err := serve(ctx)
+	if err != nil {
+		replyError(w, err)
+		return
+	}
+	reply(w, code, body)
+}
+
+// instance returns how a request on the instance id is authorized and served.
+func (h *Handler) instance(req *http.Request, id string) (string, string, func(ctx context.Context) (int, interface{}, error)) {
+	acceptsIncomplete := req.URL.Query().Get("accepts_incomplete") == "true"
+
+	switch req.Method {
+	case "PUT":
+		return "create", "backingserviceinstances", func(ctx context.Context) (int, interface{}, error) {
+			r := &servicebrokerclient.ProvisionRequest{}
+			if err := decode(req, r); err != nil {
+				return 0, nil, err
+			}
+			r.AcceptsIncomplete = acceptsIncomplete
+			resp, err := h.broker.Provision(ctx, id, r)
+			if err != nil {
+				return 0, nil, err
+			}
+			return statusCode(resp.Async, createdCode(resp.Exists)), resp, nil
+		}
+	case "PATCH":
+		return "update", "backingserviceinstances", func(ctx context.Context) (int, interface{}, error) {
+			r := &servicebrokerclient.UpdateRequest{}
+			if err := decode(req, r); err != nil {
+				return 0, nil, err
+			}
+			r.AcceptsIncomplete = acceptsIncomplete
+			resp, err := h.broker.Update(ctx, id, r)
+			if err != nil {
+				return 0, nil, err
+			}
+			return statusCode(resp.Async, http.StatusOK), resp, nil
+		}
+	case "DELETE":
+		return "delete", "backingserviceinstances", func(ctx context.Context) (int, interface{}, error) {
+			query := req.URL.Query()
+			resp, err := h.broker.Deprovision(ctx, id, &servicebrokerclient.DeprovisionRequest{
+				ServiceID:         query.Get("service_id"),
+				PlanID:            query.Get("plan_id"),
+				AcceptsIncomplete: acceptsIncomplete,
+			})
+			if err != nil {
+				return 0, nil, err
+			}
+			return statusCode(resp.Async, http.StatusOK), resp, nil
+		}
+	}
+	return "", "", nil
+}
+
+// binding returns how a request on the binding id of instanceID is authorized and served.
+func (h *Handler) binding(req *http.Request, instanceID, id string) (string, string, func(ctx context.Context) (int, interface{}, error)) {
+	switch req.Method {
+	case "PUT":
+		return "create", "backingservicebindings", func(ctx context.Context) (int, interface{}, error) {
+			r := &servicebrokerclient.BindRequest{}
+			if err := decode(req, r); err != nil {
+				return 0, nil, err
+			}
+			resp, err := h.broker.Bind(ctx, instanceID, id, r)
+			if err != nil {
+				return 0, nil, err
+			}
+			return createdCode(resp.Exists), resp, nil
+		}
+	case "DELETE":
+		return "delete", "backingservicebindings", func(ctx context.Context) (int, interface{}, error) {
+			query := req.URL.Query()
+			err := h.broker.Unbind(ctx, instanceID, id, &servicebrokerclient.UnbindRequest{
+				ServiceID: query.Get("service_id"),
+				PlanID:    query.Get("plan_id"),
+			})
+			return http.StatusOK, struct{}{}, err
+		}
+	}
+	return "", "", nil
+}
+
+// authorize authenticates the caller of req and checks it may verb resource in the
+// namespace of the broker. It returns http.StatusOK if it may.
+func (h *Handler) authorize(req *http.Request, verb, resource string) (int, string) {
+	user, ok, err := h.authenticator.AuthenticateRequest(bearerRequest(req))
+	if err != nil || !ok {
+		return http.StatusUnauthorized, "Unauthorized"
+	}
+
+	ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), h.namespace), user)
+	allowed, reason, err := h.authorizer.Authorize(ctx, authorizer.DefaultAuthorizationAttributes{Verb: verb, Resource: resource})
+	if err != nil {
+		return http.StatusForbidden, err.Error()
+	}
+	if !allowed {
+		return http.StatusForbidden, reason
+	}
+	return http.StatusOK, ""
+}
+
+// bearerRequest returns req with the password of its basic auth as bearer token.
+func bearerRequest(req *http.Request) *http.Request {
+	_, password, ok := req.BasicAuth()
+	if !ok {
+		return req
+	}
+	r := *req
+	r.Header = http.Header{}
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set("Authorization", "Bearer "+password)
+	return &r
+}
+
+func decode(req *http.Request, into interface{}) error {
+	if err := json.NewDecoder(req.Body).Decode(into); err != nil {
+		return badRequest("invalid request body: %v", err)
+	}
+	return nil
+}
+
+// statusCode returns http.StatusAccepted for asynchronous operations, and code otherwise.
+func statusCode(async bool, code int) int {
+	if async {
+		return http.StatusAccepted
+	}
+	return code
+}
+
+// createdCode returns http.StatusOK for requests which found what they create
+// already there, and http.StatusCreated otherwise.
+func createdCode(exists bool) int {
+	if exists {
+		return http.StatusOK
+	}
+	return http.StatusCreated
+}
+
+func replyError(w http.ResponseWriter, err error) {
+	if e, ok := err.(*servicebrokerclient.HTTPStatusCodeError); ok {
+		reply(w, e.StatusCode, servicebrokerclient.ErrorResponse{Error: e.ErrorMessage, Description: e.Description})
+		return
+	}
+	glog.V(2).Infof("servicebroker facade error: %v", err)
+	reply(w, http.StatusInternalServerError, servicebrokerclient.ErrorResponse{Description: err.Error()})
+}
+
+func reply(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,152 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/watch"
+
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	projectservicebroker "github.com/openshift/origin/pkg/servicebroker/registry/projectservicebroker"
+)
+
+const ProjectServiceBrokerPath = "/projectservicebrokers"
+
+type REST struct {
+	store   *etcdgeneric.Etcd
+	secrets kclient.SecretsNamespacer
+}
+
+// NewREST returns a new REST. Inline credentials of the brokers are moved to
+// Secrets in their project with secrets before they are stored.
+func NewREST(s storage.Interface, secrets kclient.SecretsNamespacer) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc: func() runtime.Object {
+			return &servicebrokerapi.ProjectServiceBroker{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &servicebrokerapi.ProjectServiceBrokerList{}
+		},
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, ProjectServiceBrokerPath)
+		},
+		KeyFunc: func(ctx kapi.Context, id string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, ProjectServiceBrokerPath, id)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*servicebrokerapi.ProjectServiceBroker).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return projectservicebroker.Matcher(label, field)
+		},
+		EndpointName: "projectservicebroker",
+
+		CreateStrategy: projectservicebroker.PsbStrategy,
+		UpdateStrategy: projectservicebroker.PsbStrategy,
+
+		ReturnDeletedObject: false,
+
+		Storage: s,
+	}
+	return &REST{store: store, secrets: secrets}
+}
+
+func (r *REST) New() runtime.Object {
+	return r.store.NewFunc()
+}
+
+func (r *REST) NewList() runtime.Object {
+	return r.store.NewListFunc()
+}
+
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return r.store.Get(ctx, name)
+}
+
+func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return r.store.List(ctx, label, field)
+}
+
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	psb := obj.(*servicebrokerapi.ProjectServiceBroker)
+	if !servicebrokerclient.HasInlineCredentials(&psb.Spec) {
+		return r.store.Create(ctx, obj)
+	}
+
+	// The credentials are moved under the name of the broker, make sure it is
+	// valid and not someone else's before touching its secret.
+	if err := rest.BeforeCreate(projectservicebroker.PsbStrategy, ctx, obj); err != nil {
+		return nil, err
+	}
+	if _, err := r.store.Get(ctx, psb.Name); err == nil {
+		return nil, errors.NewAlreadyExists("projectservicebroker", psb.Name)
+	}
+	undo, err := servicebrokerclient.MoveCredentials(r.secrets, kapi.NamespaceValue(ctx), psb.Name, &psb.Spec)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.store.Create(ctx, obj)
+	if err != nil {
+		undo()
+	}
+	return result, err
+}
+
+func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	psb := obj.(*servicebrokerapi.ProjectServiceBroker)
+	if !servicebrokerclient.HasInlineCredentials(&psb.Spec) {
+		return r.store.Update(ctx, obj)
+	}
+
+	// Only move the credentials of a valid update, and put the previous ones
+	// back if the broker fails to save.
+	old, err := r.store.Get(ctx, psb.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := rest.BeforeUpdate(projectservicebroker.PsbStrategy, ctx, obj, old); err != nil {
+		return nil, false, err
+	}
+	undo, err := servicebrokerclient.MoveCredentials(r.secrets, kapi.NamespaceValue(ctx), psb.Name, &psb.Spec)
+	if err != nil {
+		return nil, false, err
+	}
+	result, created, err := r.store.Update(ctx, obj)
+	if err != nil {
+		undo()
+	}
+	return result, created, err
+}
+
+// Delete marks the broker Deleting, the controller deletes it for good once
+// its services are deactivated.
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	obj, err := r.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	psb := obj.(*servicebrokerapi.ProjectServiceBroker)
+
+	if psb.DeletionTimestamp.IsZero() {
+		now := unversioned.Now()
+		psb.DeletionTimestamp = &now
+		psb.Status.Phase = servicebrokerapi.ServiceBrokerDeleting
+		result, _, err := r.store.Update(ctx, psb)
+		return result, err
+	}
+
+	return r.store.Delete(ctx, name, options)
+}
+
+func (r *REST) Watch(ctx kapi.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return r.store.Watch(ctx, label, field, resourceVersion)
+}
@@ -0,0 +1,75 @@
+package projectservicebroker
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+
+	"github.com/openshift/origin/pkg/servicebroker/api"
+	"github.com/openshift/origin/pkg/servicebroker/api/validation"
+)
+
+// Strategy implements behavior for ProjectServiceBrokers
+type Strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// PsbStrategy is the default logic that applies when creating and updating
+// ProjectServiceBroker objects via the REST API.
+var PsbStrategy = Strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true for projectservicebrokers
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+func (Strategy) PrepareForCreate(obj runtime.Object) {
+	psb := obj.(*api.ProjectServiceBroker)
+	psb.Status.Phase = api.ServiceBrokerNew
+	defaultSecretRefs(psb)
+}
+
+func (Strategy) PrepareForUpdate(obj, old runtime.Object) {
+	defaultSecretRefs(obj.(*api.ProjectServiceBroker))
+}
+
+// defaultSecretRefs fills in the namespace of the broker, the only one its Secrets may be in.
+func defaultSecretRefs(psb *api.ProjectServiceBroker) {
+	for _, ref := range []*api.SecretReference{psb.Spec.AuthSecretRef, psb.Spec.CASecretRef, psb.Spec.ClientCertSecretRef} {
+		if ref != nil && len(ref.Namespace) == 0 {
+			ref.Namespace = psb.Namespace
+		}
+	}
+}
+
+// Validate validates a new projectservicebroker
+func (Strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateProjectServiceBroker(obj.(*api.ProjectServiceBroker))
+}
+
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for a projectservicebroker
+func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateProjectServiceBrokerUpdate(obj.(*api.ProjectServiceBroker), old.(*api.ProjectServiceBroker))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return &generic.SelectionPredicate{Label: label, Field: field, GetAttrs: getAttrs}
+}
+
+func getAttrs(obj runtime.Object) (objLabels labels.Set, objFields fields.Set, err error) {
+	psb := obj.(*api.ProjectServiceBroker)
+	return labels.Set(psb.Labels), api.ProjectServiceBrokerToSelectableFields(psb), nil
+}
@@ -2,6 +2,8 @@ package etcd
 
 import (
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
@@ -10,17 +12,21 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 
 	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
 	servicebroker "github.com/openshift/origin/pkg/servicebroker/registry/servicebroker"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
 type REST struct {
-	store *etcdgeneric.Etcd
+	store   *etcdgeneric.Etcd
+	secrets kclient.SecretsNamespacer
 }
 
-// NewREST returns a new REST.
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a new REST. Inline credentials of the brokers are moved to
+// Secrets with secrets before they are stored.
+func NewREST(s storage.Interface, secrets kclient.SecretsNamespacer) *REST {
 	prefix := "/servicebrokers"
 	store := &etcdgeneric.Etcd{
 		NewFunc:     func() runtime.Object {
@@ -50,7 +56,7 @@ func NewREST(s storage.Interface) *REST {
 
 		Storage: s,
 	}
-	return &REST{store: store}
+	return &REST{store: store, secrets: secrets}
 }
 
 /// New returns a new object
@@ -75,15 +81,57 @@ func (r *REST) List(ctx kapi.Context, label labels.Selector, field fields.Select
 // Create creates an image based on a specification.
 func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
 
-	servicebroker := obj.(*servicebrokerapi.ServiceBroker)
-	servicebroker.Status.Phase = servicebrokerapi.ServiceBrokerNew
+	sb := obj.(*servicebrokerapi.ServiceBroker)
+	sb.Status.Phase = servicebrokerapi.ServiceBrokerNew
 
-	return r.store.Create(ctx, obj)
+	if !servicebrokerclient.HasInlineCredentials(&sb.Spec) {
+		return r.store.Create(ctx, obj)
+	}
+
+	// The credentials are moved under the name of the broker, make sure it is
+	// valid and not someone else's before touching its secret.
+	if err := rest.BeforeCreate(servicebroker.SbStrategy, ctx, obj); err != nil {
+		return nil, err
+	}
+	if _, err := r.store.Get(ctx, sb.Name); err == nil {
+		return nil, errors.NewAlreadyExists("servicebroker", sb.Name)
+	}
+	undo, err := servicebrokerclient.MoveCredentials(r.secrets, servicebrokerapi.ServiceBrokerSecretNamespace, sb.Name, &sb.Spec)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.store.Create(ctx, obj)
+	if err != nil {
+		undo()
+	}
+	return result, err
 }
 
 // Update alters an existing image.
 func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
-	return r.store.Update(ctx, obj)
+	sb := obj.(*servicebrokerapi.ServiceBroker)
+	if !servicebrokerclient.HasInlineCredentials(&sb.Spec) {
+		return r.store.Update(ctx, obj)
+	}
+
+	// Only move the credentials of a valid update, and put the previous ones
+	// back if the broker fails to save.
+	old, err := r.store.Get(ctx, sb.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := rest.BeforeUpdate(servicebroker.SbStrategy, ctx, obj, old); err != nil {
+		return nil, false, err
+	}
+	undo, err := servicebrokerclient.MoveCredentials(r.secrets, servicebrokerapi.ServiceBrokerSecretNamespace, sb.Name, &sb.Spec)
+	if err != nil {
+		return nil, false, err
+	}
+	result, created, err := r.store.Update(ctx, obj)
+	if err != nil {
+		undo()
+	}
+	return result, created, err
 }
 
 // Delete deletes an existing image specified by its ID.
@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/fielderrors"
 
 	"github.com/openshift/origin/pkg/servicebroker/api"
+	"github.com/openshift/origin/pkg/servicebroker/api/validation"
 )
 
 // sdnStrategy implements behavior for HostSubnets
@@ -20,7 +21,16 @@ type Strategy struct {
 // objects via the REST API.
 var SbStrategy = Strategy{kapi.Scheme}
 
-func (Strategy) PrepareForUpdate(obj, old runtime.Object) {}
+func (Strategy) PrepareForUpdate(obj, old runtime.Object) {
+	defaultAuthSecretRef(obj.(*api.ServiceBroker))
+}
+
+// defaultAuthSecretRef fills in the only namespace AuthSecretRef may refer to.
+func defaultAuthSecretRef(sb *api.ServiceBroker) {
+	if ref := sb.Spec.AuthSecretRef; ref != nil && len(ref.Namespace) == 0 {
+		ref.Namespace = api.ServiceBrokerSecretNamespace
+	}
+}
 
 // NamespaceScoped is false for sdns
 func (Strategy) NamespaceScoped() bool {
@@ -32,11 +42,12 @@ func (Strategy) GenerateName(base string) string {
 }
 
 func (Strategy) PrepareForCreate(obj runtime.Object) {
+	defaultAuthSecretRef(obj.(*api.ServiceBroker))
 }
 
-// Validate validates a new sdn
+// Validate validates a new servicebroker
 func (Strategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
-	return fielderrors.ValidationErrorList{}
+	return validation.ValidateServiceBrokerSpec(&obj.(*api.ServiceBroker).Spec).Prefix("spec")
 }
 
 // AllowCreateOnUpdate is false for sdns
@@ -50,7 +61,7 @@ func (Strategy) AllowUnconditionalUpdate() bool {
 
 // ValidateUpdate is the default update validation for a HostSubnet
 func (Strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) fielderrors.ValidationErrorList {
-	return fielderrors.ValidationErrorList{}
+	return validation.ValidateServiceBrokerSpec(&obj.(*api.ServiceBroker).Spec).Prefix("spec")
 }
 
 // Matcher returns a generic matcher for a given label and field selector.
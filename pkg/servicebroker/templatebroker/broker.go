@@ -0,0 +1,403 @@
+// Package templatebroker is a service broker running in process, which offers the
+// Templates published in the openshift namespace as services. Provisioning
+// instantiates a template into the project of the instance, binding hands out the
+// credentials of the Secrets and Services the template created and deprovisioning
+// deletes what it created.
+package templatebroker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+	servicebrokerclient "github.com/openshift/origin/pkg/servicebroker/client"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+const (
+	// ServiceBrokerName is the name of the ServiceBroker the template broker registers.
+	ServiceBrokerName = "template-service-broker"
+	// URL is the url of the template broker, which is served in process.
+	URL = servicebrokerapi.InProcessURLScheme + "://templates"
+	// DefaultNamespace is the namespace of the Templates offered.
+	DefaultNamespace = "openshift"
+
+	// PublishedLabel marks the Templates offered as services.
+	PublishedLabel = "asiainfo.io/templatebroker"
+	// InstanceLabel labels the objects created for an instance with its id.
+	InstanceLabel = "asiainfo.io/templatebroker-instance"
+	// CredentialsAnnotation marks the Secrets and Services whose credentials are handed
+	// out by binds. Its value prefixes the credential keys, unless it is "true".
+	CredentialsAnnotation = "asiainfo.io/templatebroker-credentials"
+
+	// PlanName is the name of the single plan of a template.
+	PlanName = "default"
+)
+
+// Broker is the template service broker.
+type Broker struct {
+	client     *osclient.Client
+	kubeClient *kclient.Client
+	// namespace holds the Templates offered and the records of the instances.
+	namespace string
+}
+
+// NewBroker returns a broker offering the Templates of namespace.
+func NewBroker(client *osclient.Client, kubeClient *kclient.Client, namespace string) *Broker {
+	return &Broker{client: client, kubeClient: kubeClient, namespace: namespace}
+}
+
+// ClientFunc returns broker for the ServiceBroker the template broker registers, and
+// the clients next returns for the others.
+func ClientFunc(broker *Broker, next servicebrokerclient.ClientFunc) servicebrokerclient.ClientFunc {
+	return func(sb *servicebrokerapi.ServiceBroker) (servicebrokerclient.Interface, error) {
+		if len(sb.Namespace) == 0 && sb.Spec.Url == URL {
+			return broker, nil
+		}
+		return next(sb)
+	}
+}
+
+var _ servicebrokerclient.Interface = &Broker{}
+
+// Catalog offers a service with a single plan for every published Template.
+func (b *Broker) Catalog(ctx context.Context) (*servicebrokerclient.CatalogResponse, error) {
+	templates, err := b.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &servicebrokerclient.CatalogResponse{Services: []servicebrokerclient.Service{}}
+	for i := range templates.Items {
+		catalog.Services = append(catalog.Services, serviceFor(&templates.Items[i]))
+	}
+	return catalog, nil
+}
+
+// Provision instantiates the Template of the service into the project of the instance.
+func (b *Broker) Provision(ctx context.Context, instanceID string, r *servicebrokerclient.ProvisionRequest) (*servicebrokerclient.ProvisionResponse, error) {
+	if len(r.OrganizationGUID) == 0 {
+		return nil, badRequest("organization_guid must be the project of the instance")
+	}
+
+	record, err := b.getRecord(instanceID)
+	switch {
+	case err == nil:
+		if record.ServiceID != r.ServiceID || record.Namespace != r.OrganizationGUID {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusConflict, Description: "instance " + instanceID + " already exists"}
+		}
+		return &servicebrokerclient.ProvisionResponse{Exists: true}, nil
+	case !kerrors.IsNotFound(err):
+		return nil, err
+	}
+
+	template, err := b.template(r.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := setParameters(template, r.Parameters); err != nil {
+		return nil, badRequest(err.Error())
+	}
+	if template.ObjectLabels == nil {
+		template.ObjectLabels = map[string]string{}
+	}
+	template.ObjectLabels[InstanceLabel] = instanceID
+
+	processed, err := b.client.TemplateConfigs(r.OrganizationGUID).Create(template)
+	if err != nil {
+		if kerrors.IsInvalid(err) {
+			return nil, badRequest(err.Error())
+		}
+		return nil, err
+	}
+	if err := utilerrors.NewAggregate(runtime.DecodeList(processed.Objects, kapi.Scheme)); err != nil {
+		return nil, err
+	}
+
+	record = &instanceRecord{
+		ServiceID: r.ServiceID,
+		Template:  template.Name,
+		Namespace: r.OrganizationGUID,
+	}
+	for _, obj := range processed.Objects {
+		info, err := b.mapper().InfoForObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		record.Objects = append(record.Objects, objectReference{Kind: info.Mapping.Kind, APIVersion: info.Mapping.APIVersion, Name: info.Name})
+	}
+
+	// the record goes first, deprovisioning finds what a failed provisioning left.
+	if err := b.createRecord(instanceID, record); err != nil {
+		return nil, err
+	}
+
+	list := &kapi.List{Items: processed.Objects}
+	if errs := b.bulk().Create(list, r.OrganizationGUID); len(errs) > 0 {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError, Description: utilerrors.NewAggregate(errs).Error()}
+	}
+
+	glog.V(4).Infof("template %s instantiated into %s for instance %s", template.Name, r.OrganizationGUID, instanceID)
+	return &servicebrokerclient.ProvisionResponse{}, nil
+}
+
+// Update is refused, templates have a single plan and are not updated in place.
+func (b *Broker) Update(ctx context.Context, instanceID string, r *servicebrokerclient.UpdateRequest) (*servicebrokerclient.UpdateResponse, error) {
+	return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, Description: "template instances can't be updated"}
+}
+
+// Deprovision deletes the objects created for the instance.
+func (b *Broker) Deprovision(ctx context.Context, instanceID string, r *servicebrokerclient.DeprovisionRequest) (*servicebrokerclient.DeprovisionResponse, error) {
+	record, err := b.getRecord(instanceID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return &servicebrokerclient.DeprovisionResponse{}, nil
+		}
+		return nil, err
+	}
+
+	errs := []error{}
+	for _, ref := range record.Objects {
+		mapping, err := b.mapper().RESTMapping(ref.Kind, ref.APIVersion)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		client, err := b.restClient(mapping)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := resource.NewHelper(client, mapping).Delete(record.Namespace, ref.Name); err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusInternalServerError, Description: utilerrors.NewAggregate(errs).Error()}
+	}
+
+	if err := b.deleteRecord(instanceID); err != nil && !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	return &servicebrokerclient.DeprovisionResponse{}, nil
+}
+
+// Bind returns the credentials of the annotated Secrets and Services of the instance.
+// Every binding of an instance gets the same credentials.
+func (b *Broker) Bind(ctx context.Context, instanceID, bindingID string, r *servicebrokerclient.BindRequest) (*servicebrokerclient.BindResponse, error) {
+	record, err := b.getRecord(instanceID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, badRequest("instance " + instanceID + " doesn't exist")
+		}
+		return nil, err
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{InstanceLabel: instanceID})
+	credentials := map[string]interface{}{}
+
+	secrets, err := b.kubeClient.Secrets(record.Namespace).List(selector, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		prefix, ok := credentialsPrefix(secret.Annotations)
+		if !ok {
+			continue
+		}
+		for k, v := range secret.Data {
+			credentials[prefix+k] = string(v)
+		}
+	}
+
+	services, err := b.kubeClient.Services(record.Namespace).List(selector, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		prefix, ok := credentialsPrefix(service.Annotations)
+		if !ok {
+			continue
+		}
+		credentials[prefix+"host"] = service.Name + "." + service.Namespace + ".svc"
+		if len(service.Spec.Ports) > 0 {
+			credentials[prefix+"port"] = strconv.Itoa(service.Spec.Ports[0].Port)
+		}
+	}
+
+	return &servicebrokerclient.BindResponse{Credentials: credentials}, nil
+}
+
+// Unbind has nothing to revoke, the credentials belong to the instance.
+func (b *Broker) Unbind(ctx context.Context, instanceID, bindingID string, r *servicebrokerclient.UnbindRequest) error {
+	return nil
+}
+
+// LastOperation reports the operations of the broker, which are all synchronous, done.
+func (b *Broker) LastOperation(ctx context.Context, instanceID string, r *servicebrokerclient.LastOperationRequest) (*servicebrokerclient.LastOperationResponse, error) {
+	if _, err := b.getRecord(instanceID); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusGone}
+		}
+		return nil, err
+	}
+	return &servicebrokerclient.LastOperationResponse{State: servicebrokerclient.StateSucceeded}, nil
+}
+
+// templates lists the published Templates.
+func (b *Broker) templates() (*templateapi.TemplateList, error) {
+	selector := labels.SelectorFromSet(labels.Set{PublishedLabel: "true"})
+	return b.client.Templates(b.namespace).List(selector, fields.Everything())
+}
+
+// template returns the published Template offered as the service serviceID.
+func (b *Broker) template(serviceID string) (*templateapi.Template, error) {
+	templates, err := b.templates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range templates.Items {
+		if string(templates.Items[i].UID) == serviceID {
+			return &templates.Items[i], nil
+		}
+	}
+	return nil, badRequest("service " + serviceID + " isn't offered")
+}
+
+// serviceFor returns the service a Template is offered as, its parameters are the
+// parameters of the plan.
+func serviceFor(template *templateapi.Template) servicebrokerclient.Service {
+	description := template.Annotations["description"]
+	if len(description) == 0 {
+		description = "Instantiates the template " + template.Name
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(template.Annotations["tags"], ",") {
+		if tag = strings.TrimSpace(tag); len(tag) > 0 {
+			tags = append(tags, tag)
+		}
+	}
+
+	free := true
+	return servicebrokerclient.Service{
+		Name:        template.Name,
+		ID:          string(template.UID),
+		Description: description,
+		Tags:        tags,
+		Bindable:    true,
+		Plans: []servicebrokerclient.Plan{{
+			ID:          string(template.UID) + "-" + PlanName,
+			Name:        PlanName,
+			Description: description,
+			Free:        &free,
+			Schemas: &servicebrokerclient.PlanSchemas{
+				ServiceInstance: &servicebrokerclient.ServiceInstanceSchema{
+					Create: &servicebrokerclient.InputParameters{Parameters: parametersSchema(template)},
+				},
+			},
+		}},
+	}
+}
+
+// parametersSchema returns the JSON schema of the parameters of template. Parameters
+// which are required and have neither a value nor a generator are required.
+func parametersSchema(template *templateapi.Template) json.RawMessage {
+	type property struct {
+		Type        string `json:"type"`
+		Description string `json:"description,omitempty"`
+		Default     string `json:"default,omitempty"`
+	}
+	schema := struct {
+		Type       string              `json:"type"`
+		Properties map[string]property `json:"properties"`
+		Required   []string            `json:"required,omitempty"`
+	}{Type: "object", Properties: map[string]property{}}
+
+	for _, param := range template.Parameters {
+		description := param.Description
+		if len(description) == 0 {
+			description = param.DisplayName
+		}
+		schema.Properties[param.Name] = property{Type: "string", Description: description, Default: param.Value}
+		if param.Required && len(param.Value) == 0 && len(param.Generate) == 0 {
+			schema.Required = append(schema.Required, param.Name)
+		}
+	}
+	sort.Strings(schema.Required)
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// setParameters sets the parameters of a provision request as the values of the
+// parameters of template.
+func setParameters(template *templateapi.Template, params map[string]interface{}) error {
+	for name, value := range params {
+		found := false
+		for i := range template.Parameters {
+			if template.Parameters[i].Name != name {
+				continue
+			}
+			found = true
+			switch v := value.(type) {
+			case string:
+				template.Parameters[i].Value = v
+			case bool:
+				template.Parameters[i].Value = strconv.FormatBool(v)
+			case float64:
+				template.Parameters[i].Value = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				data, err := json.Marshal(v)
+				if err != nil {
+					return fmt.Errorf("parameter %s: %v", name, err)
+				}
+				template.Parameters[i].Value = string(data)
+			}
+			// a value given replaces the generated one.
+			template.Parameters[i].Generate = ""
+		}
+		if !found {
+			return fmt.Errorf("template %s has no parameter %s", template.Name, name)
+		}
+	}
+	return nil
+}
+
+// credentialsPrefix returns the prefix of the credentials of an object annotated
+// with CredentialsAnnotation, and false if it isn't.
+func credentialsPrefix(annotations map[string]string) (string, bool) {
+	value, ok := annotations[CredentialsAnnotation]
+	if !ok {
+		return "", false
+	}
+	if value == "true" {
+		return "", true
+	}
+	return value, true
+}
+
+func badRequest(description string) error {
+	return &servicebrokerclient.HTTPStatusCodeError{StatusCode: http.StatusBadRequest, Description: description}
+}
@@ -0,0 +1,98 @@
+package templatebroker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+func newTemplate() *templateapi.Template {
+	return &templateapi.Template{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "mysql-ephemeral",
+			UID:         "1234",
+			Annotations: map[string]string{"description": "MySQL database", "tags": "database, mysql"},
+		},
+		Parameters: []templateapi.Parameter{
+			{Name: "DATABASE_SERVICE_NAME", Value: "mysql", Required: true},
+			{Name: "MYSQL_PASSWORD", Generate: "expression", From: "[a-zA-Z0-9]{16}", Required: true},
+			{Name: "MYSQL_DATABASE", Description: "Name of the database", Required: true},
+			{Name: "MEMORY_LIMIT", Value: "512Mi"},
+		},
+	}
+}
+
+func TestServiceFor(t *testing.T) {
+	service := serviceFor(newTemplate())
+
+	if service.ID != "1234" || service.Name != "mysql-ephemeral" || service.Description != "MySQL database" || !service.Bindable {
+		t.Errorf("unexpected service %#v", service)
+	}
+	if !reflect.DeepEqual(service.Tags, []string{"database", "mysql"}) {
+		t.Errorf("unexpected tags %v", service.Tags)
+	}
+	if len(service.Plans) != 1 || service.Plans[0].ID != "1234-default" {
+		t.Fatalf("unexpected plans %#v", service.Plans)
+	}
+
+	schema := struct {
+		Properties map[string]struct {
+			Type    string `json:"type"`
+			Default string `json:"default"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}{}
+	if err := json.Unmarshal(service.Plans[0].Schemas.ServiceInstance.Create.Parameters, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Properties) != 4 || schema.Properties["MEMORY_LIMIT"].Default != "512Mi" {
+		t.Errorf("unexpected properties %#v", schema.Properties)
+	}
+	if !reflect.DeepEqual(schema.Required, []string{"MYSQL_DATABASE"}) {
+		t.Errorf("expected only the parameters without value nor generator to be required, got %v", schema.Required)
+	}
+}
+
+func TestSetParameters(t *testing.T) {
+	template := newTemplate()
+	err := setParameters(template, map[string]interface{}{
+		"MYSQL_PASSWORD": "secret",
+		"MYSQL_DATABASE": "orders",
+		"MEMORY_LIMIT":   float64(1024),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[string]string{}
+	for _, param := range template.Parameters {
+		values[param.Name] = param.Value
+		if param.Name == "MYSQL_PASSWORD" && len(param.Generate) > 0 {
+			t.Errorf("expected the value given to replace the generated one")
+		}
+	}
+	expected := map[string]string{"DATABASE_SERVICE_NAME": "mysql", "MYSQL_PASSWORD": "secret", "MYSQL_DATABASE": "orders", "MEMORY_LIMIT": "1024"}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+
+	if err := setParameters(newTemplate(), map[string]interface{}{"UNKNOWN": "x"}); err == nil {
+		t.Errorf("expected an unknown parameter to be refused")
+	}
+}
+
+func TestCredentialsPrefix(t *testing.T) {
+	if _, ok := credentialsPrefix(map[string]string{}); ok {
+		t.Errorf("expected objects without the annotation to be skipped")
+	}
+	if prefix, ok := credentialsPrefix(map[string]string{CredentialsAnnotation: "true"}); !ok || prefix != "" {
+		t.Errorf("expected no prefix, got %q", prefix)
+	}
+	if prefix, ok := credentialsPrefix(map[string]string{CredentialsAnnotation: "db_"}); !ok || prefix != "db_" {
+		t.Errorf("expected the prefix db_, got %q", prefix)
+	}
+}
@@ -0,0 +1,88 @@
+package templatebroker
+
+import (
+	"encoding/json"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	configcmd "github.com/openshift/origin/pkg/config/cmd"
+)
+
+// instanceRecordKey is the key of the record in the data of its Secret.
+const instanceRecordKey = "instance"
+
+// instanceRecord is what the broker keeps about an instance: where the Template was
+// instantiated and the objects it created.
+type instanceRecord struct {
+	ServiceID string            `json:"serviceID"`
+	Template  string            `json:"template"`
+	Namespace string            `json:"namespace"`
+	Objects   []objectReference `json:"objects"`
+}
+
+type objectReference struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Name       string `json:"name"`
+}
+
+// recordName returns the name of the Secret holding the record of an instance. The
+// records are kept with the Templates, deprovision requests don't name the project.
+func recordName(instanceID string) string {
+	return "templatebroker-" + instanceID
+}
+
+func (b *Broker) getRecord(instanceID string) (*instanceRecord, error) {
+	secret, err := b.kubeClient.Secrets(b.namespace).Get(recordName(instanceID))
+	if err != nil {
+		return nil, err
+	}
+	record := &instanceRecord{}
+	if err := json.Unmarshal(secret.Data[instanceRecordKey], record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (b *Broker) createRecord(instanceID string, record *instanceRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:   recordName(instanceID),
+			Labels: map[string]string{InstanceLabel: instanceID},
+		},
+		Type: kapi.SecretTypeOpaque,
+		Data: map[string][]byte{instanceRecordKey: data},
+	}
+	_, err = b.kubeClient.Secrets(b.namespace).Create(secret)
+	return err
+}
+
+func (b *Broker) deleteRecord(instanceID string) error {
+	return b.kubeClient.Secrets(b.namespace).Delete(recordName(instanceID))
+}
+
+func (b *Broker) restClient(mapping *meta.RESTMapping) (resource.RESTClient, error) {
+	if latest.OriginKind(mapping.Kind, mapping.APIVersion) {
+		return b.client, nil
+	}
+	return b.kubeClient, nil
+}
+
+func (b *Broker) mapper() *resource.Mapper {
+	return &resource.Mapper{ObjectTyper: kapi.Scheme, RESTMapper: latest.RESTMapper, ClientMapper: resource.ClientMapperFunc(b.restClient)}
+}
+
+func (b *Broker) bulk() *configcmd.Bulk {
+	return &configcmd.Bulk{
+		Mapper:            latest.RESTMapper,
+		Typer:             kapi.Scheme,
+		RESTClientFactory: b.restClient,
+	}
+}
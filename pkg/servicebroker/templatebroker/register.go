@@ -0,0 +1,37 @@
+package templatebroker
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	servicebrokerapi "github.com/openshift/origin/pkg/servicebroker/api"
+)
+
+// registerRetryInterval is how often registering the broker is retried while the api
+// server isn't ready.
+const registerRetryInterval = 10 * time.Second
+
+// Register creates the ServiceBroker of the template broker unless it exists, retrying
+// in the background until it succeeds.
+func Register(client osclient.Interface) {
+	stop := make(chan struct{})
+	go util.Until(func() {
+		sb := &servicebrokerapi.ServiceBroker{
+			ObjectMeta: kapi.ObjectMeta{Name: ServiceBrokerName},
+			Spec: servicebrokerapi.ServiceBrokerSpec{
+				Url:  URL,
+				Name: ServiceBrokerName,
+			},
+		}
+		if _, err := client.ServiceBrokers().Create(sb); err != nil && !kerrors.IsAlreadyExists(err) {
+			glog.V(2).Infof("failed to register the template service broker, will retry: %v", err)
+			return
+		}
+		close(stop)
+	}, registerRetryInterval, stop)
+}
@@ -7,6 +7,8 @@ func newFakeTemplateRouter() templateRouter {
 	return templateRouter{
 		state:       map[string]ServiceUnit{},
 		certManager: fakeCertManager,
+		// the fake router is taken to run a version of HAProxy supporting route services.
+		routeServices: true,
 	}
 }
 
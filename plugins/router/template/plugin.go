@@ -39,6 +39,9 @@ type TemplatePluginConfig struct {
 	StatsPassword      string
 	IncludeUDP         bool
 	PeerService        *ktypes.NamespacedName
+	RouteServiceSecret string
+	// RouteServices is true if the router can forward routes through route services.
+	RouteServices bool
 }
 
 // routerInterface controls the interaction of the plugin with the underlying router implementation
@@ -75,6 +78,7 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
 		"endpointsForAlias": endpointsForAlias,
+		"hasRouteServices":  hasRouteServices,
 	}
 	masterTemplate, err := template.New("config").Funcs(globalFuncs).ParseFiles(cfg.TemplatePath)
 	if err != nil {
@@ -104,6 +108,8 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 		statsPassword:      cfg.StatsPassword,
 		statsPort:          cfg.StatsPort,
 		peerEndpointsKey:   peerKey,
+		routeServiceSecret: []byte(cfg.RouteServiceSecret),
+		routeServices:      cfg.RouteServices,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
 	return newDefaultTemplatePlugin(router, cfg.IncludeUDP), err
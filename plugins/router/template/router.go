@@ -64,6 +64,11 @@ type templateRouter struct {
 	statsPassword string
 	// if the router can expose statistics it should expose them with this port
 	statsPort int
+	// routeServiceSecret signs the requests route services forward back to their routes
+	routeServiceSecret []byte
+	// routeServices is false if the router cannot forward routes through route services,
+	// the routes with a route service are then not served.
+	routeServices bool
 }
 
 // templateRouterCfg holds all configuration items required to initialize the template router
@@ -77,6 +82,8 @@ type templateRouterCfg struct {
 	statsPort          int
 	peerEndpointsKey   string
 	includeUDP         bool
+	routeServiceSecret []byte
+	routeServices      bool
 }
 
 // templateConfig is a subset of the templateRouter information that should be passed to the template for generating
@@ -130,6 +137,8 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 		statsPort:              cfg.statsPort,
 		peerEndpointsKey:       cfg.peerEndpointsKey,
 		peerEndpoints:          []Endpoint{},
+		routeServiceSecret:     cfg.routeServiceSecret,
+		routeServices:          cfg.routeServices,
 	}
 	if err := router.writeDefaultCert(); err != nil {
 		return nil, err
@@ -408,6 +417,25 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 		}
 	}
 
+	if routeServiceURL := route.Annotations[routeapi.RouteServiceURLAnnotation]; len(routeServiceURL) > 0 {
+		routeService, err := newRouteService(backendKey, routeServiceURL, r.routeServiceSecret)
+		if err == nil && !r.routeServices {
+			err = fmt.Errorf("this router cannot forward routes through route service %s", routeServiceURL)
+		}
+		if err == nil && config.TLSTermination == routeapi.TLSTerminationPassthrough {
+			err = fmt.Errorf("passthrough routes cannot be forwarded through route service %s", routeServiceURL)
+		}
+		if err != nil {
+			// not served at all rather than served without its route service.
+			glog.Errorf("Route %s/%s is not served: %v", route.Namespace, route.Name, err)
+			delete(frontend.ServiceAliasConfigs, backendKey)
+			r.state[id] = frontend
+			r.cleanUpdates(id, backendKey)
+			return true
+		}
+		config.RouteService = routeService
+	}
+
 	//create or replace
 	frontend.ServiceAliasConfigs[backendKey] = config
 	r.state[id] = frontend
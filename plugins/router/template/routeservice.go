@@ -0,0 +1,115 @@
+package templaterouter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	kvalidation "k8s.io/kubernetes/pkg/util/validation"
+)
+
+// routeServiceMaxAge is the number of seconds a route service has to forward a request
+// back through the router once it was signed. Older signatures are refused, so that a
+// request seen by someone else cannot be replayed past the route service later on.
+const routeServiceMaxAge = 60
+
+// routeServiceMinVersion is the first version of HAProxy with the set-var-fmt action and
+// the hmac and strcmp converters the template checks route service signatures with.
+var routeServiceMinVersion = []int{2, 6}
+
+// haproxyVersionPattern matches the version in the output of haproxy -v.
+var haproxyVersionPattern = regexp.MustCompile(`HA-?Proxy version (\d+)\.(\d+)`)
+
+// SupportsRouteServices returns true if versionOutput, the output of haproxy -v, is from a
+// version of HAProxy able to forward routes through route services.
+func SupportsRouteServices(versionOutput string) bool {
+	match := haproxyVersionPattern.FindStringSubmatch(versionOutput)
+	if match == nil {
+		return false
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major > routeServiceMinVersion[0] || major == routeServiceMinVersion[0] && minor >= routeServiceMinVersion[1]
+}
+
+// newRouteService returns the route service requests to the route with backendKey are
+// forwarded through before reaching its endpoints. Every request sent to the route service
+// is signed with the time it was sent, using a key computed from secret, and the route
+// service forwards it back with that signature.
+//
+// The host of the route service is resolved by HAProxy, which follows its addresses as
+// they change. Until it can be resolved the route answers 503 rather than being served
+// without its route service.
+func newRouteService(backendKey, rawURL string, secret []byte) (*RouteService, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if len(u.Host) == 0 {
+		return nil, fmt.Errorf("route service %q has no host", rawURL)
+	}
+
+	service := &RouteService{
+		URL:  rawURL,
+		Host: u.Host,
+		// the path is set with a log-format string in which % must be escaped.
+		Path:   strings.Replace(u.RequestURI(), "%", "%%", -1),
+		Key:    routeServiceKey(backendKey, rawURL, secret),
+		MaxAge: routeServiceMaxAge,
+	}
+	port := "80"
+	switch u.Scheme {
+	case "http":
+	case "https":
+		service.TLS = true
+		port = "443"
+	default:
+		return nil, fmt.Errorf("route service %q is neither http nor https", rawURL)
+	}
+
+	host := u.Host
+	if h, p, err := net.SplitHostPort(u.Host); err == nil {
+		host, port = h, p
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	// the host and the port are written to the configuration as they are, nothing but a
+	// host name or an IP address and a port number is accepted.
+	if !kvalidation.IsDNS1123Subdomain(strings.ToLower(host)) && net.ParseIP(host) == nil {
+		return nil, fmt.Errorf("route service %q has an invalid host", rawURL)
+	}
+	if p, err := strconv.Atoi(port); err != nil || !kvalidation.IsValidPortNum(p) {
+		return nil, fmt.Errorf("route service %q has an invalid port", rawURL)
+	}
+	service.ServerName = host
+	service.Address = net.JoinHostPort(host, port)
+	return service, nil
+}
+
+// routeServiceKey returns the base64 key requests to the route service of the route with
+// backendKey are signed with. It only changes with the route service or the secret of the
+// router, and is distinct for every route so that a signature for one route is never
+// accepted by another.
+func routeServiceKey(backendKey, rawURL string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(backendKey + "\n" + rawURL))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// hasRouteServices returns true if any route in state is forwarded through a route
+// service, the template then declares what route services need.
+func hasRouteServices(state map[string]ServiceUnit) bool {
+	for _, serviceUnit := range state {
+		for _, cfg := range serviceUnit.ServiceAliasConfigs {
+			if cfg.RouteService != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
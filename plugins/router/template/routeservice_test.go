@@ -0,0 +1,147 @@
+package templaterouter
+
+import (
+	"encoding/base64"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestNewRouteService(t *testing.T) {
+	secret := []byte("secret")
+	tests := []struct {
+		url      string
+		expected RouteService
+	}{
+		{
+			url: "https://rs.example.com/auth?app=1",
+			expected: RouteService{
+				Address:    "rs.example.com:443",
+				Host:       "rs.example.com",
+				ServerName: "rs.example.com",
+				Path:       "/auth?app=1",
+				TLS:        true,
+			},
+		},
+		{
+			url: "http://rs.example.com:8080",
+			expected: RouteService{
+				Address:    "rs.example.com:8080",
+				Host:       "rs.example.com:8080",
+				ServerName: "rs.example.com",
+				Path:       "/",
+			},
+		},
+		{
+			// the path is escaped for a log-format string.
+			url: "https://10.1.2.3/auth%20me",
+			expected: RouteService{
+				Address:    "10.1.2.3:443",
+				Host:       "10.1.2.3",
+				ServerName: "10.1.2.3",
+				Path:       "/auth%%20me",
+				TLS:        true,
+			},
+		},
+	}
+	for _, test := range tests {
+		service, err := newRouteService("ns_route", test.url, secret)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.url, err)
+			continue
+		}
+		test.expected.URL = test.url
+		test.expected.Key = routeServiceKey("ns_route", test.url, secret)
+		test.expected.MaxAge = routeServiceMaxAge
+		if *service != test.expected {
+			t.Errorf("%s: expected %#v, got %#v", test.url, test.expected, *service)
+		}
+	}
+
+	invalid := []string{
+		"ftp://rs.example.com",
+		"/auth",
+		"://",
+		// only a host and a port are written to the configuration.
+		"https://rs.example.com:0",
+		"https://rs_example.com",
+		"https://rs.example.com%0Abind:81",
+		"https://rs.example.com%20verify%20none",
+	}
+	for _, url := range invalid {
+		if _, err := newRouteService("ns_route", url, secret); err == nil {
+			t.Errorf("%s: expected an error", url)
+		}
+	}
+}
+
+func TestSupportsRouteServices(t *testing.T) {
+	tests := map[string]bool{
+		"HA-Proxy version 1.5.18 2016/05/10\nCopyright 2000-2016 Willy Tarreau <willy@haproxy.org>\n": false,
+		"HA-Proxy version 2.4.22-f8e3218 2023/02/14 - https://haproxy.org/\n":                         false,
+		"HAProxy version 2.6.14-a3b1b1b 2023/06/09 - https://haproxy.org/\n":                          true,
+		"HAProxy version 3.0.5 2024/09/19 - https://haproxy.org/\n":                                   true,
+		"": false,
+	}
+	for output, expected := range tests {
+		if SupportsRouteServices(output) != expected {
+			t.Errorf("%q: expected %t", output, expected)
+		}
+	}
+}
+
+func TestRouteServiceKey(t *testing.T) {
+	key := routeServiceKey("ns_route", "https://rs.example.com", []byte("secret"))
+	if raw, err := base64.StdEncoding.DecodeString(key); err != nil || len(raw) != 32 {
+		t.Errorf("expected a base64 sha256, got %s", key)
+	}
+	if routeServiceKey("ns_other", "https://rs.example.com", []byte("secret")) == key {
+		t.Errorf("expected routes to have different keys")
+	}
+	if routeServiceKey("ns_route", "https://rs.example.com", []byte("other")) == key {
+		t.Errorf("expected the key to depend on the secret")
+	}
+}
+
+func TestAddRouteWithRouteService(t *testing.T) {
+	router := newFakeTemplateRouter()
+	suKey := "test/svc"
+	router.CreateServiceUnit(suKey)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "test",
+			Name:        "route",
+			Annotations: map[string]string{routeapi.RouteServiceURLAnnotation: "https://rs.example.com"},
+		},
+		Spec: routeapi.RouteSpec{Host: "host", To: kapi.ObjectReference{Name: "svc"}},
+	}
+	router.AddRoute(suKey, route, route.Spec.Host)
+	backendKey := router.routeKey(route)
+	if cfg := router.state[suKey].ServiceAliasConfigs[backendKey]; cfg.RouteService == nil || cfg.RouteService.Address != "rs.example.com:443" {
+		t.Errorf("expected the route to be forwarded through its route service, got %#v", cfg.RouteService)
+	}
+	if !hasRouteServices(router.state) {
+		t.Errorf("expected the router to have route services")
+	}
+
+	// a passthrough route cannot be forwarded, it is not served rather than served without.
+	route.Spec.TLS = &routeapi.TLSConfig{Termination: routeapi.TLSTerminationPassthrough}
+	router.AddRoute(suKey, route, route.Spec.Host)
+	if _, ok := router.state[suKey].ServiceAliasConfigs[backendKey]; ok {
+		t.Errorf("expected the passthrough route not to be served")
+	}
+	if hasRouteServices(router.state) {
+		t.Errorf("expected the router to have no route services left")
+	}
+
+	// a router unable to forward it does not serve it either.
+	route.Spec.TLS = nil
+	router.routeServices = false
+	router.AddRoute(suKey, route, route.Spec.Host)
+	if _, ok := router.state[suKey].ServiceAliasConfigs[backendKey]; ok {
+		t.Errorf("expected the route not to be served without route services")
+	}
+}
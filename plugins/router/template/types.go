@@ -37,6 +37,36 @@ type ServiceAliasConfig struct {
 	// insecure connections to an edge-terminated route:
 	//   none (or disable), allow or redirect
 	InsecureEdgeTerminationPolicy routeapi.InsecureEdgeTerminationPolicyType
+	// RouteService, if set, receives the requests to this backend first. Requests the
+	// route service forwards back with a recent signature made with its Key reach the
+	// endpoints.
+	RouteService *RouteService
+}
+
+// RouteService is a service requests to a route are forwarded through, as set on the
+// route with the RouteServiceURLAnnotation.
+type RouteService struct {
+	// URL of the route service, requests are sent to it with their own url in the
+	// X-CF-Forwarded-Url header.
+	URL string
+	// Address is the host:port of the route service, resolved by HAProxy.
+	Address string
+	// Host is the Host header of requests to the route service.
+	Host string
+	// ServerName is the name the certificate of a TLS route service is verified against.
+	ServerName string
+	// Path is the path and query of requests to the route service, escaped for a
+	// HAProxy log-format string.
+	Path string
+	// TLS is true if the route service is reached with https.
+	TLS bool
+	// Key is the base64 HMAC-SHA256 key the X-CF-Proxy-Signature of requests to the
+	// route service is computed with, over the time they are sent, in the
+	// X-CF-Proxy-Metadata header, and their X-CF-Forwarded-Url. The router only lets
+	// requests carrying a valid signature reach the endpoints of the route.
+	Key string
+	// MaxAge is the number of seconds a signature is accepted for once it was made.
+	MaxAge int
 }
 
 type ServiceAliasConfigStatus string